@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/group/grouppb"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/outbox"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+	"github.com/FSpruhs/kick-app/backend/match/matchpb"
+)
+
+const deadLettersTimeout = time.Minute
+
+var errUnknownDeadLettersCommand = errors.New("unknown deadletters command, use list or replay")
+
+// deadLetters runs the deadletters command. A replay is only requested here,
+// the running kickapp hands the event to its subscription again:
+//
+//	kickapp deadletters list
+//	kickapp deadletters replay <id>
+func deadLetters(args []string) error {
+	_, db, err := connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), deadLettersTimeout)
+	defer cancel()
+
+	defer func() { _ = db.Client().Disconnect(ctx) }()
+
+	reg, err := eventRegistry()
+	if err != nil {
+		return err
+	}
+
+	store := outbox.NewDeadLetterStore(db, deadLettersCollection, reg)
+
+	switch {
+	case len(args) == 1 && args[0] == "list":
+		err = printDeadLetters(ctx, store, os.Stdout)
+	case len(args) == 2 && args[0] == "replay":
+		err = store.RequestReplay(ctx, args[1])
+	default:
+		err = fmt.Errorf("%w: %q", errUnknownDeadLettersCommand, args)
+	}
+
+	return err
+}
+
+// eventRegistry registers the events of the modules, so the dead letters can
+// be read without starting the modules.
+func eventRegistry() (registry.Registry, error) {
+	reg := registry.New()
+
+	if err := grouppb.Registrations(reg); err != nil {
+		return nil, fmt.Errorf("register group events: %w", err)
+	}
+
+	if err := matchpb.Registrations(reg); err != nil {
+		return nil, fmt.Errorf("register match events: %w", err)
+	}
+
+	return reg, nil
+}
+
+func printDeadLetters(ctx context.Context, store *outbox.DeadLetterStore, out io.Writer) error {
+	letters, err := store.FindPending(ctx)
+	if err != nil {
+		return fmt.Errorf("reading dead letters: %w", err)
+	}
+
+	writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "ID\tFAILED AT\tSUBSCRIPTION\tEVENT\tAGGREGATE\tATTEMPTS\tREPLAY\tERROR")
+
+	for _, letter := range letters {
+		subscription := letter.Subscription
+		if subscription == "" {
+			subscription = "outbox"
+		}
+
+		replay := "-"
+		if letter.ReplayRequestedAt != nil {
+			replay = "requested"
+		}
+
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			letter.ID,
+			letter.FailedAt.Format(time.RFC3339),
+			subscription,
+			letter.Event.EventName(),
+			aggregateID(letter),
+			letter.Attempts,
+			replay,
+			letter.Err,
+		)
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("printing dead letters: %w", err)
+	}
+
+	return nil
+}
+
+func aggregateID(letter *outbox.DeadLetter) string {
+	if event, ok := letter.Event.(ddd.AggregateEvent); ok {
+		return event.AggregateID()
+	}
+
+	return "-"
+}
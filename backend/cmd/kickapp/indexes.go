@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+	"github.com/FSpruhs/kick-app/backend/internal/monolith"
+)
+
+// checkIndexes runs the indexes command, which reports how the indexes in the
+// database differ from the declared ones without changing them:
+//
+//	kickapp indexes
+func checkIndexes() error {
+	log, db, err := connect()
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = db.Client().Disconnect(context.Background()) }()
+
+	return reconcileIndexes(db, newModules(), log, indexes.DryRun())
+}
+
+// reconcileIndexes reconciles the indexes the modules declare as
+// indexes.Source.
+func reconcileIndexes(
+	db *mongo.Database,
+	modules []monolith.Module,
+	log *slog.Logger,
+	opts ...indexes.Option,
+) error {
+	var declared []indexes.Index
+
+	for _, module := range modules {
+		if source, ok := module.(indexes.Source); ok {
+			declared = append(declared, source.Indexes()...)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), startupTimeout)
+	defer cancel()
+
+	report, err := indexes.Reconcile(ctx, db, declared, opts...)
+	if err != nil {
+		return fmt.Errorf("reconciling indexes: %w", err)
+	}
+
+	report.Log(log)
+
+	return nil
+}
@@ -2,16 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
+	"path"
+	"reflect"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -21,21 +26,44 @@ import (
 	"github.com/FSpruhs/kick-app/backend/internal/config"
 	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/health"
+	"github.com/FSpruhs/kick-app/backend/internal/idempotency"
+	"github.com/FSpruhs/kick-app/backend/internal/logger"
+	"github.com/FSpruhs/kick-app/backend/internal/metrics"
 	"github.com/FSpruhs/kick-app/backend/internal/mongodb"
 	"github.com/FSpruhs/kick-app/backend/internal/monolith"
+	"github.com/FSpruhs/kick-app/backend/internal/outbox"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+	"github.com/FSpruhs/kick-app/backend/internal/requestid"
 	"github.com/FSpruhs/kick-app/backend/internal/rpc"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
 	"github.com/FSpruhs/kick-app/backend/internal/waiter"
+	"github.com/FSpruhs/kick-app/backend/match"
 	"github.com/FSpruhs/kick-app/backend/player"
 	"github.com/FSpruhs/kick-app/backend/user"
 )
 
+const (
+	startupTimeout        = 10 * time.Second
+	deadLettersCollection = "events.deadletters"
+)
+
+var errUnknownStorage = errors.New("unknown storage, use mongodb or memory")
+
 type app struct {
 	cfg             config.AppConfig
 	modules         []monolith.Module
 	db              *mongo.Database
 	router          *gin.Engine
 	eventDispatcher *ddd.EventDispatcher[ddd.AggregateEvent]
+	deadLetters     *outbox.DeadLetterStore
+	registry        registry.Registry
 	rpc             *grpc.Server
+	tokenVerifier   *ginconfig.TokenVerifier
+	health          *health.Checker
+	idempotency     *idempotency.Keys
+	metrics         *metrics.Metrics
+	logger          *slog.Logger
 	waiter          waiter.Waiter
 }
 
@@ -61,17 +89,61 @@ func (a *app) EventDispatcher() *ddd.EventDispatcher[ddd.AggregateEvent] {
 	return a.eventDispatcher
 }
 
+// DeadLetters returns nil without MongoDB, so failed events are not parked.
+func (a *app) DeadLetters() ddd.DeadLetterStore {
+	if a.deadLetters == nil {
+		return nil
+	}
+
+	return a.deadLetters
+}
+
+func (a *app) Registry() registry.Registry {
+	return a.registry
+}
+
+func (a *app) TokenVerifier() *ginconfig.TokenVerifier {
+	return a.tokenVerifier
+}
+
+func (a *app) Health() *health.Checker {
+	return a.health
+}
+
+func (a *app) Idempotency() *idempotency.Keys {
+	return a.idempotency
+}
+
+func (a *app) Metrics() *metrics.Metrics {
+	return a.metrics
+}
+
+func (a *app) Logger() *slog.Logger {
+	return a.logger
+}
+
 func (a *app) Waiter() waiter.Waiter {
 	return a.waiter
 }
 
 func (a *app) waitForWeb(ctx context.Context) error {
-	const timeoutDuration = 5 * time.Second
+	cfg := a.Config().Gin
+	server := &http.Server{
+		Addr:              ":" + cfg.Port,
+		Handler:           a.router,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
 
 	ginGroup, gCtx := errgroup.WithContext(ctx)
 
 	ginGroup.Go(func() error {
-		if err := a.router.Run(":" + a.Config().Gin.Port); err != nil {
+		a.logger.Info("web server started", slog.String("address", server.Addr))
+		defer a.logger.Info("web server stopped")
+
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			return fmt.Errorf("starting web server: %w", err)
 		}
 
@@ -79,10 +151,17 @@ func (a *app) waitForWeb(ctx context.Context) error {
 	})
 	ginGroup.Go(func() error {
 		<-gCtx.Done()
+		a.logger.Info("shutting down web server")
 
-		_, cancel := context.WithTimeout(context.Background(), timeoutDuration)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 		defer cancel()
 
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			_ = server.Close()
+
+			return fmt.Errorf("web server failed to stop gracefully: %w", err)
+		}
+
 		return nil
 	})
 
@@ -97,8 +176,8 @@ func (a *app) waitForRPC(ctx context.Context) error {
 
 	grpcGroup, gCtx := errgroup.WithContext(ctx)
 	grpcGroup.Go(func() error {
-		log.Println("rpc server started")
-		defer log.Println("rpc server stopped")
+		a.logger.Info("rpc server started", slog.String("address", a.cfg.RPC.Address()))
+		defer a.logger.Info("rpc server stopped")
 
 		if err := a.RPC().Serve(listener); err != nil {
 			return fmt.Errorf("rpc server starts listening: %w", err)
@@ -109,9 +188,7 @@ func (a *app) waitForRPC(ctx context.Context) error {
 
 	grpcGroup.Go(func() error {
 		<-gCtx.Done()
-		log.Println("shutting down rpc server")
-
-		const timeoutDuration = 5 * time.Second
+		a.logger.Info("shutting down rpc server")
 
 		stopped := make(chan struct{})
 		go func() {
@@ -119,7 +196,7 @@ func (a *app) waitForRPC(ctx context.Context) error {
 			close(stopped)
 		}()
 
-		timeout := time.NewTimer(timeoutDuration)
+		timeout := time.NewTimer(a.cfg.RPC.ShutdownTimeout)
 		select {
 		case <-timeout.C:
 			a.RPC().Stop()
@@ -135,8 +212,21 @@ func (a *app) waitForRPC(ctx context.Context) error {
 }
 
 func main() {
-	if err := run(); err != nil {
-		log.Println(err.Error())
+	var err error
+
+	switch {
+	case len(os.Args) > 1 && os.Args[1] == "migrate":
+		err = migrate(os.Args[2:])
+	case len(os.Args) > 1 && os.Args[1] == "indexes":
+		err = checkIndexes()
+	case len(os.Args) > 1 && os.Args[1] == "deadletters":
+		err = deadLetters(os.Args[2:])
+	default:
+		err = run()
+	}
+
+	if err != nil {
+		slog.Error("kickapp failed", slog.Any("error", err))
 		os.Exit(1)
 	}
 }
@@ -144,17 +234,43 @@ func main() {
 func run() error {
 	conf := config.InitConfig()
 
-	mongoDB := mongodb.ConnectMongoDB(conf.EnvMongoURI, conf.DatabaseName)
+	newLogger, err := logger.New(conf.Log, os.Stdout)
+	if err != nil {
+		return fmt.Errorf("creating logger: %w", err)
+	}
+
+	// the standard logger of libraries writes through it as well
+	slog.SetDefault(newLogger)
+
+	tracer, err := tracing.New(context.Background(), conf.Tracing)
+	if err != nil {
+		return fmt.Errorf("creating tracer provider: %w", err)
+	}
+
+	newMetrics := metrics.New()
+	modules := newModules()
+
+	mongoDB, err := initStorage(conf, modules, newMetrics, newLogger)
+	if err != nil {
+		return err
+	}
 
-	eventDispatcher := ddd.NewEventDispatcher[ddd.AggregateEvent]()
+	reg := registry.New()
+	deadLetters := initDeadLetters(mongoDB, reg)
+	eventDispatcher := initEventDispatcher(conf.Events, deadLetters, newMetrics, newLogger)
 	newWaiter := waiter.New(waiter.CatchSignals())
-	router := initRouter()
-	newRPC := initRPC(conf.RPC)
+	checker := initHealth(mongoDB, newLogger)
+	router := initRouter(conf.Tracing, checker, newMetrics, newLogger)
+	newRPC := initRPC(conf.RPC, checker, newMetrics)
 
-	modules := []monolith.Module{
-		&player.Module{},
-		&user.Module{},
-		&group.Module{},
+	keys, err := initIdempotency(mongoDB, conf.Idempotency, newLogger)
+	if err != nil {
+		return err
+	}
+
+	tokenVerifier, err := ginconfig.NewTokenVerifier(conf.Gin)
+	if err != nil {
+		return fmt.Errorf("creating token verifier: %w", err)
 	}
 
 	application := app{
@@ -163,41 +279,204 @@ func run() error {
 		db:              mongoDB,
 		router:          router,
 		eventDispatcher: eventDispatcher,
+		deadLetters:     deadLetters,
+		registry:        reg,
 		rpc:             newRPC,
+		tokenVerifier:   tokenVerifier,
+		health:          checker,
+		idempotency:     keys,
+		metrics:         newMetrics,
+		logger:          newLogger,
 		waiter:          newWaiter,
 	}
 	application.startupModules()
 
-	log.Println("Started KickApp")
-	defer log.Println("Stopped KickApp")
+	newLogger.Info("started kickapp")
+	defer newLogger.Info("stopped kickapp")
 
 	application.waiter.Add(
 		application.waitForWeb,
 		application.waitForRPC,
+		application.eventDispatcher.Start,
+		application.health.Start,
+		tracer.Start,
 	)
 
+	if deadLetters != nil {
+		application.waiter.Add(outbox.NewReplayer(deadLetters, eventDispatcher, outbox.ReplayLogger(newLogger)).Start)
+	}
+
 	return application.waiter.Wait()
 }
 
+func newModules() []monolith.Module {
+	return []monolith.Module{
+		&player.Module{},
+		&user.Module{},
+		&group.Module{},
+		&match.Module{},
+	}
+}
+
 func (a *app) startupModules() {
+	for _, module := range a.modules {
+		a.health.SetModuleState(moduleName(module), health.StateStarting)
+	}
+
 	for _, module := range a.modules {
 		if err := module.Startup(a); err != nil {
+			a.health.SetModuleState(moduleName(module), health.StateFailed)
 			panic(err)
 		}
+
+		a.health.SetModuleState(moduleName(module), health.StateStarted)
+	}
+}
+
+// moduleName is the name of the package of the module, e.g. "group".
+func moduleName(module monolith.Module) string {
+	return path.Base(reflect.TypeOf(module).Elem().PkgPath())
+}
+
+// initStorage connects to MongoDB and prepares its collections. With
+// config.StorageMemory the modules keep their data in memory and no database
+// is returned.
+func initStorage(
+	conf config.AppConfig,
+	modules []monolith.Module,
+	m *metrics.Metrics,
+	log *slog.Logger,
+) (*mongo.Database, error) {
+	switch conf.Storage {
+	case config.StorageMongoDB:
+	case config.StorageMemory:
+		log.Warn("keeping all data in memory, it is lost on shutdown")
+
+		return nil, nil //nolint:nilnil // no database means the data is kept in memory
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownStorage, conf.Storage)
+	}
+
+	mongoDB, err := mongodb.ConnectMongoDB(
+		conf.EnvMongoURI,
+		conf.DatabaseName,
+		options.Client().SetMonitor(mongodb.Monitors(m.CommandMonitor(), tracing.CommandMonitor())),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("connected to mongodb")
+
+	if conf.Migrations.OnStartup {
+		if err := migrateOnStartup(mongoDB, modules, log); err != nil {
+			return nil, err
+		}
 	}
+
+	if err := reconcileIndexes(mongoDB, modules, log); err != nil {
+		return nil, err
+	}
+
+	return mongoDB, nil
 }
 
-func initRPC(_ rpc.Config) *grpc.Server {
-	server := grpc.NewServer()
+// initDeadLetters returns nil without MongoDB.
+func initDeadLetters(db *mongo.Database, reg registry.Registry) *outbox.DeadLetterStore {
+	if db == nil {
+		return nil
+	}
+
+	return outbox.NewDeadLetterStore(db, deadLettersCollection, reg)
+}
+
+func initEventDispatcher(
+	cfg config.EventsConfig,
+	deadLetters *outbox.DeadLetterStore,
+	observer ddd.DispatchObserver,
+	log *slog.Logger,
+) *ddd.EventDispatcher[ddd.AggregateEvent] {
+	dispatcherOptions := []ddd.DispatcherOption{
+		ddd.Observe(observer),
+		ddd.Trace(tracing.EventTracer{}),
+		ddd.Logger(log),
+		ddd.RestoreContext(requestid.RestoreContext, tracing.RestoreContext),
+	}
+
+	if deadLetters != nil {
+		dispatcherOptions = append(dispatcherOptions, ddd.DeadLetters(deadLetters))
+	}
+
+	if !cfg.Async {
+		return ddd.NewEventDispatcher[ddd.AggregateEvent](dispatcherOptions...)
+	}
+
+	return ddd.NewEventDispatcher[ddd.AggregateEvent](
+		append(
+			dispatcherOptions,
+			ddd.Async(cfg.Workers, cfg.QueueSize),
+			ddd.Retry(cfg.MaxAttempts, cfg.Backoff, cfg.MaxBackoff),
+			ddd.Drain(cfg.DrainTimeout),
+		)...,
+	)
+}
+
+func initHealth(db *mongo.Database, log *slog.Logger) *health.Checker {
+	checker := health.New(health.Logger(log))
+
+	if db != nil {
+		checker.AddCheck("mongodb", mongodb.Ping(db))
+	}
+
+	return checker
+}
+
+func initIdempotency(db *mongo.Database, cfg idempotency.Config, log *slog.Logger) (*idempotency.Keys, error) {
+	if db == nil {
+		return idempotency.New(idempotency.NewMemoryStore(cfg), idempotency.Logger(log)), nil
+	}
+
+	store := idempotency.NewMongoStore(db, "idempotency.keys", cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), startupTimeout)
+	defer cancel()
+
+	if err := store.EnsureIndexes(ctx); err != nil {
+		return nil, fmt.Errorf("prepare idempotency store: %w", err)
+	}
+
+	return idempotency.New(store, idempotency.Logger(log)), nil
+}
+
+func initRPC(_ rpc.Config, checker *health.Checker, m *metrics.Metrics) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(requestid.UnaryServerInterceptor(), m.UnaryServerInterceptor()),
+		tracing.ServerOption(),
+	)
 	reflection.Register(server)
+	checker.RegisterGRPC(server)
 
 	return server
 }
 
-func initRouter() *gin.Engine {
-	router := gin.Default()
+func initRouter(
+	tracingCfg tracing.Config,
+	checker *health.Checker,
+	m *metrics.Metrics,
+	log *slog.Logger,
+) *gin.Engine {
+	router := gin.New()
+	router.Use(gin.Recovery())
+	router.Use(requestid.GinMiddleware())
+	router.Use(tracing.GinMiddleware(tracingCfg.ServiceName))
+	router.Use(logger.GinMiddleware(log))
+	router.Use(m.GinMiddleware())
+	router.Use(ginconfig.ErrorHandler(log))
 	router.Use(ginconfig.CorsMiddleware())
 
+	health.Routes(router, checker)
+	router.GET("/metrics", gin.WrapH(m.Handler()))
+
 	docs.SwaggerInfo.BasePath = "/api/v1"
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
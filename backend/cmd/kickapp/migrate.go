@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/FSpruhs/kick-app/backend/internal/config"
+	"github.com/FSpruhs/kick-app/backend/internal/logger"
+	"github.com/FSpruhs/kick-app/backend/internal/migrations"
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb"
+	"github.com/FSpruhs/kick-app/backend/internal/monolith"
+)
+
+const migrateTimeout = 5 * time.Minute
+
+var errUnknownMigrateCommand = errors.New("unknown migrate command, use up, down or status")
+
+// migrate runs the migrate command:
+//
+//	kickapp migrate [-dry-run] up
+//	kickapp migrate [-dry-run] down [steps]
+//	kickapp migrate status
+func migrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "only log the migrations which would be applied or rolled back")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("parsing migrate arguments: %w", err)
+	}
+
+	log, db, err := connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+
+	defer func() { _ = db.Client().Disconnect(ctx) }()
+
+	opts := []migrations.Option{migrations.Logger(log)}
+	if *dryRun {
+		opts = append(opts, migrations.DryRun())
+	}
+
+	migrator, err := newMigrator(db, newModules(), opts...)
+	if err != nil {
+		return err
+	}
+
+	switch flags.Arg(0) {
+	case "up":
+		_, err = migrator.Up(ctx)
+	case "down":
+		err = migrateDown(ctx, migrator, flags.Arg(1))
+	case "status":
+		err = printStatus(ctx, migrator, os.Stdout)
+	default:
+		err = fmt.Errorf("%w: %q", errUnknownMigrateCommand, flags.Arg(0))
+	}
+
+	return err
+}
+
+// connect sets up the logger and the database for a command. The logs go to
+// stderr, so they do not mix with the output of the command.
+func connect() (*slog.Logger, *mongo.Database, error) {
+	conf := config.InitConfig()
+
+	log, err := logger.New(conf.Log, os.Stderr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating logger: %w", err)
+	}
+
+	db, err := mongodb.ConnectMongoDB(conf.EnvMongoURI, conf.DatabaseName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return log, db, nil
+}
+
+// migrateOnStartup applies the pending migrations before the modules start
+// using the collections.
+func migrateOnStartup(db *mongo.Database, modules []monolith.Module, log *slog.Logger) error {
+	migrator, err := newMigrator(db, modules, migrations.Logger(log))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+
+	if _, err := migrator.Up(ctx); err != nil {
+		return fmt.Errorf("migrating database: %w", err)
+	}
+
+	return nil
+}
+
+// newMigrator migrates the collections of the modules which are a
+// migrations.Source.
+func newMigrator(
+	db *mongo.Database,
+	modules []monolith.Module,
+	opts ...migrations.Option,
+) (*migrations.Migrator, error) {
+	var all []migrations.Migration
+
+	for _, module := range modules {
+		if source, ok := module.(migrations.Source); ok {
+			all = append(all, source.Migrations()...)
+		}
+	}
+
+	migrator, err := migrations.New(db, all, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating migrator: %w", err)
+	}
+
+	return migrator, nil
+}
+
+func migrateDown(ctx context.Context, migrator *migrations.Migrator, stepsArg string) error {
+	steps := 1
+
+	if stepsArg != "" {
+		var err error
+		if steps, err = strconv.Atoi(stepsArg); err != nil {
+			return fmt.Errorf("parsing number of migrations to roll back: %w", err)
+		}
+	}
+
+	if _, err := migrator.Down(ctx, steps); err != nil {
+		return fmt.Errorf("rolling back migrations: %w", err)
+	}
+
+	return nil
+}
+
+func printStatus(ctx context.Context, migrator *migrations.Migrator, out io.Writer) error {
+	states, err := migrator.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("reading migration status: %w", err)
+	}
+
+	writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "VERSION\tAPPLIED AT\tDESCRIPTION")
+
+	for _, state := range states {
+		appliedAt := "pending"
+		if state.Applied() {
+			appliedAt = state.AppliedAt.Format(time.RFC3339)
+		}
+
+		_, _ = fmt.Fprintf(writer, "%d\t%s\t%s\n", state.Version, appliedAt, state.Description)
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("printing migration status: %w", err)
+	}
+
+	return nil
+}
@@ -6,11 +6,22 @@ const (
 	UserAcceptedInvitationEvent = "group.UserAcceptedInvitation"
 	PlayerLeavesGroupEvent      = "group.PlayerLeavesGroup"
 	PlayerRemovedFromGroupEvent = "group.PlayerRemoved"
+	UserRejectedInvitationEvent = "group.UserRejectedInvitation"
+	PlayerRoleChangedEvent      = "group.PlayerRoleChanged"
+	PlayerStatusChangedEvent    = "group.PlayerStatusChanged"
+	RoleDefinedEvent            = "group.RoleDefined"
+	RoleDeletedEvent            = "group.RoleDeleted"
+	PlayerRoleAssignedEvent     = "group.PlayerRoleAssigned"
+	PlayerRoleUnassignedEvent   = "group.PlayerRoleUnassigned"
+	MatchPriorityChangedEvent   = "group.MatchPriorityChanged"
 )
 
 type GroupCreated struct {
-	GroupID string
-	UserIDs []string
+	GroupID     string
+	UserIDs     []string
+	UserID      string
+	Name        string
+	InviteLevel string
 }
 
 type UserInvited struct {
@@ -34,3 +45,55 @@ type PlayerRemovedFromGroup struct {
 	UserID    string
 	GroupName string
 }
+
+type UserRejectedInvitation struct {
+	GroupID string
+	UserID  string
+}
+
+type PlayerRoleChanged struct {
+	GroupID   string
+	UserID    string
+	Role      string
+	ChangedBy string
+}
+
+type PlayerStatusChanged struct {
+	GroupID   string
+	UserID    string
+	Status    string
+	ChangedBy string
+}
+
+type RoleDefined struct {
+	GroupID     string
+	Name        string
+	Permissions []string
+	ChangedBy   string
+}
+
+type RoleDeleted struct {
+	GroupID   string
+	Name      string
+	ChangedBy string
+}
+
+type PlayerRoleAssigned struct {
+	GroupID   string
+	UserID    string
+	Role      string
+	ChangedBy string
+}
+
+type PlayerRoleUnassigned struct {
+	GroupID   string
+	UserID    string
+	Role      string
+	ChangedBy string
+}
+
+type MatchPriorityChanged struct {
+	GroupID   string
+	Priority  string
+	ChangedBy string
+}
@@ -23,6 +23,9 @@ const (
 	GroupService_IsActivePlayer_FullMethodName            = "/grouppb.GroupService/IsActivePlayer"
 	GroupService_GetActivePlayersByGroupID_FullMethodName = "/grouppb.GroupService/GetActivePlayersByGroupID"
 	GroupService_HasPlayerAdminRole_FullMethodName        = "/grouppb.GroupService/HasPlayerAdminRole"
+	GroupService_GetPlayerAccess_FullMethodName           = "/grouppb.GroupService/GetPlayerAccess"
+	GroupService_GetActiveGroupsByUserID_FullMethodName   = "/grouppb.GroupService/GetActiveGroupsByUserID"
+	GroupService_GetMatchPriority_FullMethodName          = "/grouppb.GroupService/GetMatchPriority"
 )
 
 // GroupServiceClient is the client API for GroupService service.
@@ -32,6 +35,9 @@ type GroupServiceClient interface {
 	IsActivePlayer(ctx context.Context, in *IsActivePlayerRequest, opts ...grpc.CallOption) (*IsActivePlayerResponse, error)
 	GetActivePlayersByGroupID(ctx context.Context, in *GetActivePlayersByGroupIDRequest, opts ...grpc.CallOption) (*GetActivePlayersByGroupIDResponse, error)
 	HasPlayerAdminRole(ctx context.Context, in *HasPlayerAdminRoleRequest, opts ...grpc.CallOption) (*HasPlayerAdminRoleResponse, error)
+	GetPlayerAccess(ctx context.Context, in *GetPlayerAccessRequest, opts ...grpc.CallOption) (*GetPlayerAccessResponse, error)
+	GetActiveGroupsByUserID(ctx context.Context, in *GetActiveGroupsByUserIDRequest, opts ...grpc.CallOption) (*GetActiveGroupsByUserIDResponse, error)
+	GetMatchPriority(ctx context.Context, in *GetMatchPriorityRequest, opts ...grpc.CallOption) (*GetMatchPriorityResponse, error)
 }
 
 type groupServiceClient struct {
@@ -72,6 +78,36 @@ func (c *groupServiceClient) HasPlayerAdminRole(ctx context.Context, in *HasPlay
 	return out, nil
 }
 
+func (c *groupServiceClient) GetPlayerAccess(ctx context.Context, in *GetPlayerAccessRequest, opts ...grpc.CallOption) (*GetPlayerAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlayerAccessResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetPlayerAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetActiveGroupsByUserID(ctx context.Context, in *GetActiveGroupsByUserIDRequest, opts ...grpc.CallOption) (*GetActiveGroupsByUserIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveGroupsByUserIDResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetActiveGroupsByUserID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetMatchPriority(ctx context.Context, in *GetMatchPriorityRequest, opts ...grpc.CallOption) (*GetMatchPriorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMatchPriorityResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetMatchPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServiceServer is the server API for GroupService service.
 // All implementations must embed UnimplementedGroupServiceServer
 // for forward compatibility.
@@ -79,6 +115,9 @@ type GroupServiceServer interface {
 	IsActivePlayer(context.Context, *IsActivePlayerRequest) (*IsActivePlayerResponse, error)
 	GetActivePlayersByGroupID(context.Context, *GetActivePlayersByGroupIDRequest) (*GetActivePlayersByGroupIDResponse, error)
 	HasPlayerAdminRole(context.Context, *HasPlayerAdminRoleRequest) (*HasPlayerAdminRoleResponse, error)
+	GetPlayerAccess(context.Context, *GetPlayerAccessRequest) (*GetPlayerAccessResponse, error)
+	GetActiveGroupsByUserID(context.Context, *GetActiveGroupsByUserIDRequest) (*GetActiveGroupsByUserIDResponse, error)
+	GetMatchPriority(context.Context, *GetMatchPriorityRequest) (*GetMatchPriorityResponse, error)
 	mustEmbedUnimplementedGroupServiceServer()
 }
 
@@ -98,6 +137,15 @@ func (UnimplementedGroupServiceServer) GetActivePlayersByGroupID(context.Context
 func (UnimplementedGroupServiceServer) HasPlayerAdminRole(context.Context, *HasPlayerAdminRoleRequest) (*HasPlayerAdminRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HasPlayerAdminRole not implemented")
 }
+func (UnimplementedGroupServiceServer) GetPlayerAccess(context.Context, *GetPlayerAccessRequest) (*GetPlayerAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerAccess not implemented")
+}
+func (UnimplementedGroupServiceServer) GetActiveGroupsByUserID(context.Context, *GetActiveGroupsByUserIDRequest) (*GetActiveGroupsByUserIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveGroupsByUserID not implemented")
+}
+func (UnimplementedGroupServiceServer) GetMatchPriority(context.Context, *GetMatchPriorityRequest) (*GetMatchPriorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchPriority not implemented")
+}
 func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
 func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
 
@@ -173,6 +221,60 @@ func _GroupService_HasPlayerAdminRole_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_GetPlayerAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetPlayerAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetPlayerAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetPlayerAccess(ctx, req.(*GetPlayerAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetActiveGroupsByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveGroupsByUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetActiveGroupsByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetActiveGroupsByUserID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetActiveGroupsByUserID(ctx, req.(*GetActiveGroupsByUserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetMatchPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetMatchPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetMatchPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetMatchPriority(ctx, req.(*GetMatchPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +294,18 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HasPlayerAdminRole",
 			Handler:    _GroupService_HasPlayerAdminRole_Handler,
 		},
+		{
+			MethodName: "GetPlayerAccess",
+			Handler:    _GroupService_GetPlayerAccess_Handler,
+		},
+		{
+			MethodName: "GetActiveGroupsByUserID",
+			Handler:    _GroupService_GetActiveGroupsByUserID_Handler,
+		},
+		{
+			MethodName: "GetMatchPriority",
+			Handler:    _GroupService_GetMatchPriority_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "group_api.proto",
@@ -0,0 +1,33 @@
+package grouppb
+
+import (
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+)
+
+func Registrations(reg registry.Registry) error {
+	events := map[string]any{
+		GroupCreatedEvent:           GroupCreated{},
+		UserInvitedEvent:            UserInvited{},
+		UserAcceptedInvitationEvent: UserAcceptedInvitation{},
+		PlayerLeavesGroupEvent:      UserLeavesGroup{},
+		PlayerRemovedFromGroupEvent: PlayerRemovedFromGroup{},
+		UserRejectedInvitationEvent: UserRejectedInvitation{},
+		PlayerRoleChangedEvent:      PlayerRoleChanged{},
+		PlayerStatusChangedEvent:    PlayerStatusChanged{},
+		RoleDefinedEvent:            RoleDefined{},
+		RoleDeletedEvent:            RoleDeleted{},
+		PlayerRoleAssignedEvent:     PlayerRoleAssigned{},
+		PlayerRoleUnassignedEvent:   PlayerRoleUnassigned{},
+		MatchPriorityChangedEvent:   MatchPriorityChanged{},
+	}
+
+	for name, payload := range events {
+		if err := reg.Register(name, payload); err != nil {
+			return fmt.Errorf("register group event: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -1,10 +1,14 @@
 package application
 
 import (
+	"context"
+
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/queries"
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
 	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
 )
 
 type App interface {
@@ -13,20 +17,30 @@ type App interface {
 }
 
 type Commands interface {
-	CreateGroup(cmd *commands.CreateGroup) (*domain.Group, error)
-	InviteUser(cmd *commands.InviteUser) error
-	InvitedUserResponse(cmd *commands.InvitedUserResponse) error
-	LeaveGroup(cmd *commands.LeaveGroup) error
-	UpdatePlayer(cmd *commands.UpdatePlayer) error
-	RemovePlayer(cmd *commands.RemovePlayer) error
+	CreateGroup(ctx context.Context, cmd *commands.CreateGroup) (*domain.Group, error)
+	InviteUser(ctx context.Context, cmd *commands.InviteUser) error
+	InvitedUserResponse(ctx context.Context, cmd *commands.InvitedUserResponse) error
+	LeaveGroup(ctx context.Context, cmd *commands.LeaveGroup) error
+	UpdatePlayer(ctx context.Context, cmd *commands.UpdatePlayer) error
+	RemovePlayer(ctx context.Context, cmd *commands.RemovePlayer) error
+	DefineRole(ctx context.Context, cmd *commands.DefineRole) error
+	DeleteRole(ctx context.Context, cmd *commands.DeleteRole) error
+	AssignRole(ctx context.Context, cmd *commands.AssignRole) error
+	UnassignRole(ctx context.Context, cmd *commands.UnassignRole) error
+	ChangeMatchPriority(ctx context.Context, cmd *commands.ChangeMatchPriority) error
 }
 
 type Queries interface {
-	GetGroups(cmd *queries.GetGroupsByUser) ([]*domain.Group, error)
-	GetGroup(cmd *queries.GetGroup) (*domain.GroupDetails, error)
-	IsPlayerActive(cmd *queries.IsPlayerActive) bool
-	GetActivePlayersByGroup(cmd *queries.GetActivePlayersByGroup) ([]string, error)
-	HasPlayerAdminRole(cmd *queries.HasPlayerAdminRole) bool
+	GetGroups(ctx context.Context, cmd *queries.GetGroupsByUser) (pagination.Page[*domain.Group], error)
+	GetGroup(ctx context.Context, cmd *queries.GetGroup) (*domain.GroupDetails, error)
+	IsPlayerActive(ctx context.Context, cmd *queries.IsPlayerActive) bool
+	GetActivePlayersByGroup(ctx context.Context, cmd *queries.GetActivePlayersByGroup) ([]string, error)
+	HasPlayerAdminRole(ctx context.Context, cmd *queries.HasPlayerAdminRole) bool
+	GetRoles(ctx context.Context, cmd *queries.GetRoles) ([]*domain.GroupRole, error)
+	GetGroupHistory(ctx context.Context, cmd *queries.GetGroupHistory) ([]ddd.AggregateEvent, error)
+	GetPlayerAccess(ctx context.Context, cmd *queries.GetPlayerAccess) (policy.Subject, error)
+	GetActiveGroupsByUser(ctx context.Context, cmd *queries.GetActiveGroupsByUser) ([]string, error)
+	GetMatchPriority(ctx context.Context, cmd *queries.GetMatchPriority) (domain.MatchPriority, error)
 }
 
 type Application struct {
@@ -41,6 +55,11 @@ type appCommands struct {
 	commands.LeaveGroupHandler
 	commands.UpdatePlayerHandler
 	commands.RemovePlayerHandler
+	commands.DefineRoleHandler
+	commands.DeleteRoleHandler
+	commands.AssignRoleHandler
+	commands.UnassignRoleHandler
+	commands.ChangeMatchPriorityHandler
 }
 
 type appQueries struct {
@@ -49,6 +68,11 @@ type appQueries struct {
 	queries.IsPlayerActiveHandler
 	queries.GetActivePlayersByGroupHandler
 	queries.HasPlayerAdminRoleHandler
+	queries.GetRolesHandler
+	queries.GetGroupHistoryHandler
+	queries.GetPlayerAccessHandler
+	queries.GetActiveGroupsByUserHandler
+	queries.GetMatchPriorityHandler
 }
 
 var _ App = (*Application)(nil)
@@ -66,6 +90,11 @@ func New(
 			LeaveGroupHandler:          commands.NewLeaveGroupHandler(groups, eventPublisher),
 			UpdatePlayerHandler:        commands.NewUpdatePlayerHandler(groups),
 			RemovePlayerHandler:        commands.NewRemovePlayerHandler(groups, eventPublisher),
+			DefineRoleHandler:          commands.NewDefineRoleHandler(groups),
+			DeleteRoleHandler:          commands.NewDeleteRoleHandler(groups),
+			AssignRoleHandler:          commands.NewAssignRoleHandler(groups),
+			UnassignRoleHandler:        commands.NewUnassignRoleHandler(groups),
+			ChangeMatchPriorityHandler: commands.NewChangeMatchPriorityHandler(groups),
 		},
 		appQueries: appQueries{
 			GetGroupsByUserHandler:         queries.NewGetGroupsByUserHandler(groups),
@@ -73,6 +102,11 @@ func New(
 			IsPlayerActiveHandler:          queries.NewIsPlayerActiveHandler(groups),
 			GetActivePlayersByGroupHandler: queries.NewGetActivePlayersByGroupHandler(groups),
 			HasPlayerAdminRoleHandler:      queries.NewHasPlayerAdminRoleHandler(groups),
+			GetRolesHandler:                queries.NewGetRolesHandler(groups),
+			GetGroupHistoryHandler:         queries.NewGetGroupHistoryHandler(groups),
+			GetPlayerAccessHandler:         queries.NewGetPlayerAccessHandler(groups),
+			GetActiveGroupsByUserHandler:   queries.NewGetActiveGroupsByUserHandler(groups),
+			GetMatchPriorityHandler:        queries.NewGetMatchPriorityHandler(groups),
 		},
 	}
 }
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+type AssignRole struct {
+	GroupID        string
+	ChangingUserID string
+	UserID         string
+	Role           string
+}
+
+type AssignRoleHandler struct {
+	groups domain.GroupRepository
+}
+
+func NewAssignRoleHandler(groups domain.GroupRepository) AssignRoleHandler {
+	return AssignRoleHandler{groups}
+}
+
+func (h AssignRoleHandler) AssignRole(ctx context.Context, cmd *AssignRole) error {
+	return ddd.RetryOnConflict(conflictAttempts, func() error {
+		group, err := h.groups.FindByID(ctx, cmd.GroupID)
+		if err != nil {
+			return fmt.Errorf("assigning role: %w", err)
+		}
+
+		if err := group.AssignRole(ctx, cmd.ChangingUserID, cmd.UserID, cmd.Role); err != nil {
+			return fmt.Errorf("assigning role %s: %w", cmd.Role, err)
+		}
+
+		if err := h.groups.Save(ctx, group); err != nil {
+			return fmt.Errorf("saving group after assigning role: %w", err)
+		}
+
+		return nil
+	})
+}
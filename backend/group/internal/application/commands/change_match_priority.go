@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+type ChangeMatchPriority struct {
+	GroupID        string
+	ChangingUserID string
+	Priority       domain.MatchPriority
+}
+
+type ChangeMatchPriorityHandler struct {
+	groups domain.GroupRepository
+}
+
+func NewChangeMatchPriorityHandler(groups domain.GroupRepository) ChangeMatchPriorityHandler {
+	return ChangeMatchPriorityHandler{groups}
+}
+
+func (h ChangeMatchPriorityHandler) ChangeMatchPriority(ctx context.Context, cmd *ChangeMatchPriority) error {
+	return ddd.RetryOnConflict(conflictAttempts, func() error {
+		group, err := h.groups.FindByID(ctx, cmd.GroupID)
+		if err != nil {
+			return fmt.Errorf("changing match priority: %w", err)
+		}
+
+		if err := group.ChangeMatchPriority(ctx, cmd.ChangingUserID, cmd.Priority); err != nil {
+			return fmt.Errorf("changing match priority to %s: %w", cmd.Priority, err)
+		}
+
+		if err := h.groups.Save(ctx, group); err != nil {
+			return fmt.Errorf("saving group after changing match priority: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
@@ -24,18 +25,18 @@ func NewCreateGroupHandler(
 	return CreateGroupHandler{groups, eventPublisher}
 }
 
-func (h CreateGroupHandler) CreateGroup(cmd *CreateGroup) (*domain.Group, error) {
+func (h CreateGroupHandler) CreateGroup(ctx context.Context, cmd *CreateGroup) (*domain.Group, error) {
 	newGroup, err := domain.CreateNewGroup(cmd.UserID, cmd.Name)
 	if err != nil {
 		return nil, fmt.Errorf("creating new group: %w", err)
 	}
 
-	result, err := h.GroupRepository.Create(newGroup)
+	result, err := h.GroupRepository.Create(ctx, newGroup)
 	if err != nil {
 		return nil, fmt.Errorf("creating group: %w", err)
 	}
 
-	if err := h.Publish(newGroup.Events()...); err != nil {
+	if err := h.Publish(ctx, newGroup.Events()...); err != nil {
 		return nil, fmt.Errorf("publish group created: %w", err)
 	}
 
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -22,10 +23,10 @@ func TestCreateGroupHandler_CreateGroup(t *testing.T) {
 
 	t.Run("Create Group Success", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("Create", mock.AnythingOfType("*domain.Group")).Return(expectedGroup, nil)
+		mockGroupRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(expectedGroup, nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
 
 		handler := NewCreateGroupHandler(mockGroupRepo, mockEventRepo)
 
@@ -34,13 +35,13 @@ func TestCreateGroupHandler_CreateGroup(t *testing.T) {
 			Name:   name.Value(),
 		}
 
-		group, err := handler.CreateGroup(cmd)
+		group, err := handler.CreateGroup(context.Background(), cmd)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, group)
 
-		mockGroupRepo.AssertCalled(t, "Create", groupMatcher(name, userID))
-		mockEventRepo.AssertCalled(t, "Publish", createEventMatcher(userID))
+		mockGroupRepo.AssertCalled(t, "Create", mock.Anything, groupMatcher(name, userID))
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, createEventMatcher(userID))
 
 		mockGroupRepo.AssertExpectations(t)
 		mockEventRepo.AssertExpectations(t)
@@ -48,10 +49,10 @@ func TestCreateGroupHandler_CreateGroup(t *testing.T) {
 
 	t.Run("Create Group invalid group creating", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("Create", mock.AnythingOfType("*domain.Group")).Return(expectedGroup, nil)
+		mockGroupRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(expectedGroup, nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
 
 		handler := NewCreateGroupHandler(mockGroupRepo, mockEventRepo)
 
@@ -60,7 +61,7 @@ func TestCreateGroupHandler_CreateGroup(t *testing.T) {
 			Name:   "",
 		}
 
-		group, err := handler.CreateGroup(cmd)
+		group, err := handler.CreateGroup(context.Background(), cmd)
 
 		assert.Error(t, err)
 		assert.Nil(t, group)
@@ -68,10 +69,10 @@ func TestCreateGroupHandler_CreateGroup(t *testing.T) {
 
 	t.Run("Create group with repo error", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("Create", mock.AnythingOfType("*domain.Group")).Return(nil, someErr)
+		mockGroupRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil, someErr)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
 
 		handler := NewCreateGroupHandler(mockGroupRepo, mockEventRepo)
 
@@ -80,7 +81,7 @@ func TestCreateGroupHandler_CreateGroup(t *testing.T) {
 			Name:   name.Value(),
 		}
 
-		group, err := handler.CreateGroup(cmd)
+		group, err := handler.CreateGroup(context.Background(), cmd)
 
 		assert.Error(t, err)
 		assert.Nil(t, group)
@@ -88,10 +89,10 @@ func TestCreateGroupHandler_CreateGroup(t *testing.T) {
 
 	t.Run("Create group with publisher error", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("Create", mock.AnythingOfType("*domain.Group")).Return(expectedGroup, nil)
+		mockGroupRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(expectedGroup, nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
 
 		handler := NewCreateGroupHandler(mockGroupRepo, mockEventRepo)
 
@@ -100,11 +101,38 @@ func TestCreateGroupHandler_CreateGroup(t *testing.T) {
 			Name:   name.Value(),
 		}
 
-		group, err := handler.CreateGroup(cmd)
+		group, err := handler.CreateGroup(context.Background(), cmd)
 
 		assert.Error(t, err)
 		assert.Nil(t, group)
 	})
+
+	t.Run("Create group passes the context on", func(t *testing.T) {
+		type key struct{}
+		ctx := context.WithValue(context.Background(), key{}, "request")
+		withRequest := mock.MatchedBy(func(c context.Context) bool {
+			return c.Value(key{}) == "request"
+		})
+
+		mockGroupRepo := new(domain.MockGroupRepository)
+		mockGroupRepo.On("Create", withRequest, mock.AnythingOfType("*domain.Group")).Return(expectedGroup, nil)
+
+		mockEventRepo := new(ddd.MockEventPublisher)
+		mockEventRepo.On("Publish", withRequest, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+
+		handler := NewCreateGroupHandler(mockGroupRepo, mockEventRepo)
+
+		cmd := &CreateGroup{
+			UserID: userID,
+			Name:   name.Value(),
+		}
+
+		_, err := handler.CreateGroup(ctx, cmd)
+
+		assert.NoError(t, err)
+		mockGroupRepo.AssertExpectations(t)
+		mockEventRepo.AssertExpectations(t)
+	})
 }
 
 func createExpectedGroup(name *domain.Name, userID string) *domain.Group {
@@ -114,6 +142,8 @@ func createExpectedGroup(name *domain.Name, userID string) *domain.Group {
 		name,
 		make([]string, 0),
 		domain.Admin,
+		nil,
+		domain.FirstComeFirstServe,
 	)
 }
 
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+type DefineRole struct {
+	GroupID        string
+	ChangingUserID string
+	Name           string
+	Permissions    []policy.Permission
+}
+
+type DefineRoleHandler struct {
+	groups domain.GroupRepository
+}
+
+func NewDefineRoleHandler(groups domain.GroupRepository) DefineRoleHandler {
+	return DefineRoleHandler{groups}
+}
+
+func (h DefineRoleHandler) DefineRole(ctx context.Context, cmd *DefineRole) error {
+	return ddd.RetryOnConflict(conflictAttempts, func() error {
+		group, err := h.groups.FindByID(ctx, cmd.GroupID)
+		if err != nil {
+			return fmt.Errorf("defining role: %w", err)
+		}
+
+		if err := group.DefineRole(ctx, cmd.ChangingUserID, cmd.Name, cmd.Permissions); err != nil {
+			return fmt.Errorf("defining role %s: %w", cmd.Name, err)
+		}
+
+		if err := h.groups.Save(ctx, group); err != nil {
+			return fmt.Errorf("saving group after defining role: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+type DeleteRole struct {
+	GroupID        string
+	ChangingUserID string
+	Name           string
+}
+
+type DeleteRoleHandler struct {
+	groups domain.GroupRepository
+}
+
+func NewDeleteRoleHandler(groups domain.GroupRepository) DeleteRoleHandler {
+	return DeleteRoleHandler{groups}
+}
+
+func (h DeleteRoleHandler) DeleteRole(ctx context.Context, cmd *DeleteRole) error {
+	return ddd.RetryOnConflict(conflictAttempts, func() error {
+		group, err := h.groups.FindByID(ctx, cmd.GroupID)
+		if err != nil {
+			return fmt.Errorf("deleting role: %w", err)
+		}
+
+		if err := group.DeleteRole(ctx, cmd.ChangingUserID, cmd.Name); err != nil {
+			return fmt.Errorf("deleting role %s: %w", cmd.Name, err)
+		}
+
+		if err := h.groups.Save(ctx, group); err != nil {
+			return fmt.Errorf("saving group after deleting role: %w", err)
+		}
+
+		return nil
+	})
+}
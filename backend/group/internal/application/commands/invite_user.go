@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
@@ -25,21 +26,31 @@ func NewInviteUserHandler(
 	return InviteUserHandler{groups, eventPublisher}
 }
 
-func (h InviteUserHandler) InviteUser(cmd *InviteUser) error {
-	group, err := h.GroupRepository.FindByID(cmd.GroupID)
-	if err != nil {
-		return fmt.Errorf("invite user %s to group %s: %w", cmd.InvitedUserID, cmd.GroupID, err)
-	}
+func (h InviteUserHandler) InviteUser(ctx context.Context, cmd *InviteUser) error {
+	var group *domain.Group
 
-	if err := group.InviteUser(cmd.InvitedUserID, cmd.InvitingUserID); err != nil {
-		return fmt.Errorf("invite user %s to group %s: %w", cmd.InvitedUserID, cmd.GroupID, err)
-	}
+	if err := ddd.RetryOnConflict(conflictAttempts, func() error {
+		var err error
+
+		group, err = h.GroupRepository.FindByID(ctx, cmd.GroupID)
+		if err != nil {
+			return fmt.Errorf("invite user %s to group %s: %w", cmd.InvitedUserID, cmd.GroupID, err)
+		}
+
+		if err := group.InviteUser(ctx, cmd.InvitedUserID, cmd.InvitingUserID); err != nil {
+			return fmt.Errorf("invite user %s to group %s: %w", cmd.InvitedUserID, cmd.GroupID, err)
+		}
+
+		if err := h.GroupRepository.Save(ctx, group); err != nil {
+			return fmt.Errorf("save group: %w", err)
+		}
 
-	if err := h.GroupRepository.Save(group); err != nil {
-		return fmt.Errorf("save group: %w", err)
+		return nil
+	}); err != nil {
+		return err
 	}
 
-	if err := h.Publish(group.Events()...); err != nil {
+	if err := h.Publish(ctx, group.Events()...); err != nil {
 		return fmt.Errorf("publish events: %w", err)
 	}
 
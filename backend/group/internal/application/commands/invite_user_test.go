@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -22,11 +23,11 @@ func TestInviteUserHandler_InviteUser(t *testing.T) {
 
 	t.Run("Invite User Success", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
 
 		handler := NewInviteUserHandler(mockGroupRepo, mockEventRepo)
 
@@ -36,13 +37,13 @@ func TestInviteUserHandler_InviteUser(t *testing.T) {
 			InvitingUserID: invitingUserID,
 		}
 
-		err := handler.InviteUser(cmd)
+		err := handler.InviteUser(context.Background(), cmd)
 
 		assert.NoError(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", inviteMatcher(invitedUserID))
-		mockEventRepo.AssertCalled(t, "Publish", inviteEventMatcher(groupID, invitedUserID))
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, inviteMatcher(invitedUserID))
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, inviteEventMatcher(groupID, invitedUserID))
 
 		mockGroupRepo.AssertExpectations(t)
 		mockEventRepo.AssertExpectations(t)
@@ -50,8 +51,8 @@ func TestInviteUserHandler_InviteUser(t *testing.T) {
 
 	t.Run("Invite User could not save group", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(someErr)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -63,17 +64,17 @@ func TestInviteUserHandler_InviteUser(t *testing.T) {
 			InvitingUserID: invitingUserID,
 		}
 
-		err := handler.InviteUser(cmd)
+		err := handler.InviteUser(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("Invite User could not find group", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(nil, someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(nil, someErr)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -85,21 +86,21 @@ func TestInviteUserHandler_InviteUser(t *testing.T) {
 			InvitingUserID: invitingUserID,
 		}
 
-		err := handler.InviteUser(cmd)
+		err := handler.InviteUser(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("Invite User event error", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
 
 		handler := NewInviteUserHandler(mockGroupRepo, mockEventRepo)
 
@@ -109,7 +110,7 @@ func TestInviteUserHandler_InviteUser(t *testing.T) {
 			InvitingUserID: invitingUserID,
 		}
 
-		err := handler.InviteUser(cmd)
+		err := handler.InviteUser(context.Background(), cmd)
 
 		assert.Error(t, err)
 
@@ -117,9 +118,65 @@ func TestInviteUserHandler_InviteUser(t *testing.T) {
 		mockEventRepo.AssertExpectations(t)
 	})
 
+	t.Run("Invite User retries on concurrent modification", func(t *testing.T) {
+		conflict := ddd.ConcurrentModificationError{AggregateName: domain.GroupAggregate, AggregateID: groupID}
+
+		mockGroupRepo := new(domain.MockGroupRepository)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil).Once()
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil).Once()
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(conflict).Once()
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil).Once()
+
+		mockEventRepo := new(ddd.MockEventPublisher)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+
+		handler := NewInviteUserHandler(mockGroupRepo, mockEventRepo)
+
+		cmd := &InviteUser{
+			GroupID:        groupID,
+			InvitedUserID:  invitedUserID,
+			InvitingUserID: invitingUserID,
+		}
+
+		err := handler.InviteUser(context.Background(), cmd)
+
+		assert.NoError(t, err)
+
+		mockGroupRepo.AssertNumberOfCalls(t, "FindByID", 2)
+		mockGroupRepo.AssertNumberOfCalls(t, "Save", 2)
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, inviteEventMatcher(groupID, invitedUserID))
+	})
+
+	t.Run("Invite User gives up on concurrent modification", func(t *testing.T) {
+		conflict := ddd.ConcurrentModificationError{AggregateName: domain.GroupAggregate, AggregateID: groupID}
+
+		mockGroupRepo := new(domain.MockGroupRepository)
+		for range conflictAttempts {
+			mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil).Once()
+		}
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(conflict)
+
+		mockEventRepo := new(ddd.MockEventPublisher)
+
+		handler := NewInviteUserHandler(mockGroupRepo, mockEventRepo)
+
+		cmd := &InviteUser{
+			GroupID:        groupID,
+			InvitedUserID:  invitedUserID,
+			InvitingUserID: invitingUserID,
+		}
+
+		err := handler.InviteUser(context.Background(), cmd)
+
+		assert.ErrorIs(t, err, ddd.ErrConcurrentModification)
+
+		mockGroupRepo.AssertNumberOfCalls(t, "Save", conflictAttempts)
+		mockEventRepo.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
 	t.Run("Invite User domain error", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createFoundGroup(groupID, invitingUserID), nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -131,7 +188,7 @@ func TestInviteUserHandler_InviteUser(t *testing.T) {
 			InvitingUserID: "user not in group",
 		}
 
-		err := handler.InviteUser(cmd)
+		err := handler.InviteUser(context.Background(), cmd)
 
 		assert.Error(t, err)
 
@@ -164,5 +221,7 @@ func createFoundGroup(groupID, invitingUserID string) *domain.Group {
 		name,
 		make([]string, 0),
 		domain.Admin,
+		nil,
+		domain.FirstComeFirstServe,
 	)
 }
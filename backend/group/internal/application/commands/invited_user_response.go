@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
@@ -25,21 +26,31 @@ func NewInvitedUserResponseHandler(
 	return InvitedUserResponseHandler{groups, eventPublisher}
 }
 
-func (h InvitedUserResponseHandler) InvitedUserResponse(cmd *InvitedUserResponse) error {
-	group, err := h.GroupRepository.FindByID(cmd.GroupID)
-	if err != nil {
-		return fmt.Errorf("handle invited user response: %w", err)
-	}
+func (h InvitedUserResponseHandler) InvitedUserResponse(ctx context.Context, cmd *InvitedUserResponse) error {
+	var group *domain.Group
 
-	if err := group.HandleInvitedUserResponse(cmd.UserID, cmd.Accepted); err != nil {
-		return fmt.Errorf("handle invited user response: %w", err)
-	}
+	if err := ddd.RetryOnConflict(conflictAttempts, func() error {
+		var err error
 
-	if err := h.GroupRepository.Save(group); err != nil {
-		return fmt.Errorf("handle invited user response: %w", err)
+		group, err = h.GroupRepository.FindByID(ctx, cmd.GroupID)
+		if err != nil {
+			return fmt.Errorf("handle invited user response: %w", err)
+		}
+
+		if err := group.HandleInvitedUserResponse(cmd.UserID, cmd.Accepted); err != nil {
+			return fmt.Errorf("handle invited user response: %w", err)
+		}
+
+		if err := h.GroupRepository.Save(ctx, group); err != nil {
+			return fmt.Errorf("handle invited user response: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
-	if err := h.Publish(group.Events()...); err != nil {
+	if err := h.Publish(ctx, group.Events()...); err != nil {
 		return fmt.Errorf("handle invited user response: %w", err)
 	}
 
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -20,11 +21,11 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 
 	t.Run("Invited User Response true Success", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
 
 		handler := NewInvitedUserResponseHandler(mockGroupRepo, mockEventRepo)
 
@@ -34,13 +35,13 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 			Accepted: true,
 		}
 
-		err := handler.InvitedUserResponse(cmd)
+		err := handler.InvitedUserResponse(context.Background(), cmd)
 
 		assert.NoError(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", inviteResponseTrueMatcher(userID))
-		mockEventRepo.AssertCalled(t, "Publish", inviteResponseEventMatcher(groupID, userID))
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, inviteResponseTrueMatcher(userID))
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, inviteResponseEventMatcher(groupID, userID))
 
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
@@ -48,11 +49,11 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 
 	t.Run("Invited User Response false Success", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
 
 		handler := NewInvitedUserResponseHandler(mockGroupRepo, mockEventRepo)
 
@@ -62,13 +63,13 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 			Accepted: false,
 		}
 
-		err := handler.InvitedUserResponse(cmd)
+		err := handler.InvitedUserResponse(context.Background(), cmd)
 
 		assert.NoError(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", inviteResponseFalseMatcher())
-		mockEventRepo.AssertCalled(t, "Publish", []ddd.AggregateEvent{})
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, inviteResponseFalseMatcher())
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, inviteRejectedEventMatcher(groupID, userID))
 
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
@@ -76,7 +77,7 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 
 	t.Run("Invited User Response group not found", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(nil, someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(nil, someErr)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -88,19 +89,19 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 			Accepted: true,
 		}
 
-		err := handler.InvitedUserResponse(cmd)
+		err := handler.InvitedUserResponse(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("Invited User Response save error", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(someErr)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -112,12 +113,12 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 			Accepted: false,
 		}
 
-		err := handler.InvitedUserResponse(cmd)
+		err := handler.InvitedUserResponse(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", inviteResponseFalseMatcher())
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, inviteResponseFalseMatcher())
 
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
@@ -125,11 +126,11 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 
 	t.Run("Invited User Response publish fail", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
 
 		handler := NewInvitedUserResponseHandler(mockGroupRepo, mockEventRepo)
 
@@ -139,13 +140,13 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 			Accepted: false,
 		}
 
-		err := handler.InvitedUserResponse(cmd)
+		err := handler.InvitedUserResponse(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", inviteResponseFalseMatcher())
-		mockEventRepo.AssertCalled(t, "Publish", []ddd.AggregateEvent{})
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, inviteResponseFalseMatcher())
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, inviteRejectedEventMatcher(groupID, userID))
 
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
@@ -153,7 +154,7 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 
 	t.Run("Invited User Response user not invited", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createGroup(groupID, userID), nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -165,11 +166,11 @@ func TestInvitedUserResponseHandler_InvitedUserResponse(t *testing.T) {
 			Accepted: false,
 		}
 
-		err := handler.InvitedUserResponse(cmd)
+		err := handler.InvitedUserResponse(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
@@ -202,6 +203,16 @@ func inviteResponseEventMatcher(groupID, userID string) interface{} {
 	})
 }
 
+func inviteRejectedEventMatcher(groupID, userID string) interface{} {
+	return mock.MatchedBy(func(events []ddd.AggregateEvent) bool {
+		if len(events) != 1 {
+			return false
+		}
+		event, ok := events[0].Payload().(grouppb.UserRejectedInvitation)
+		return ok && event.GroupID == groupID && event.UserID == userID
+	})
+}
+
 func createGroup(groupID, invitedUserID string) *domain.Group {
 	name, _ := domain.NewName("Group Name")
 
@@ -211,5 +222,7 @@ func createGroup(groupID, invitedUserID string) *domain.Group {
 		name,
 		[]string{invitedUserID},
 		domain.Admin,
+		nil,
+		domain.FirstComeFirstServe,
 	)
 }
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
@@ -24,21 +25,31 @@ func NewLeaveGroupHandler(
 	return LeaveGroupHandler{groups, eventPublisher}
 }
 
-func (h LeaveGroupHandler) LeaveGroup(cmd *LeaveGroup) error {
-	group, err := h.GroupRepository.FindByID(cmd.GroupID)
-	if err != nil {
-		return fmt.Errorf("user is leaving a group: %w", err)
-	}
+func (h LeaveGroupHandler) LeaveGroup(ctx context.Context, cmd *LeaveGroup) error {
+	var group *domain.Group
 
-	if err := group.UserLeavesGroup(cmd.UserID); err != nil {
-		return fmt.Errorf("user is leaving a group: %w", err)
-	}
+	if err := ddd.RetryOnConflict(conflictAttempts, func() error {
+		var err error
+
+		group, err = h.GroupRepository.FindByID(ctx, cmd.GroupID)
+		if err != nil {
+			return fmt.Errorf("user is leaving a group: %w", err)
+		}
+
+		if err := group.UserLeavesGroup(cmd.UserID); err != nil {
+			return fmt.Errorf("user is leaving a group: %w", err)
+		}
+
+		if err := h.GroupRepository.Save(ctx, group); err != nil {
+			return fmt.Errorf("user is leaving a group: %w", err)
+		}
 
-	if err := h.GroupRepository.Save(group); err != nil {
-		return fmt.Errorf("user is leaving a group: %w", err)
+		return nil
+	}); err != nil {
+		return err
 	}
 
-	if err := h.eventPublisher.Publish(group.Events()...); err != nil {
+	if err := h.eventPublisher.Publish(ctx, group.Events()...); err != nil {
 		return fmt.Errorf("publish user is leaving a group event: %w", err)
 	}
 
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -20,11 +21,11 @@ func TestLeaveGroupHandler_LeaveGroup(t *testing.T) {
 
 	t.Run("Leave Group Success", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createLeaveGroup(groupID, userID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createLeaveGroup(groupID, userID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
 
 		handler := NewLeaveGroupHandler(mockGroupRepo, mockEventRepo)
 
@@ -33,13 +34,13 @@ func TestLeaveGroupHandler_LeaveGroup(t *testing.T) {
 			UserID:  userID,
 		}
 
-		err := handler.LeaveGroup(cmd)
+		err := handler.LeaveGroup(context.Background(), cmd)
 
 		assert.NoError(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", leaveGroupMatcher())
-		mockEventRepo.AssertCalled(t, "Publish", leaveGroupEventMatcher(groupID, userID))
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, leaveGroupMatcher())
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, leaveGroupEventMatcher(groupID, userID))
 
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
@@ -47,7 +48,7 @@ func TestLeaveGroupHandler_LeaveGroup(t *testing.T) {
 
 	t.Run("Leave Group group not found", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(nil, someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(nil, someErr)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -58,18 +59,18 @@ func TestLeaveGroupHandler_LeaveGroup(t *testing.T) {
 			UserID:  userID,
 		}
 
-		err := handler.LeaveGroup(cmd)
+		err := handler.LeaveGroup(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("Leave Group player not in group", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createLeaveGroup(groupID, userID), nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createLeaveGroup(groupID, userID), nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -80,19 +81,19 @@ func TestLeaveGroupHandler_LeaveGroup(t *testing.T) {
 			UserID:  "notInGroup",
 		}
 
-		err := handler.LeaveGroup(cmd)
+		err := handler.LeaveGroup(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("Leave Group save error", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createLeaveGroup(groupID, userID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createLeaveGroup(groupID, userID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(someErr)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -103,23 +104,23 @@ func TestLeaveGroupHandler_LeaveGroup(t *testing.T) {
 			UserID:  userID,
 		}
 
-		err := handler.LeaveGroup(cmd)
+		err := handler.LeaveGroup(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", leaveGroupMatcher())
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, leaveGroupMatcher())
 
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("Leave Group publish event error", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createLeaveGroup(groupID, userID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createLeaveGroup(groupID, userID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
 
 		handler := NewLeaveGroupHandler(mockGroupRepo, mockEventRepo)
 
@@ -128,13 +129,13 @@ func TestLeaveGroupHandler_LeaveGroup(t *testing.T) {
 			UserID:  userID,
 		}
 
-		err := handler.LeaveGroup(cmd)
+		err := handler.LeaveGroup(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", leaveGroupMatcher())
-		mockEventRepo.AssertCalled(t, "Publish", leaveGroupEventMatcher(groupID, userID))
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, leaveGroupMatcher())
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, leaveGroupEventMatcher(groupID, userID))
 
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
@@ -166,5 +167,7 @@ func createLeaveGroup(groupID, userID string) *domain.Group {
 		name,
 		make([]string, 0),
 		domain.Admin,
+		nil,
+		domain.FirstComeFirstServe,
 	)
 }
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
@@ -25,21 +26,31 @@ func NewRemovePlayerHandler(
 	return RemovePlayerHandler{groups, eventPublisher}
 }
 
-func (h RemovePlayerHandler) RemovePlayer(cmd *RemovePlayer) error {
-	group, err := h.GroupRepository.FindByID(cmd.GroupID)
-	if err != nil {
-		return fmt.Errorf("removing player from group: %w", err)
-	}
+func (h RemovePlayerHandler) RemovePlayer(ctx context.Context, cmd *RemovePlayer) error {
+	var group *domain.Group
 
-	if err := group.RemovePlayer(cmd.RemoveUserID, cmd.RemovingUserID); err != nil {
-		return fmt.Errorf("removing player from group: %w", err)
-	}
+	if err := ddd.RetryOnConflict(conflictAttempts, func() error {
+		var err error
+
+		group, err = h.GroupRepository.FindByID(ctx, cmd.GroupID)
+		if err != nil {
+			return fmt.Errorf("removing player from group: %w", err)
+		}
+
+		if err := group.RemovePlayer(ctx, cmd.RemoveUserID, cmd.RemovingUserID); err != nil {
+			return fmt.Errorf("removing player from group: %w", err)
+		}
+
+		if err := h.GroupRepository.Save(ctx, group); err != nil {
+			return fmt.Errorf("saving group after removing player from group: %w", err)
+		}
 
-	if err := h.GroupRepository.Save(group); err != nil {
-		return fmt.Errorf("saving group after removing player from group: %w", err)
+		return nil
+	}); err != nil {
+		return err
 	}
 
-	if err := h.Publish(group.Events()...); err != nil {
+	if err := h.Publish(ctx, group.Events()...); err != nil {
 		return fmt.Errorf("publish removing player from group event: %w", err)
 	}
 
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -21,11 +22,11 @@ func TestRemovePlayerHandler_RemovePlayer(t *testing.T) {
 
 	t.Run("remove player success", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createRemoveGroup(groupID, removeUserID, removingUserID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createRemoveGroup(groupID, removeUserID, removingUserID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(nil)
 
 		handler := NewRemovePlayerHandler(mockGroupRepo, mockEventRepo)
 
@@ -35,13 +36,13 @@ func TestRemovePlayerHandler_RemovePlayer(t *testing.T) {
 			RemovingUserID: removingUserID,
 		}
 
-		err := handler.RemovePlayer(cmd)
+		err := handler.RemovePlayer(context.Background(), cmd)
 
 		assert.NoError(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", removeGroupMatcher())
-		mockEventRepo.AssertCalled(t, "Publish", removeGroupEventMatcher(groupID, removeUserID))
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, removeGroupMatcher())
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, removeGroupEventMatcher(groupID, removeUserID))
 
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
@@ -49,7 +50,7 @@ func TestRemovePlayerHandler_RemovePlayer(t *testing.T) {
 
 	t.Run("remove player group not found", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(nil, someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(nil, someErr)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -61,18 +62,18 @@ func TestRemovePlayerHandler_RemovePlayer(t *testing.T) {
 			RemovingUserID: removingUserID,
 		}
 
-		err := handler.RemovePlayer(cmd)
+		err := handler.RemovePlayer(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("remove player not in group", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createRemoveGroup(groupID, removeUserID, removingUserID), nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createRemoveGroup(groupID, removeUserID, removingUserID), nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -84,19 +85,19 @@ func TestRemovePlayerHandler_RemovePlayer(t *testing.T) {
 			RemovingUserID: removingUserID,
 		}
 
-		err := handler.RemovePlayer(cmd)
+		err := handler.RemovePlayer(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("remove player save failed", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createRemoveGroup(groupID, removeUserID, removingUserID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createRemoveGroup(groupID, removeUserID, removingUserID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(someErr)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
 
@@ -108,23 +109,23 @@ func TestRemovePlayerHandler_RemovePlayer(t *testing.T) {
 			RemovingUserID: removingUserID,
 		}
 
-		err := handler.RemovePlayer(cmd)
+		err := handler.RemovePlayer(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", removeGroupMatcher())
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, removeGroupMatcher())
 
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("remove player publish failed", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createRemoveGroup(groupID, removeUserID, removingUserID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createRemoveGroup(groupID, removeUserID, removingUserID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		mockEventRepo := new(ddd.MockEventPublisher)
-		mockEventRepo.On("Publish", mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
+		mockEventRepo.On("Publish", mock.Anything, mock.AnythingOfType("[]ddd.AggregateEvent")).Return(someErr)
 
 		handler := NewRemovePlayerHandler(mockGroupRepo, mockEventRepo)
 
@@ -134,13 +135,13 @@ func TestRemovePlayerHandler_RemovePlayer(t *testing.T) {
 			RemovingUserID: removingUserID,
 		}
 
-		err := handler.RemovePlayer(cmd)
+		err := handler.RemovePlayer(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", removeGroupMatcher())
-		mockEventRepo.AssertCalled(t, "Publish", removeGroupEventMatcher(groupID, removeUserID))
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, removeGroupMatcher())
+		mockEventRepo.AssertCalled(t, "Publish", mock.Anything, removeGroupEventMatcher(groupID, removeUserID))
 
 		mockEventRepo.AssertExpectations(t)
 		mockGroupRepo.AssertExpectations(t)
@@ -166,6 +167,8 @@ func createRemoveGroup(groupID, removeUserID, removingUserID string) *domain.Gro
 		name,
 		[]string{},
 		domain.Admin,
+		nil,
+		domain.FirstComeFirstServe,
 	)
 }
 
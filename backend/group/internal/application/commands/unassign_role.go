@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+type UnassignRole struct {
+	GroupID        string
+	ChangingUserID string
+	UserID         string
+	Role           string
+}
+
+type UnassignRoleHandler struct {
+	groups domain.GroupRepository
+}
+
+func NewUnassignRoleHandler(groups domain.GroupRepository) UnassignRoleHandler {
+	return UnassignRoleHandler{groups}
+}
+
+func (h UnassignRoleHandler) UnassignRole(ctx context.Context, cmd *UnassignRole) error {
+	return ddd.RetryOnConflict(conflictAttempts, func() error {
+		group, err := h.groups.FindByID(ctx, cmd.GroupID)
+		if err != nil {
+			return fmt.Errorf("unassigning role: %w", err)
+		}
+
+		if err := group.UnassignRole(ctx, cmd.ChangingUserID, cmd.UserID, cmd.Role); err != nil {
+			return fmt.Errorf("unassigning role %s: %w", cmd.Role, err)
+		}
+
+		if err := h.groups.Save(ctx, group); err != nil {
+			return fmt.Errorf("saving group after unassigning role: %w", err)
+		}
+
+		return nil
+	})
+}
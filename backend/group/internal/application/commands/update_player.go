@@ -1,9 +1,11 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 )
 
 type UpdatePlayer struct {
@@ -22,24 +24,27 @@ func NewUpdatePlayerHandler(groups domain.GroupRepository) UpdatePlayerHandler {
 	return UpdatePlayerHandler{groups}
 }
 
-func (h UpdatePlayerHandler) UpdatePlayer(command *UpdatePlayer) error {
-	group, err := h.groups.FindByID(command.GroupID)
-	if err != nil {
-		return fmt.Errorf("updating player: %w", err)
-	}
-
-	if err := group.UpdatePlayer(
-		command.UpdatingUserID,
-		command.UpdatedUserID,
-		command.NewRole,
-		command.NewStatus,
-	); err != nil {
-		return fmt.Errorf("updating player role: %w", err)
-	}
-
-	if err := h.groups.Save(group); err != nil {
-		return fmt.Errorf("updating player: %w", err)
-	}
-
-	return nil
+func (h UpdatePlayerHandler) UpdatePlayer(ctx context.Context, command *UpdatePlayer) error {
+	return ddd.RetryOnConflict(conflictAttempts, func() error {
+		group, err := h.groups.FindByID(ctx, command.GroupID)
+		if err != nil {
+			return fmt.Errorf("updating player: %w", err)
+		}
+
+		if err := group.UpdatePlayer(
+			ctx,
+			command.UpdatingUserID,
+			command.UpdatedUserID,
+			command.NewRole,
+			command.NewStatus,
+		); err != nil {
+			return fmt.Errorf("updating player role: %w", err)
+		}
+
+		if err := h.groups.Save(ctx, group); err != nil {
+			return fmt.Errorf("updating player: %w", err)
+		}
+
+		return nil
+	})
 }
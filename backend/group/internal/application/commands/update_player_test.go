@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -21,8 +22,8 @@ func TestUpdatePlayerHandler_UpdatePlayer(t *testing.T) {
 
 	t.Run("update player success", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createUpdateGroup(groupID, updatedUserID, updatingUserID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createUpdateGroup(groupID, updatedUserID, updatingUserID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(nil)
 
 		handler := NewUpdatePlayerHandler(mockGroupRepo)
 
@@ -34,18 +35,18 @@ func TestUpdatePlayerHandler_UpdatePlayer(t *testing.T) {
 			NewStatus:      domain.Status(newStatus),
 		}
 
-		err := handler.UpdatePlayer(cmd)
+		err := handler.UpdatePlayer(context.Background(), cmd)
 
 		assert.NoError(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", updateGroupMatcher(domain.Role(newRole), domain.Status(newStatus)))
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, updateGroupMatcher(domain.Role(newRole), domain.Status(newStatus)))
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("update player group not found", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(nil, someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(nil, someErr)
 
 		handler := NewUpdatePlayerHandler(mockGroupRepo)
 
@@ -57,17 +58,17 @@ func TestUpdatePlayerHandler_UpdatePlayer(t *testing.T) {
 			NewStatus:      domain.Status(newStatus),
 		}
 
-		err := handler.UpdatePlayer(cmd)
+		err := handler.UpdatePlayer(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("update player not found", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createUpdateGroup(groupID, updatedUserID, updatingUserID), nil)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createUpdateGroup(groupID, updatedUserID, updatingUserID), nil)
 
 		handler := NewUpdatePlayerHandler(mockGroupRepo)
 
@@ -79,18 +80,18 @@ func TestUpdatePlayerHandler_UpdatePlayer(t *testing.T) {
 			NewStatus:      domain.Status(newStatus),
 		}
 
-		err := handler.UpdatePlayer(cmd)
+		err := handler.UpdatePlayer(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
 		mockGroupRepo.AssertExpectations(t)
 	})
 
 	t.Run("update player save error", func(t *testing.T) {
 		mockGroupRepo := new(domain.MockGroupRepository)
-		mockGroupRepo.On("FindByID", mock.AnythingOfType("string")).Return(createUpdateGroup(groupID, updatedUserID, updatingUserID), nil)
-		mockGroupRepo.On("Save", mock.AnythingOfType("*domain.Group")).Return(someErr)
+		mockGroupRepo.On("FindByID", mock.Anything, mock.AnythingOfType("string")).Return(createUpdateGroup(groupID, updatedUserID, updatingUserID), nil)
+		mockGroupRepo.On("Save", mock.Anything, mock.AnythingOfType("*domain.Group")).Return(someErr)
 
 		handler := NewUpdatePlayerHandler(mockGroupRepo)
 
@@ -102,12 +103,12 @@ func TestUpdatePlayerHandler_UpdatePlayer(t *testing.T) {
 			NewStatus:      domain.Status(newStatus),
 		}
 
-		err := handler.UpdatePlayer(cmd)
+		err := handler.UpdatePlayer(context.Background(), cmd)
 
 		assert.Error(t, err)
 
-		mockGroupRepo.AssertCalled(t, "FindByID", groupID)
-		mockGroupRepo.AssertCalled(t, "Save", updateGroupMatcher(domain.Role(newRole), domain.Status(newStatus)))
+		mockGroupRepo.AssertCalled(t, "FindByID", mock.Anything, groupID)
+		mockGroupRepo.AssertCalled(t, "Save", mock.Anything, updateGroupMatcher(domain.Role(newRole), domain.Status(newStatus)))
 		mockGroupRepo.AssertExpectations(t)
 	})
 
@@ -122,6 +123,8 @@ func createUpdateGroup(groupID, updatedUserID, updatingUserID string) *domain.Gr
 		name,
 		make([]string, 0),
 		domain.Admin,
+		nil,
+		domain.FirstComeFirstServe,
 	)
 }
 
@@ -0,0 +1,51 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+type GetActiveGroupsByUser struct {
+	UserID string
+}
+
+type GetActiveGroupsByUserHandler struct {
+	domain.GroupRepository
+}
+
+func NewGetActiveGroupsByUserHandler(groups domain.GroupRepository) GetActiveGroupsByUserHandler {
+	return GetActiveGroupsByUserHandler{groups}
+}
+
+// GetActiveGroupsByUser returns the ids of all groups the user is an active
+// player of. The groups are read page by page.
+func (h GetActiveGroupsByUserHandler) GetActiveGroupsByUser(
+	ctx context.Context,
+	cmd *GetActiveGroupsByUser,
+) ([]string, error) {
+	request := pagination.Request{Limit: pagination.MaxLimit, Sort: domain.GroupListSchema.DefaultSort}
+
+	var groupIDs []string
+
+	for {
+		page, err := h.GroupRepository.FindAllByUserID(ctx, cmd.UserID, request)
+		if err != nil {
+			return nil, fmt.Errorf("getting groups for user %s: %w", cmd.UserID, err)
+		}
+
+		for _, group := range page.Items {
+			if group.IsActivePlayer(cmd.UserID) {
+				groupIDs = append(groupIDs, group.ID())
+			}
+		}
+
+		if page.Next == nil {
+			return groupIDs, nil
+		}
+
+		request.Cursor = page.Next
+	}
+}
@@ -0,0 +1,45 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/group/internal/memory"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+func TestGetActiveGroupsByUserHandler_GetActiveGroupsByUser(t *testing.T) {
+	ctx := context.Background()
+	groups := memory.NewGroupRepository()
+
+	createGroup := func(userID, name string) string {
+		group, err := domain.CreateNewGroup(userID, name)
+		require.NoError(t, err)
+
+		created, err := groups.Create(ctx, group)
+		require.NoError(t, err)
+
+		return created.ID()
+	}
+
+	// more groups than fit on one page
+	var want []string
+	for i := range pagination.MaxLimit + 1 {
+		want = append(want, createGroup("user-1", fmt.Sprintf("Group %03d", i)))
+	}
+
+	createGroup("user-2", "Other Group")
+
+	groupIDs, err := NewGetActiveGroupsByUserHandler(groups).GetActiveGroupsByUser(
+		ctx,
+		&GetActiveGroupsByUser{UserID: "user-1"},
+	)
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, want, groupIDs)
+}
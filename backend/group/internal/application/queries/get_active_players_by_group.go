@@ -1,6 +1,7 @@
 package queries
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
@@ -18,8 +19,8 @@ func NewGetActivePlayersByGroupHandler(groups domain.GroupRepository) GetActiveP
 	return GetActivePlayersByGroupHandler{groups}
 }
 
-func (h GetActivePlayersByGroupHandler) GetActivePlayersByGroup(cmd *GetActivePlayersByGroup) ([]string, error) {
-	group, err := h.GroupRepository.FindByID(cmd.GroupID)
+func (h GetActivePlayersByGroupHandler) GetActivePlayersByGroup(ctx context.Context, cmd *GetActivePlayersByGroup) ([]string, error) {
+	group, err := h.GroupRepository.FindByID(ctx, cmd.GroupID)
 	if err != nil {
 		return nil, fmt.Errorf("getting group by id %s: %w", cmd.GroupID, err)
 	}
@@ -1,13 +1,15 @@
 package queries
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 )
 
-var ErrUserNotFound = errors.New("user not found")
+var ErrUserNotFound = ddd.NotFoundError("group.user_not_found", "user not found")
 
 type GetGroup struct {
 	GroupID string
@@ -25,13 +27,13 @@ func NewGetGroupHandler(
 	return GetGroupHandler{groups, users}
 }
 
-func (h GetGroupHandler) GetGroup(cmd *GetGroup) (*domain.GroupDetails, error) {
-	group, err := h.GroupRepository.FindByID(cmd.GroupID)
+func (h GetGroupHandler) GetGroup(ctx context.Context, cmd *GetGroup) (*domain.GroupDetails, error) {
+	group, err := h.GroupRepository.FindByID(ctx, cmd.GroupID)
 	if err != nil {
 		return nil, fmt.Errorf("getting group %s: %w", cmd.GroupID, err)
 	}
 
-	users, err := h.UserRepository.GetUserAll(group.UserIDs())
+	users, err := h.UserRepository.GetUserAll(ctx, group.UserIDs())
 	if err != nil {
 		return nil, fmt.Errorf("getting users %s: %w", group.UserIDs(), err)
 	}
@@ -40,24 +42,28 @@ func (h GetGroupHandler) GetGroup(cmd *GetGroup) (*domain.GroupDetails, error) {
 
 	for _, player := range group.Players() {
 		user, err := findUser(users, player.UserID())
-		if errors.Is(ErrUserNotFound, err) {
+		if errors.Is(err, ErrUserNotFound) {
 			notFoundUsers = append(notFoundUsers, player.UserID())
 
-			user.SetStatus("not found")
-
 			continue
 		}
 
+		if err != nil {
+			return nil, fmt.Errorf("finding user %s: %w", player.UserID(), err)
+		}
+
 		user.SetRole(player.Role().String())
 		user.SetStatus(player.Status().String())
 	}
 
 	if len(notFoundUsers) > 0 {
 		for _, user := range notFoundUsers {
-			group.UserForPlayerNotFound(user)
+			if err := group.UserForPlayerNotFound(user); err != nil {
+				return nil, fmt.Errorf("marking user %s as not found: %w", user, err)
+			}
 		}
 
-		if err := h.GroupRepository.Save(group); err != nil {
+		if err := h.GroupRepository.Save(ctx, group); err != nil {
 			return nil, fmt.Errorf("saving group %s: %w", group.ID(), err)
 		}
 	}
@@ -0,0 +1,47 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+type GetGroupHistory struct {
+	GroupID string
+	UserID  string
+}
+
+type GetGroupHistoryHandler struct {
+	domain.GroupRepository
+}
+
+func NewGetGroupHistoryHandler(groups domain.GroupRepository) GetGroupHistoryHandler {
+	return GetGroupHistoryHandler{groups}
+}
+
+// GetGroupHistory tells who changed a group and when, e.g. who assigned a
+// role. The events carry the user who made the change.
+func (h GetGroupHistoryHandler) GetGroupHistory(
+	ctx context.Context,
+	cmd *GetGroupHistory,
+) ([]ddd.AggregateEvent, error) {
+	// a group without events may exist as well, so its history is empty
+	group, err := h.GroupRepository.FindByID(ctx, cmd.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("getting history of group %s: %w", cmd.GroupID, err)
+	}
+
+	if err := group.AuthorizeHistory(ctx, cmd.UserID); err != nil {
+		return nil, fmt.Errorf("authorizing %s: %w", policy.ViewHistory, err)
+	}
+
+	history, err := h.GroupRepository.FindHistory(ctx, cmd.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("getting history of group %s: %w", cmd.GroupID, err)
+	}
+
+	return history, nil
+}
@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/group/grouppb"
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/group/internal/memory"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+func TestGetGroupHistoryHandler_GetGroupHistory(t *testing.T) {
+	ctx := context.Background()
+	groups := memory.NewGroupRepository()
+
+	group, err := domain.CreateNewGroup("user-1", "Kickers")
+	require.NoError(t, err)
+	_, err = groups.Create(ctx, group)
+	require.NoError(t, err)
+
+	query := &GetGroupHistory{GroupID: group.ID(), UserID: "user-1"}
+
+	history, err := NewGetGroupHistoryHandler(groups).GetGroupHistory(ctx, query)
+
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, grouppb.GroupCreatedEvent, history[0].EventName())
+}
+
+func TestGetGroupHistoryHandler_GetGroupHistoryOfUnknownGroup(t *testing.T) {
+	handler := NewGetGroupHistoryHandler(memory.NewGroupRepository())
+
+	_, err := handler.GetGroupHistory(context.Background(), &GetGroupHistory{GroupID: "unknown", UserID: "user-1"})
+
+	assert.ErrorIs(t, err, domain.ErrGroupNotFound)
+}
+
+func TestGetGroupHistoryHandler_GetGroupHistoryOfOtherGroup(t *testing.T) {
+	ctx := context.Background()
+	groups := memory.NewGroupRepository()
+
+	group, err := domain.CreateNewGroup("user-1", "Kickers")
+	require.NoError(t, err)
+	_, err = groups.Create(ctx, group)
+	require.NoError(t, err)
+
+	query := &GetGroupHistory{GroupID: group.ID(), UserID: "user-2"}
+
+	_, err = NewGetGroupHistoryHandler(groups).GetGroupHistory(ctx, query)
+
+	assert.ErrorIs(t, err, policy.ErrActorNotActive)
+}
@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/group/internal/memory"
+)
+
+type userRepository []*domain.User
+
+func (r userRepository) GetUser(_ context.Context, userID string) (*domain.User, error) {
+	return findUser(r, userID)
+}
+
+func (r userRepository) GetUserAll(context.Context, []string) ([]*domain.User, error) {
+	return r, nil
+}
+
+func TestGetGroupHandler_GetGroup(t *testing.T) {
+	ctx := context.Background()
+	groups := memory.NewGroupRepository()
+
+	group, err := domain.CreateNewGroup("user-1", "Kickers")
+	require.NoError(t, err)
+	_, err = groups.Create(ctx, group)
+	require.NoError(t, err)
+
+	users := userRepository{domain.NewUser("user-1", "Alice")}
+
+	details, err := NewGetGroupHandler(groups, users).GetGroup(ctx, &GetGroup{GroupID: group.ID()})
+
+	require.NoError(t, err)
+	require.Len(t, details.Users(), 1)
+	assert.Equal(t, domain.Role(domain.Master).String(), details.Users()[0].Role())
+	assert.Equal(t, domain.Status(domain.Active).String(), details.Users()[0].Status())
+}
+
+func TestGetGroupHandler_GetGroupMarksPlayersWithoutUser(t *testing.T) {
+	ctx := context.Background()
+	groups := memory.NewGroupRepository()
+
+	group, err := domain.CreateNewGroup("user-1", "Kickers")
+	require.NoError(t, err)
+	_, err = groups.Create(ctx, group)
+	require.NoError(t, err)
+
+	details, err := NewGetGroupHandler(groups, userRepository{}).GetGroup(ctx, &GetGroup{GroupID: group.ID()})
+
+	require.NoError(t, err)
+	assert.Empty(t, details.Users())
+
+	saved, err := groups.FindByID(ctx, group.ID())
+	require.NoError(t, err)
+	assert.Equal(t, domain.Status(domain.NotFound), saved.Players()[0].Status())
+}
@@ -1,13 +1,16 @@
 package queries
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 )
 
 type GetGroupsByUser struct {
 	UserID string
+	Page   pagination.Request
 }
 
 type GetGroupsByUserHandler struct {
@@ -18,25 +21,11 @@ func NewGetGroupsByUserHandler(groups domain.GroupRepository) GetGroupsByUserHan
 	return GetGroupsByUserHandler{groups}
 }
 
-func (h GetGroupsByUserHandler) GetGroups(cmd *GetGroupsByUser) ([]*domain.Group, error) {
-	groups, err := h.GroupRepository.FindAllByUserID(cmd.UserID)
+func (h GetGroupsByUserHandler) GetGroups(ctx context.Context, cmd *GetGroupsByUser) (pagination.Page[*domain.Group], error) {
+	groups, err := h.GroupRepository.FindAllByUserID(ctx, cmd.UserID, cmd.Page)
 	if err != nil {
-		return nil, fmt.Errorf("getting groups for user %s: %w", cmd.UserID, err)
+		return pagination.Page[*domain.Group]{}, fmt.Errorf("getting groups for user %s: %w", cmd.UserID, err)
 	}
 
-	filteredGroups := filterGroups(cmd.UserID, groups)
-
-	return filteredGroups, nil
-}
-
-func filterGroups(userID string, groups []*domain.Group) []*domain.Group {
-	var filteredGroups []*domain.Group
-
-	for _, group := range groups {
-		if group.IsUserParticipateInTheGroup(userID) {
-			filteredGroups = append(filteredGroups, group)
-		}
-	}
-
-	return filteredGroups
+	return groups, nil
 }
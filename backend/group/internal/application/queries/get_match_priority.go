@@ -0,0 +1,32 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+)
+
+type GetMatchPriority struct {
+	GroupID string
+}
+
+type GetMatchPriorityHandler struct {
+	groups domain.GroupRepository
+}
+
+func NewGetMatchPriorityHandler(groups domain.GroupRepository) GetMatchPriorityHandler {
+	return GetMatchPriorityHandler{groups: groups}
+}
+
+func (h GetMatchPriorityHandler) GetMatchPriority(
+	ctx context.Context,
+	cmd *GetMatchPriority,
+) (domain.MatchPriority, error) {
+	group, err := h.groups.FindByID(ctx, cmd.GroupID)
+	if err != nil {
+		return "", fmt.Errorf("getting match priority of group %s: %w", cmd.GroupID, err)
+	}
+
+	return group.MatchPriority(), nil
+}
@@ -0,0 +1,33 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+type GetPlayerAccess struct {
+	UserID  string
+	GroupID string
+}
+
+type GetPlayerAccessHandler struct {
+	groups domain.GroupRepository
+}
+
+func NewGetPlayerAccessHandler(groups domain.GroupRepository) GetPlayerAccessHandler {
+	return GetPlayerAccessHandler{groups: groups}
+}
+
+// GetPlayerAccess tells other modules how the user acts in the group, so they
+// authorize requests on its resources with one call.
+func (h GetPlayerAccessHandler) GetPlayerAccess(ctx context.Context, cmd *GetPlayerAccess) (policy.Subject, error) {
+	group, err := h.groups.FindByID(ctx, cmd.GroupID)
+	if err != nil {
+		return policy.Subject{}, fmt.Errorf("getting access to group %s: %w", cmd.GroupID, err)
+	}
+
+	return group.Subject(cmd.UserID), nil
+}
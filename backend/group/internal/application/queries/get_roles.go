@@ -0,0 +1,29 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+)
+
+type GetRoles struct {
+	GroupID string
+}
+
+type GetRolesHandler struct {
+	groups domain.GroupRepository
+}
+
+func NewGetRolesHandler(groups domain.GroupRepository) GetRolesHandler {
+	return GetRolesHandler{groups: groups}
+}
+
+func (h GetRolesHandler) GetRoles(ctx context.Context, cmd *GetRoles) ([]*domain.GroupRole, error) {
+	group, err := h.groups.FindByID(ctx, cmd.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("getting roles of group %s: %w", cmd.GroupID, err)
+	}
+
+	return group.Roles(), nil
+}
@@ -1,6 +1,10 @@
 package queries
 
-import "github.com/FSpruhs/kick-app/backend/group/internal/domain"
+import (
+	"context"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+)
 
 type HasPlayerAdminRole struct {
 	UserID  string
@@ -15,8 +19,8 @@ func NewHasPlayerAdminRoleHandler(groups domain.GroupRepository) HasPlayerAdminR
 	return HasPlayerAdminRoleHandler{groups: groups}
 }
 
-func (h HasPlayerAdminRoleHandler) HasPlayerAdminRole(cmd *HasPlayerAdminRole) bool {
-	group, err := h.groups.FindByID(cmd.GroupID)
+func (h HasPlayerAdminRoleHandler) HasPlayerAdminRole(ctx context.Context, cmd *HasPlayerAdminRole) bool {
+	group, err := h.groups.FindByID(ctx, cmd.GroupID)
 	if err != nil {
 		return false
 	}
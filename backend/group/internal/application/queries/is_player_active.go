@@ -1,6 +1,10 @@
 package queries
 
-import "github.com/FSpruhs/kick-app/backend/group/internal/domain"
+import (
+	"context"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+)
 
 type IsPlayerActive struct {
 	GroupID string
@@ -15,8 +19,8 @@ func NewIsPlayerActiveHandler(groups domain.GroupRepository) IsPlayerActiveHandl
 	return IsPlayerActiveHandler{groups}
 }
 
-func (h IsPlayerActiveHandler) IsPlayerActive(cmd *IsPlayerActive) bool {
-	group, err := h.GroupRepository.FindByID(cmd.GroupID)
+func (h IsPlayerActiveHandler) IsPlayerActive(ctx context.Context, cmd *IsPlayerActive) bool {
+	group, err := h.GroupRepository.FindByID(ctx, cmd.GroupID)
 	if err != nil {
 		return false
 	}
@@ -1,30 +1,31 @@
 package domain
 
 import (
-	"errors"
+	"context"
 	"fmt"
-	"log"
 
 	"github.com/google/uuid"
 
 	"github.com/FSpruhs/kick-app/backend/group/grouppb"
 	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
 )
 
 var (
-	ErrMasterStatusIsAlwaysActive         = errors.New("master status is always active")
-	ErrInvalidStatus                      = errors.New("invalid status for player")
-	ErrInvalidStatusForLeavingGroup       = errors.New("invalid status for leaving group")
-	ErrMasterCanNotLeaveGroup             = errors.New("master can not leave group")
-	ErrUserAlreadyInvited                 = errors.New("user is already invited")
-	ErrUserNotInGroup                     = errors.New("user is not in group")
-	ErrUserNotInvitedInGroup              = errors.New("user is not invited in group")
-	ErrInvitingPlayerRoleTooLow           = errors.New("inviting player role is too low")
-	ErrUserCanNotSelfUpgrade              = errors.New("user can not selfe update role")
-	ErrMemberCanNotUpdate                 = errors.New("members can not update")
-	ErrOnlyMasterCanDownGradeRoleToMember = errors.New("only master can downgrade role to member")
-	ErrOnlyMasterCanUpdateToMaster        = errors.New("only master can update to master")
-	ErrMasterCanNotDowngradeOtherMaster   = errors.New("master can not downgrade other master")
+	ErrMasterStatusIsAlwaysActive         = ddd.ConflictError("group.master_always_active", "master status is always active")
+	ErrInvalidStatus                      = ddd.ValidationError("group.invalid_status", "invalid status for player")
+	ErrInvalidStatusForLeavingGroup       = ddd.ConflictError("group.invalid_status_for_leaving", "invalid status for leaving group")
+	ErrMasterCanNotLeaveGroup             = ddd.ConflictError("group.master_can_not_leave", "master can not leave group")
+	ErrUserAlreadyInvited                 = ddd.ConflictError("group.user_already_invited", "user is already invited")
+	ErrUserNotInGroup                     = ddd.NotFoundError("group.user_not_in_group", "user is not in group")
+	ErrGroupNotFound                      = ddd.NotFoundError("group.not_found", "group not found")
+	ErrUserNotInvitedInGroup              = ddd.NotFoundError("group.user_not_invited", "user is not invited in group")
+	ErrInvitingPlayerRoleTooLow           = policy.ErrBelowInviteLevel
+	ErrUserCanNotSelfUpgrade              = policy.ErrSelfRoleChange
+	ErrMemberCanNotUpdate                 = policy.ErrInsufficientRole
+	ErrOnlyMasterCanDownGradeRoleToMember = policy.ErrOnlyMasterCanDowngradeToMember
+	ErrOnlyMasterCanUpdateToMaster        = policy.ErrOnlyMasterCanPromoteToMaster
+	ErrMasterCanNotDowngradeOtherMaster   = policy.ErrOnlyMasterCanChangeMaster
 )
 
 const GroupAggregate = "group.GroupAggregate"
@@ -35,36 +36,62 @@ type Group struct {
 	players        []*Player
 	invitedUserIDs []string
 	inviteLevel    Role
+	roles          []*GroupRole
+	matchPriority  MatchPriority
 }
 
-func NewGroup(id string, players []*Player, name *Name, invitedUserIDs []string, inviteLevel Role) *Group {
+// NewGroup restores a group. Groups stored before roles could be configured
+// have no roles and get the DefaultRoles of their invite level.
+func NewGroup(
+	id string,
+	players []*Player,
+	name *Name,
+	invitedUserIDs []string,
+	inviteLevel Role,
+	roles []*GroupRole,
+	matchPriority MatchPriority,
+) *Group {
+	if len(roles) == 0 {
+		roles = DefaultRoles(inviteLevel)
+	}
+
 	return &Group{
 		Aggregate:      ddd.NewAggregate(id, GroupAggregate),
 		players:        players,
 		name:           name,
 		invitedUserIDs: invitedUserIDs,
 		inviteLevel:    inviteLevel,
+		roles:          roles,
+		matchPriority:  matchPriority,
+	}
+}
+
+// NewEmptyGroup is the starting point for restoring a group from its events.
+func NewEmptyGroup(id string) *Group {
+	return &Group{
+		Aggregate:      ddd.NewAggregate(id, GroupAggregate),
+		players:        make([]*Player, 0),
+		invitedUserIDs: make([]string, 0),
+		roles:          make([]*GroupRole, 0),
 	}
 }
 
 func CreateNewGroup(userID, name string) (*Group, error) {
-	newName, err := NewName(name)
-	if err != nil {
+	if _, err := NewName(name); err != nil {
 		return nil, fmt.Errorf("create name: %w", err)
 	}
 
-	newGroup := &Group{
-		Aggregate:      ddd.NewAggregate(uuid.New().String(), GroupAggregate),
-		players:        []*Player{NewPlayer(userID, Active, Master)},
-		name:           newName,
-		invitedUserIDs: make([]string, 0),
-		inviteLevel:    Admin,
-	}
+	newGroup := NewEmptyGroup(uuid.New().String())
 
-	newGroup.AddEvent(grouppb.GroupCreatedEvent, grouppb.GroupCreated{
-		GroupID: newGroup.ID(),
-		UserIDs: newGroup.UserIDs(),
-	})
+	if err := newGroup.raise(grouppb.GroupCreatedEvent, grouppb.GroupCreated{
+		GroupID:     newGroup.ID(),
+		UserIDs:     []string{userID},
+		UserID:      userID,
+		Name:        name,
+		InviteLevel: Role(Admin).String(),
+	}); err != nil {
+		return nil, err
+	}
 
 	return newGroup, nil
 }
@@ -78,7 +105,7 @@ func (g *Group) UserIDs() []string {
 	return userIDs
 }
 
-func (g *Group) InviteUser(invitedUserID, invitingUserID string) error {
+func (g *Group) InviteUser(ctx context.Context, invitedUserID, invitingUserID string) error {
 	if contains(g.InvitedUserIDs(), invitedUserID) {
 		return ErrUserAlreadyInvited
 	}
@@ -88,19 +115,19 @@ func (g *Group) InviteUser(invitedUserID, invitingUserID string) error {
 		return err
 	}
 
-	if invitingPlayer.Role() < g.InviteLevel() {
-		return ErrInvitingPlayerRoleTooLow
+	if err := policy.Authorize(ctx, policy.Request{
+		Actor:    g.subject(invitingPlayer),
+		Action:   policy.InviteUser,
+		Resource: g.resource(),
+	}); err != nil {
+		return err
 	}
 
-	g.invitedUserIDs = append(g.InvitedUserIDs(), invitedUserID)
-
-	g.AddEvent(grouppb.UserInvitedEvent, grouppb.UserInvited{
+	return g.raise(grouppb.UserInvitedEvent, grouppb.UserInvited{
 		GroupID:   g.ID(),
 		GroupName: g.Name().Value(),
 		UserID:    invitedUserID,
 	})
-
-	return nil
 }
 
 func (g *Group) HandleInvitedUserResponse(userID string, accept bool) error {
@@ -109,12 +136,10 @@ func (g *Group) HandleInvitedUserResponse(userID string, accept bool) error {
 	}
 
 	if accept {
-		return g.acceptInvitation(userID)
+		return g.raise(grouppb.UserAcceptedInvitationEvent, grouppb.UserAcceptedInvitation{GroupID: g.ID(), UserID: userID})
 	}
 
-	g.rejectInvitation(userID)
-
-	return nil
+	return g.raise(grouppb.UserRejectedInvitationEvent, grouppb.UserRejectedInvitation{GroupID: g.ID(), UserID: userID})
 }
 
 func (g *Group) ActivePlayers() []string {
@@ -129,25 +154,12 @@ func (g *Group) ActivePlayers() []string {
 	return activePlayers
 }
 
-func (g *Group) acceptInvitation(userID string) error {
-	player, err := findPlayerByUserID(g.Players(), userID)
-	if err != nil {
-		g.players = append(g.Players(), NewPlayer(userID, Active, Member))
-	} else {
-		player.status = Active
-	}
-
-	g.invitedUserIDs = remove(g.InvitedUserIDs(), userID)
-	g.AddEvent(grouppb.UserAcceptedInvitationEvent, grouppb.UserAcceptedInvitation{GroupID: g.ID(), UserID: userID})
-
-	return nil
-}
-
-func (g *Group) rejectInvitation(userID string) {
-	g.invitedUserIDs = remove(g.InvitedUserIDs(), userID)
-}
-
-func (g *Group) UpdatePlayer(updatingUserID, updatedUserID string, newRole Role, newStatus Status) error {
+func (g *Group) UpdatePlayer(
+	ctx context.Context,
+	updatingUserID, updatedUserID string,
+	newRole Role,
+	newStatus Status,
+) error {
 	updatingPlayer, err := findPlayerByUserID(g.Players(), updatingUserID)
 	if err != nil {
 		return err
@@ -163,13 +175,13 @@ func (g *Group) UpdatePlayer(updatingUserID, updatedUserID string, newRole Role,
 	}
 
 	if updatedPlayer.Role() != newRole {
-		if err := updatePlayerRole(newRole, updatingPlayer, updatedPlayer); err != nil {
+		if err := g.updatePlayerRole(ctx, newRole, updatingPlayer, updatedPlayer); err != nil {
 			return err
 		}
 	}
 
 	if updatedPlayer.Status() != newStatus {
-		if err := updatePlayerStatus(newStatus, updatedPlayer, updatingPlayer); err != nil {
+		if err := g.updatePlayerStatus(ctx, newStatus, updatedPlayer, updatingPlayer); err != nil {
 			return err
 		}
 	}
@@ -191,23 +203,22 @@ func (g *Group) UserLeavesGroup(userID string) error {
 		return ErrInvalidStatusForLeavingGroup
 	}
 
-	player.status = Leaved
-
-	g.AddEvent(grouppb.PlayerLeavesGroupEvent, grouppb.UserLeavesGroup{
+	return g.raise(grouppb.PlayerLeavesGroupEvent, grouppb.UserLeavesGroup{
 		GroupID: g.ID(),
 		UserID:  userID,
 	})
-
-	return nil
 }
 
-func (g *Group) UserForPlayerNotFound(userID string) {
-	player, err := findPlayerByUserID(g.Players(), userID)
-	if err != nil {
-		log.Printf("player not found: %s\n", userID)
+func (g *Group) UserForPlayerNotFound(userID string) error {
+	if _, err := findPlayerByUserID(g.Players(), userID); err != nil {
+		return err
 	}
 
-	player.status = NotFound
+	return g.raise(grouppb.PlayerStatusChangedEvent, grouppb.PlayerStatusChanged{
+		GroupID: g.ID(),
+		UserID:  userID,
+		Status:  Status(NotFound).String(),
+	})
 }
 
 func (g *Group) IsUserParticipateInTheGroup(userID string) bool {
@@ -223,7 +234,7 @@ func (g *Group) IsUserParticipateInTheGroup(userID string) bool {
 	return true
 }
 
-func (g *Group) RemovePlayer(removeUserID, removingUserID string) error {
+func (g *Group) RemovePlayer(ctx context.Context, removeUserID, removingUserID string) error {
 	removePlayer, err := findPlayerByUserID(g.Players(), removeUserID)
 	if err != nil {
 		return err
@@ -234,19 +245,15 @@ func (g *Group) RemovePlayer(removeUserID, removingUserID string) error {
 		return err
 	}
 
-	if removingPlayer.Role() <= removePlayer.Role() {
-		return ErrMemberCanNotUpdate
+	if err := g.authorize(ctx, policy.RemovePlayer, removingPlayer, removePlayer); err != nil {
+		return err
 	}
 
-	removePlayer.status = Removed
-
-	g.AddEvent(grouppb.PlayerRemovedFromGroupEvent, grouppb.PlayerRemovedFromGroup{
+	return g.raise(grouppb.PlayerRemovedFromGroupEvent, grouppb.PlayerRemovedFromGroup{
 		GroupID:   g.ID(),
 		UserID:    removeUserID,
 		GroupName: g.Name().Value(),
 	})
-
-	return nil
 }
 
 func (g *Group) IsActivePlayer(userID string) bool {
@@ -267,6 +274,121 @@ func (g *Group) HasPlayerAdminRole(userID string) bool {
 	return player.Role() >= Admin
 }
 
+func (g *Group) ApplyEvent(event ddd.Event) error {
+	return g.apply(event.Payload())
+}
+
+// raise records a new event and applies it, so the state of the group is
+// always the result of its events.
+func (g *Group) raise(name string, payload ddd.EventPayload) error {
+	if err := g.apply(payload); err != nil {
+		return err
+	}
+
+	g.AddEvent(name, payload)
+
+	return nil
+}
+
+func (g *Group) apply(payload ddd.EventPayload) error {
+	switch payload := payload.(type) {
+	case grouppb.GroupCreated:
+		return g.applyGroupCreated(payload)
+	case grouppb.UserInvited:
+		g.invitedUserIDs = append(g.InvitedUserIDs(), payload.UserID)
+	case grouppb.UserAcceptedInvitation:
+		g.invitedUserIDs = remove(g.InvitedUserIDs(), payload.UserID)
+
+		if player, err := findPlayerByUserID(g.Players(), payload.UserID); err == nil {
+			player.status = Active
+		} else {
+			g.players = append(g.Players(), NewPlayer(payload.UserID, Active, Member))
+		}
+	case grouppb.UserRejectedInvitation:
+		g.invitedUserIDs = remove(g.InvitedUserIDs(), payload.UserID)
+	case grouppb.PlayerRoleChanged:
+		return g.applyPlayerRoleChanged(payload)
+	case grouppb.PlayerStatusChanged:
+		return g.applyPlayerStatusChanged(payload.UserID, payload.Status)
+	case grouppb.UserLeavesGroup:
+		return g.applyPlayerStatusChanged(payload.UserID, Status(Leaved).String())
+	case grouppb.PlayerRemovedFromGroup:
+		return g.applyPlayerStatusChanged(payload.UserID, Status(Removed).String())
+	case grouppb.RoleDefined:
+		return g.applyRoleDefined(payload)
+	case grouppb.RoleDeleted:
+		g.applyRoleDeleted(payload)
+	case grouppb.PlayerRoleAssigned:
+		return g.applyPlayerRoleAssigned(payload)
+	case grouppb.PlayerRoleUnassigned:
+		return g.applyPlayerRoleUnassigned(payload)
+	case grouppb.MatchPriorityChanged:
+		priority, err := ToMatchPriority(payload.Priority)
+		if err != nil {
+			return fmt.Errorf("create match priority: %w", err)
+		}
+
+		g.matchPriority = priority
+	default:
+		return fmt.Errorf("%T: %w", payload, ddd.ErrInvalidEventPayload)
+	}
+
+	return nil
+}
+
+func (g *Group) applyGroupCreated(payload grouppb.GroupCreated) error {
+	name, err := NewName(payload.Name)
+	if err != nil {
+		return fmt.Errorf("create name: %w", err)
+	}
+
+	inviteLevel, err := ToRole(payload.InviteLevel)
+	if err != nil {
+		return fmt.Errorf("create invite level: %w", err)
+	}
+
+	g.name = name
+	g.inviteLevel = inviteLevel
+	g.roles = DefaultRoles(inviteLevel)
+	g.matchPriority = FirstComeFirstServe
+	g.players = []*Player{NewPlayer(payload.UserID, Active, Master)}
+	g.invitedUserIDs = make([]string, 0)
+
+	return nil
+}
+
+func (g *Group) applyPlayerRoleChanged(payload grouppb.PlayerRoleChanged) error {
+	player, err := findPlayerByUserID(g.Players(), payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	role, err := ToRole(payload.Role)
+	if err != nil {
+		return err
+	}
+
+	player.role = role
+
+	return nil
+}
+
+func (g *Group) applyPlayerStatusChanged(userID, newStatus string) error {
+	player, err := findPlayerByUserID(g.Players(), userID)
+	if err != nil {
+		return err
+	}
+
+	status, err := ToStatus(newStatus)
+	if err != nil {
+		return err
+	}
+
+	player.status = status
+
+	return nil
+}
+
 func (g *Group) Players() []*Player {
 	return g.players
 }
@@ -317,43 +439,41 @@ func findPlayerByUserID(players []*Player, userID string) (*Player, error) {
 	return nil, ErrUserNotInGroup
 }
 
-func updatePlayerRole(newRole Role, updatingPlayer, updatedPlayer *Player) error {
-	if updatingPlayer.UserID() == updatedPlayer.UserID() {
-		return ErrUserCanNotSelfUpgrade
-	}
-
-	if updatingPlayer.Role() == Member {
-		return ErrMemberCanNotUpdate
+func (g *Group) updatePlayerRole(ctx context.Context, newRole Role, updatingPlayer, updatedPlayer *Player) error {
+	if err := policy.Authorize(ctx, policy.Request{
+		Actor:    g.subject(updatingPlayer),
+		Action:   policy.ChangeRole,
+		Resource: g.resource(),
+		Target:   g.subject(updatedPlayer),
+		NewRole:  policy.Role(newRole),
+	}); err != nil {
+		return err
 	}
 
-	switch newRole {
-	case Member:
-		if updatingPlayer.Role() != Master {
-			return ErrOnlyMasterCanDownGradeRoleToMember
-		}
-	case Master:
-		if updatingPlayer.Role() != Master {
-			return ErrOnlyMasterCanUpdateToMaster
-		}
-
+	if newRole == Master {
 		if notParticipatesInGroup(updatedPlayer) {
 			return ErrInvalidStatus
 		}
 
-		updatingPlayer.role = Admin
-
-	case Admin:
-		if updatedPlayer.Role() == Master && updatingPlayer.Role() != Master {
-			return ErrMasterCanNotDowngradeOtherMaster
+		if err := g.raise(grouppb.PlayerRoleChangedEvent, grouppb.PlayerRoleChanged{
+			GroupID:   g.ID(),
+			UserID:    updatingPlayer.UserID(),
+			Role:      Role(Admin).String(),
+			ChangedBy: updatingPlayer.UserID(),
+		}); err != nil {
+			return err
 		}
 	}
 
-	updatedPlayer.role = newRole
-
-	return nil
+	return g.raise(grouppb.PlayerRoleChangedEvent, grouppb.PlayerRoleChanged{
+		GroupID:   g.ID(),
+		UserID:    updatedPlayer.UserID(),
+		Role:      newRole.String(),
+		ChangedBy: updatingPlayer.UserID(),
+	})
 }
 
-func updatePlayerStatus(newStatus Status, updatedPlayer, updatingPlayer *Player) error {
+func (g *Group) updatePlayerStatus(ctx context.Context, newStatus Status, updatedPlayer, updatingPlayer *Player) error {
 	if newStatus != Active && newStatus != Inactive {
 		return ErrInvalidStatus
 	}
@@ -366,11 +486,63 @@ func updatePlayerStatus(newStatus Status, updatedPlayer, updatingPlayer *Player)
 		return ErrMasterStatusIsAlwaysActive
 	}
 
-	if updatingPlayer.UserID() != updatedPlayer.UserID() && updatingPlayer.Role() == Member {
-		return ErrMemberCanNotUpdate
+	if err := g.authorize(ctx, policy.ChangeStatus, updatingPlayer, updatedPlayer); err != nil {
+		return err
 	}
 
-	updatedPlayer.status = newStatus
+	return g.raise(grouppb.PlayerStatusChangedEvent, grouppb.PlayerStatusChanged{
+		GroupID:   g.ID(),
+		UserID:    updatedPlayer.UserID(),
+		Status:    newStatus.String(),
+		ChangedBy: updatingPlayer.UserID(),
+	})
+}
 
-	return nil
+// AuthorizeHistory lets only active players of the group see who changed it.
+// Users who are not in the group are denied like inactive players.
+func (g *Group) AuthorizeHistory(ctx context.Context, viewingUserID string) error {
+	actor := policy.Subject{UserID: viewingUserID}
+	if player, err := findPlayerByUserID(g.Players(), viewingUserID); err == nil {
+		actor = g.subject(player)
+	}
+
+	return policy.Authorize(ctx, policy.Request{
+		Actor:    actor,
+		Action:   policy.ViewHistory,
+		Resource: g.resource(),
+	})
+}
+
+func (g *Group) authorize(ctx context.Context, action policy.Action, actor, target *Player) error {
+	return policy.Authorize(ctx, policy.Request{
+		Actor:    g.subject(actor),
+		Action:   action,
+		Resource: g.resource(),
+		Target:   g.subject(target),
+	})
+}
+
+// Subject tells how the user acts in the group, e.g. for other modules which
+// authorize requests on resources of the group. Users who are not in the
+// group are inactive members without permissions.
+func (g *Group) Subject(userID string) policy.Subject {
+	player, err := findPlayerByUserID(g.Players(), userID)
+	if err != nil {
+		return policy.Subject{UserID: userID, Role: policy.RoleMember}
+	}
+
+	return g.subject(player)
+}
+
+func (g *Group) subject(player *Player) policy.Subject {
+	return policy.Subject{
+		UserID:      player.UserID(),
+		Role:        policy.Role(player.Role()),
+		Active:      player.Status() == Active,
+		Permissions: g.permissions(player),
+	}
+}
+
+func (g *Group) resource() policy.Resource {
+	return policy.Resource{Kind: policy.GroupResource, ID: g.ID()}
 }
@@ -1,8 +1,29 @@
 package domain
 
+import (
+	"context"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+// GroupListSchema are the fields the groups of a user can be sorted and
+// filtered by.
+var GroupListSchema = pagination.Schema{
+	Fields: map[string]pagination.Field{
+		"name": {Type: pagination.String, Sortable: true, Operators: []pagination.Operator{pagination.Equal, pagination.Prefix}},
+	},
+	DefaultSort: pagination.Sort{Field: "name", Descending: false},
+}
+
 type GroupRepository interface {
-	FindByID(id string) (*Group, error)
-	Save(group *Group) error
-	Create(newGroup *Group) (*Group, error)
-	FindAllByUserID(userID string) ([]*Group, error)
+	FindByID(ctx context.Context, id string) (*Group, error)
+	Save(ctx context.Context, group *Group) error
+	Create(ctx context.Context, newGroup *Group) (*Group, error)
+	// FindAllByUserID finds the groups the user is an active or inactive
+	// player of.
+	FindAllByUserID(ctx context.Context, userID string, page pagination.Request) (pagination.Page[*Group], error)
+	// FindHistory finds the events which changed the group, oldest first.
+	// Changes made before the events of groups were recorded are missing.
+	FindHistory(ctx context.Context, id string) ([]ddd.AggregateEvent, error)
 }
@@ -0,0 +1,295 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/FSpruhs/kick-app/backend/group/grouppb"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+var (
+	ErrInvalidRoleName     = ddd.ValidationError("group.invalid_role_name", "invalid role name")
+	ErrRoleNotFound        = ddd.NotFoundError("group.role_not_found", "role not found")
+	ErrBuiltInRole         = ddd.ConflictError("group.built_in_role", "built-in roles can not be deleted or assigned")
+	ErrMasterRoleIsFixed   = ddd.ConflictError("group.master_role_fixed", "permissions of master can not be changed")
+	ErrRoleAlreadyAssigned = ddd.ConflictError("group.role_already_assigned", "role is already assigned to player")
+	ErrRoleNotAssigned     = ddd.ConflictError("group.role_not_assigned", "role is not assigned to player")
+)
+
+const maxRoleNameLength = 30
+
+// GroupRole grants permissions to the players holding it. Every player holds
+// the built-in role matching its Role and any number of custom roles.
+type GroupRole struct {
+	name        string
+	permissions []policy.Permission
+}
+
+func NewGroupRole(name string, permissions []policy.Permission) (*GroupRole, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || len(name) > maxRoleNameLength {
+		return nil, ErrInvalidRoleName
+	}
+
+	granted := slices.Clone(permissions)
+	slices.Sort(granted)
+
+	return &GroupRole{name: name, permissions: slices.Compact(granted)}, nil
+}
+
+// DefaultRoles are the built-in roles with the permissions their Role granted
+// before roles could be configured.
+func DefaultRoles(inviteLevel Role) []*GroupRole {
+	roles := make([]*GroupRole, 0, Master+1)
+
+	for _, role := range []Role{Member, Admin, Master} {
+		permissions := policy.DefaultPermissions(policy.Role(role), policy.Role(inviteLevel))
+		slices.Sort(permissions)
+
+		roles = append(roles, &GroupRole{name: role.String(), permissions: permissions})
+	}
+
+	return roles
+}
+
+func (r *GroupRole) Name() string {
+	return r.name
+}
+
+func (r *GroupRole) Permissions() []policy.Permission {
+	return r.permissions
+}
+
+func (r *GroupRole) IsBuiltIn() bool {
+	_, err := ToRole(r.name)
+
+	return err == nil
+}
+
+func (g *Group) Roles() []*GroupRole {
+	return g.roles
+}
+
+// DefineRole creates a custom role or replaces the permissions of an existing
+// role. The permissions of master can not be changed.
+func (g *Group) DefineRole(ctx context.Context, changingUserID, name string, permissions []policy.Permission) error {
+	role, err := NewGroupRole(name, permissions)
+	if err != nil {
+		return err
+	}
+
+	if err := g.authorizeSettings(ctx, changingUserID); err != nil {
+		return err
+	}
+
+	if role.Name() == Role(Master).String() {
+		return ErrMasterRoleIsFixed
+	}
+
+	granted := make([]string, len(role.Permissions()))
+	for i, p := range role.Permissions() {
+		granted[i] = string(p)
+	}
+
+	return g.raise(grouppb.RoleDefinedEvent, grouppb.RoleDefined{
+		GroupID:     g.ID(),
+		Name:        role.Name(),
+		Permissions: granted,
+		ChangedBy:   changingUserID,
+	})
+}
+
+// DeleteRole deletes a custom role and takes it from all players holding it.
+func (g *Group) DeleteRole(ctx context.Context, changingUserID, name string) error {
+	role, err := g.findRole(name)
+	if err != nil {
+		return err
+	}
+
+	if role.IsBuiltIn() {
+		return ErrBuiltInRole
+	}
+
+	if err := g.authorizeSettings(ctx, changingUserID); err != nil {
+		return err
+	}
+
+	return g.raise(grouppb.RoleDeletedEvent, grouppb.RoleDeleted{
+		GroupID:   g.ID(),
+		Name:      role.Name(),
+		ChangedBy: changingUserID,
+	})
+}
+
+func (g *Group) AssignRole(ctx context.Context, changingUserID, userID, name string) error {
+	player, role, err := g.findPlayerAndCustomRole(userID, name)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(player.AssignedRoles(), role.Name()) {
+		return ErrRoleAlreadyAssigned
+	}
+
+	if notParticipatesInGroup(player) {
+		return ErrInvalidStatus
+	}
+
+	if err := g.authorizeSettings(ctx, changingUserID); err != nil {
+		return err
+	}
+
+	return g.raise(grouppb.PlayerRoleAssignedEvent, grouppb.PlayerRoleAssigned{
+		GroupID:   g.ID(),
+		UserID:    userID,
+		Role:      role.Name(),
+		ChangedBy: changingUserID,
+	})
+}
+
+func (g *Group) UnassignRole(ctx context.Context, changingUserID, userID, name string) error {
+	player, role, err := g.findPlayerAndCustomRole(userID, name)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(player.AssignedRoles(), role.Name()) {
+		return ErrRoleNotAssigned
+	}
+
+	if err := g.authorizeSettings(ctx, changingUserID); err != nil {
+		return err
+	}
+
+	return g.raise(grouppb.PlayerRoleUnassignedEvent, grouppb.PlayerRoleUnassigned{
+		GroupID:   g.ID(),
+		UserID:    userID,
+		Role:      role.Name(),
+		ChangedBy: changingUserID,
+	})
+}
+
+// Permissions returns what the player may do in the group. Players which do
+// not participate in the group may do nothing.
+func (g *Group) Permissions(userID string) []policy.Permission {
+	player, err := findPlayerByUserID(g.Players(), userID)
+	if err != nil || notParticipatesInGroup(player) {
+		return []policy.Permission{}
+	}
+
+	return g.permissions(player)
+}
+
+func (g *Group) permissions(player *Player) []policy.Permission {
+	permissions := make([]policy.Permission, 0)
+
+	for _, role := range g.Roles() {
+		if role.Name() == player.Role().String() || slices.Contains(player.AssignedRoles(), role.Name()) {
+			permissions = append(permissions, role.Permissions()...)
+		}
+	}
+
+	slices.Sort(permissions)
+
+	return slices.Compact(permissions)
+}
+
+func (g *Group) authorizeSettings(ctx context.Context, changingUserID string) error {
+	changingPlayer, err := findPlayerByUserID(g.Players(), changingUserID)
+	if err != nil {
+		return err
+	}
+
+	return policy.Authorize(ctx, policy.Request{
+		Actor:    g.subject(changingPlayer),
+		Action:   policy.EditSettings,
+		Resource: g.resource(),
+	})
+}
+
+func (g *Group) findRole(name string) (*GroupRole, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	for _, role := range g.Roles() {
+		if role.Name() == name {
+			return role, nil
+		}
+	}
+
+	return nil, ErrRoleNotFound
+}
+
+func (g *Group) findPlayerAndCustomRole(userID, name string) (*Player, *GroupRole, error) {
+	role, err := g.findRole(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if role.IsBuiltIn() {
+		return nil, nil, ErrBuiltInRole
+	}
+
+	player, err := findPlayerByUserID(g.Players(), userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return player, role, nil
+}
+
+func (g *Group) applyRoleDefined(payload grouppb.RoleDefined) error {
+	permissions, err := policy.ToPermissions(payload.Permissions)
+	if err != nil {
+		return err
+	}
+
+	role, err := NewGroupRole(payload.Name, permissions)
+	if err != nil {
+		return err
+	}
+
+	if existing, err := g.findRole(role.Name()); err == nil {
+		existing.permissions = role.Permissions()
+
+		return nil
+	}
+
+	g.roles = append(g.Roles(), role)
+
+	return nil
+}
+
+func (g *Group) applyRoleDeleted(payload grouppb.RoleDeleted) {
+	g.roles = slices.DeleteFunc(g.Roles(), func(role *GroupRole) bool {
+		return role.Name() == payload.Name
+	})
+
+	for _, player := range g.Players() {
+		player.assignedRoles = remove(player.AssignedRoles(), payload.Name)
+	}
+}
+
+func (g *Group) applyPlayerRoleAssigned(payload grouppb.PlayerRoleAssigned) error {
+	player, err := findPlayerByUserID(g.Players(), payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	player.assignedRoles = append(player.AssignedRoles(), payload.Role)
+
+	return nil
+}
+
+func (g *Group) applyPlayerRoleUnassigned(payload grouppb.PlayerRoleUnassigned) error {
+	player, err := findPlayerByUserID(g.Players(), payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	player.assignedRoles = remove(player.AssignedRoles(), payload.Role)
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FSpruhs/kick-app/backend/group/grouppb"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+func newGroupWithPlayers(t *testing.T) *Group {
+	t.Helper()
+
+	group, _ := CreateNewGroup("1", "test-group")
+	group.players = append(group.Players(),
+		NewPlayer("2", Active, Admin),
+		NewPlayer("3", Active, Member),
+		NewPlayer("4", Removed, Member),
+	)
+
+	return group
+}
+
+func TestNewGroupRole(t *testing.T) {
+	role, err := NewGroupRole(" Treasurer ", []policy.Permission{
+		policy.PermissionManageRegistrations,
+		policy.PermissionInvite,
+		policy.PermissionInvite,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "treasurer", role.Name())
+	assert.Equal(t, []policy.Permission{policy.PermissionInvite, policy.PermissionManageRegistrations}, role.Permissions())
+	assert.False(t, role.IsBuiltIn())
+}
+
+func TestNewGroupRole_InvalidName(t *testing.T) {
+	for _, name := range []string{"", "  ", "a-role-name-which-is-far-too-long"} {
+		_, err := NewGroupRole(name, nil)
+
+		assert.Equal(t, ErrInvalidRoleName, err)
+	}
+}
+
+func TestGroup_DefaultPermissions(t *testing.T) {
+	group := newGroupWithPlayers(t)
+
+	assert.Equal(t, []policy.Permission{
+		policy.PermissionCreateMatches,
+		policy.PermissionEditSettings,
+		policy.PermissionInvite,
+		policy.PermissionManageRegistrations,
+		policy.PermissionRemovePlayers,
+	}, group.Permissions("1"))
+	assert.Equal(t, []policy.Permission{
+		policy.PermissionCreateMatches,
+		policy.PermissionInvite,
+		policy.PermissionManageRegistrations,
+		policy.PermissionRemovePlayers,
+	}, group.Permissions("2"))
+	assert.Equal(t, []policy.Permission{policy.PermissionCreateMatches}, group.Permissions("3"))
+	assert.Empty(t, group.Permissions("4"))
+	assert.Empty(t, group.Permissions("unknown"))
+}
+
+func TestGroup_DefineRole(t *testing.T) {
+	group := newGroupWithPlayers(t)
+
+	permissions := []policy.Permission{policy.PermissionManageRegistrations}
+
+	err := group.DefineRole(context.Background(), "1", "Treasurer", permissions)
+
+	assert.NoError(t, err)
+	assert.Len(t, group.Roles(), 4)
+	assert.Equal(t, "treasurer", group.Roles()[3].Name())
+	assert.Equal(t, grouppb.RoleDefined{
+		GroupID:     group.ID(),
+		Name:        "treasurer",
+		Permissions: []string{"manage_registrations"},
+		ChangedBy:   "1",
+	}, group.Events()[1].Payload())
+}
+
+func TestGroup_DefineRole_ReplacesPermissions(t *testing.T) {
+	group := newGroupWithPlayers(t)
+
+	permissions := []policy.Permission{policy.PermissionCreateMatches, policy.PermissionInvite}
+
+	err := group.DefineRole(context.Background(), "1", "member", permissions)
+
+	assert.NoError(t, err)
+	assert.Len(t, group.Roles(), 3)
+	assert.Equal(t, []policy.Permission{policy.PermissionCreateMatches, policy.PermissionInvite}, group.Permissions("3"))
+	assert.NoError(t, group.InviteUser(context.Background(), "5", "3"))
+}
+
+func TestGroup_DefineRole_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		changingUserID string
+		roleName       string
+		expectedErr    error
+	}{
+		{"admin can not edit settings", "2", "treasurer", policy.ErrMissingPermission},
+		{"member can not edit settings", "3", "treasurer", policy.ErrMissingPermission},
+		{"changing user not in group", "9", "treasurer", ErrUserNotInGroup},
+		{"master is fixed", "1", "Master", ErrMasterRoleIsFixed},
+		{"invalid name", "1", "", ErrInvalidRoleName},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			group := newGroupWithPlayers(t)
+
+			permissions := []policy.Permission{policy.PermissionInvite}
+
+			err := group.DefineRole(context.Background(), test.changingUserID, test.roleName, permissions)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Len(t, group.Events(), 1)
+		})
+	}
+}
+
+func TestGroup_AssignRole(t *testing.T) {
+	group := newGroupWithPlayers(t)
+	_ = group.DefineRole(context.Background(), "1", "treasurer", []policy.Permission{policy.PermissionManageRegistrations})
+
+	err := group.AssignRole(context.Background(), "1", "3", "Treasurer")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"treasurer"}, group.Players()[2].AssignedRoles())
+	assert.Equal(t, []policy.Permission{
+		policy.PermissionCreateMatches,
+		policy.PermissionManageRegistrations,
+	}, group.Permissions("3"))
+	assert.Equal(t, ErrRoleAlreadyAssigned, group.AssignRole(context.Background(), "1", "3", "treasurer"))
+}
+
+func TestGroup_AssignRole_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		changingUserID string
+		userID         string
+		roleName       string
+		expectedErr    error
+	}{
+		{"built-in role", "1", "3", "admin", ErrBuiltInRole},
+		{"unknown role", "1", "3", "coach", ErrRoleNotFound},
+		{"player not in group", "1", "9", "treasurer", ErrUserNotInGroup},
+		{"player not participating", "1", "4", "treasurer", ErrInvalidStatus},
+		{"missing permission", "2", "3", "treasurer", policy.ErrMissingPermission},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			group := newGroupWithPlayers(t)
+			_ = group.DefineRole(context.Background(), "1", "treasurer", nil)
+
+			err := group.AssignRole(context.Background(), test.changingUserID, test.userID, test.roleName)
+
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+func TestGroup_UnassignRole(t *testing.T) {
+	group := newGroupWithPlayers(t)
+	_ = group.DefineRole(context.Background(), "1", "treasurer", []policy.Permission{policy.PermissionManageRegistrations})
+	_ = group.AssignRole(context.Background(), "1", "3", "treasurer")
+
+	err := group.UnassignRole(context.Background(), "1", "3", "treasurer")
+
+	assert.NoError(t, err)
+	assert.Empty(t, group.Players()[2].AssignedRoles())
+	assert.Equal(t, []policy.Permission{policy.PermissionCreateMatches}, group.Permissions("3"))
+	assert.Equal(t, ErrRoleNotAssigned, group.UnassignRole(context.Background(), "1", "3", "treasurer"))
+}
+
+func TestGroup_DeleteRole(t *testing.T) {
+	group := newGroupWithPlayers(t)
+	_ = group.DefineRole(context.Background(), "1", "treasurer", []policy.Permission{policy.PermissionManageRegistrations})
+	_ = group.AssignRole(context.Background(), "1", "3", "treasurer")
+
+	err := group.DeleteRole(context.Background(), "1", "treasurer")
+
+	assert.NoError(t, err)
+	assert.Len(t, group.Roles(), 3)
+	assert.Empty(t, group.Players()[2].AssignedRoles())
+	assert.Equal(t, ErrRoleNotFound, group.DeleteRole(context.Background(), "1", "treasurer"))
+	assert.Equal(t, ErrBuiltInRole, group.DeleteRole(context.Background(), "1", "member"))
+}
+
+func TestGroup_CustomRoleGrantsRemovingPlayers(t *testing.T) {
+	group := newGroupWithPlayers(t)
+	group.players = append(group.Players(), NewPlayer("5", Active, Member))
+	_ = group.DefineRole(context.Background(), "1", "organizer", []policy.Permission{policy.PermissionRemovePlayers})
+
+	assert.Equal(t, ErrMemberCanNotUpdate, group.RemovePlayer(context.Background(), "5", "3"))
+
+	_ = group.AssignRole(context.Background(), "1", "3", "organizer")
+
+	assert.NoError(t, group.RemovePlayer(context.Background(), "5", "3"))
+}
+
+func TestGroup_RolesSurviveEventsAndSnapshots(t *testing.T) {
+	group := newGroupWithPlayers(t)
+	_ = group.InviteUser(context.Background(), "5", "1")
+	_ = group.HandleInvitedUserResponse("5", true)
+	_ = group.DefineRole(context.Background(), "1", "treasurer", []policy.Permission{policy.PermissionManageRegistrations})
+	_ = group.AssignRole(context.Background(), "1", "5", "treasurer")
+
+	replayed := NewEmptyGroup(group.ID())
+	for _, event := range group.Events() {
+		assert.NoError(t, replayed.ApplyEvent(event))
+	}
+
+	restored := NewEmptyGroup(group.ID())
+	assert.NoError(t, restored.ApplySnapshot(group.ToSnapshot()))
+
+	for _, other := range []*Group{replayed, restored} {
+		assert.Equal(t, group.Roles(), other.Roles())
+		assert.Equal(t, group.Permissions("5"), other.Permissions("5"))
+	}
+}
+
+func TestGroup_ApplySnapshotWithoutRoles(t *testing.T) {
+	group := NewEmptyGroup("1")
+
+	err := group.ApplySnapshot(GroupSnapshot{
+		Name:        "test-group",
+		Players:     []PlayerSnapshot{{UserID: "1", Status: int(Active), Role: int(Member)}},
+		InviteLevel: int(Member),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultRoles(Member), group.Roles())
+	assert.Contains(t, group.Permissions("1"), policy.PermissionInvite)
+}
+
+func TestGroup_Subject(t *testing.T) {
+	group := newGroupWithPlayers(t)
+
+	master := group.Subject("1")
+	assert.Equal(t, policy.RoleMaster, master.Role)
+	assert.True(t, master.Active)
+	assert.Equal(t, group.Permissions("1"), master.Permissions)
+
+	removed := group.Subject("4")
+	assert.Equal(t, policy.RoleMember, removed.Role)
+	assert.False(t, removed.Active)
+
+	assert.Equal(t, policy.Subject{UserID: "unknown", Role: policy.RoleMember}, group.Subject("unknown"))
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+const GroupSnapshotName = "group.GroupSnapshot"
+
+type GroupSnapshot struct {
+	Name           string
+	Players        []PlayerSnapshot
+	InvitedUserIDs []string
+	InviteLevel    int
+	Roles          []RoleSnapshot
+	MatchPriority  string
+}
+
+type PlayerSnapshot struct {
+	UserID        string
+	Status        int
+	Role          int
+	AssignedRoles []string
+}
+
+type RoleSnapshot struct {
+	Name        string
+	Permissions []string
+}
+
+var _ ddd.Snapshotter = (*Group)(nil)
+
+func (GroupSnapshot) SnapshotName() string {
+	return GroupSnapshotName
+}
+
+func (g *Group) ToSnapshot() ddd.Snapshot {
+	players := make([]PlayerSnapshot, len(g.Players()))
+	for i, p := range g.Players() {
+		players[i] = PlayerSnapshot{
+			UserID:        p.UserID(),
+			Status:        int(p.Status()),
+			Role:          int(p.Role()),
+			AssignedRoles: p.AssignedRoles(),
+		}
+	}
+
+	roles := make([]RoleSnapshot, len(g.Roles()))
+	for i, r := range g.Roles() {
+		permissions := make([]string, len(r.Permissions()))
+		for j, permission := range r.Permissions() {
+			permissions[j] = string(permission)
+		}
+
+		roles[i] = RoleSnapshot{Name: r.Name(), Permissions: permissions}
+	}
+
+	return GroupSnapshot{
+		Name:           g.Name().Value(),
+		Players:        players,
+		InvitedUserIDs: g.InvitedUserIDs(),
+		InviteLevel:    int(g.InviteLevel()),
+		Roles:          roles,
+		MatchPriority:  string(g.MatchPriority()),
+	}
+}
+
+func (g *Group) ApplySnapshot(snapshot ddd.Snapshot) error {
+	groupSnapshot, ok := snapshot.(GroupSnapshot)
+	if !ok {
+		return fmt.Errorf("%T: %w", snapshot, ddd.ErrInvalidEventPayload)
+	}
+
+	name, err := NewName(groupSnapshot.Name)
+	if err != nil {
+		return fmt.Errorf("create name: %w", err)
+	}
+
+	players := make([]*Player, len(groupSnapshot.Players))
+	for i, p := range groupSnapshot.Players {
+		players[i] = NewPlayer(p.UserID, Status(p.Status), Role(p.Role), p.AssignedRoles...)
+	}
+
+	roles := make([]*GroupRole, len(groupSnapshot.Roles))
+	for i, r := range groupSnapshot.Roles {
+		permissions, err := policy.ToPermissions(r.Permissions)
+		if err != nil {
+			return fmt.Errorf("restore role %s: %w", r.Name, err)
+		}
+
+		if roles[i], err = NewGroupRole(r.Name, permissions); err != nil {
+			return fmt.Errorf("restore role %s: %w", r.Name, err)
+		}
+	}
+
+	matchPriority, err := ToMatchPriority(groupSnapshot.MatchPriority)
+	if err != nil {
+		return fmt.Errorf("create match priority: %w", err)
+	}
+
+	g.name = name
+	g.players = players
+	g.invitedUserIDs = groupSnapshot.InvitedUserIDs
+	g.inviteLevel = Role(groupSnapshot.InviteLevel)
+	g.roles = roles
+	g.matchPriority = matchPriority
+
+	// snapshots taken before roles could be configured
+	if len(g.roles) == 0 {
+		g.roles = DefaultRoles(g.inviteLevel)
+	}
+
+	if g.invitedUserIDs == nil {
+		g.invitedUserIDs = make([]string, 0)
+	}
+
+	return nil
+}
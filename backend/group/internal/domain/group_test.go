@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -12,7 +13,7 @@ import (
 func TestNewGroup(t *testing.T) {
 	groupID := "test-group"
 	name, _ := NewName("test-group")
-	group := NewGroup(groupID, []*Player{}, name, []string{}, Master)
+	group := NewGroup(groupID, []*Player{}, name, []string{}, Master, nil, FirstComeFirstServe)
 
 	assert.Equal(t, groupID, group.ID())
 }
@@ -57,7 +58,7 @@ func TestInviteUser(t *testing.T) {
 
 	group, _ := CreateNewGroup(userID, "test-group")
 
-	err := group.InviteUser(invitedUserID, userID)
+	err := group.InviteUser(context.Background(), invitedUserID, userID)
 
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(group.InvitedUserIDs()))
@@ -74,7 +75,7 @@ func TestInviteUser_UserAlreadyInvited(t *testing.T) {
 	group, _ := CreateNewGroup(userID, "test-group")
 	group.invitedUserIDs = []string{invitedUserID}
 
-	err := group.InviteUser(invitedUserID, userID)
+	err := group.InviteUser(context.Background(), invitedUserID, userID)
 
 	assert.Error(t, err)
 	assert.Equal(t, ErrUserAlreadyInvited, err)
@@ -86,7 +87,7 @@ func TestInviteUser_UserNotInGroup(t *testing.T) {
 
 	group, _ := CreateNewGroup(userID, "test-group")
 
-	err := group.InviteUser("not in group", invitedUserID)
+	err := group.InviteUser(context.Background(), "not in group", invitedUserID)
 
 	assert.Error(t, err)
 	assert.Equal(t, ErrUserNotInGroup, err)
@@ -99,7 +100,7 @@ func TestInviteUser_InvitingPlayerRoleTooLow(t *testing.T) {
 	group, _ := CreateNewGroup("", "test-group")
 	group.players = append(group.Players(), NewPlayer(userID, 0, 0))
 
-	err := group.InviteUser(invitedUserID, userID)
+	err := group.InviteUser(context.Background(), invitedUserID, userID)
 
 	assert.Error(t, err)
 	assert.Equal(t, ErrInvitingPlayerRoleTooLow, err)
@@ -161,7 +162,8 @@ func TestHandleInvitedUserResponse_DeclineInvitation(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 0, len(group.InvitedUserIDs()))
 	assert.Equal(t, 1, len(group.Players()))
-	assert.Equal(t, 1, len(group.Events()))
+	assert.Equal(t, 2, len(group.Events()))
+	assert.Equal(t, userID, group.Events()[1].Payload().(grouppb.UserRejectedInvitation).UserID)
 }
 
 func TestUpdatePlayer(t *testing.T) {
@@ -204,7 +206,13 @@ func TestUpdatePlayer(t *testing.T) {
 			updatingPlayer := NewPlayer("1", test.updatingStatus, test.updatingRole)
 			updatedPlayer := NewPlayer("2", test.updatedStatus, test.updatedRole)
 			group.players = []*Player{updatingPlayer, updatedPlayer}
-			err := group.UpdatePlayer(test.updatingUserID, test.updatedUserID, test.newRole, test.newStatus)
+			err := group.UpdatePlayer(
+				context.Background(),
+				test.updatingUserID,
+				test.updatedUserID,
+				test.newRole,
+				test.newStatus,
+			)
 
 			assert.Equal(t, test.expectedErr, err)
 
@@ -222,7 +230,7 @@ func TestUpdatePlayer_NewMaster(t *testing.T) {
 	updatedPlayer := NewPlayer("2", Active, Member)
 	group.players = append(group.Players(), updatedPlayer)
 
-	err := group.UpdatePlayer("1", "2", Master, Active)
+	err := group.UpdatePlayer(context.Background(), "1", "2", Master, Active)
 
 	assert.NoError(t, err)
 	assert.Equal(t, Role(Master), updatedPlayer.Role())
@@ -234,7 +242,7 @@ func TestUpdatePlayer_UserSetSelfToInactive(t *testing.T) {
 	updatedPlayer := NewPlayer("2", Active, Member)
 	group.players = append(group.Players(), updatedPlayer)
 
-	err := group.UpdatePlayer("2", "2", Member, Inactive)
+	err := group.UpdatePlayer(context.Background(), "2", "2", Member, Inactive)
 
 	assert.NoError(t, err)
 	assert.Equal(t, Status(Inactive), updatedPlayer.Status())
@@ -286,6 +294,24 @@ func TestUserLeavesGroup_ErrWhenNotParticipatesInGroup(t *testing.T) {
 	assert.Equal(t, ErrInvalidStatusForLeavingGroup, err)
 }
 
+func TestUserForPlayerNotFound(t *testing.T) {
+	group, _ := CreateNewGroup("1", "test-group")
+	group.players = append(group.Players(), NewPlayer("2", Active, Member))
+
+	err := group.UserForPlayerNotFound("2")
+
+	assert.NoError(t, err)
+	assert.Equal(t, Status(NotFound), group.Players()[1].Status())
+}
+
+func TestUserForPlayerNotFound_UserNotInGroup(t *testing.T) {
+	group, _ := CreateNewGroup("1", "test-group")
+
+	err := group.UserForPlayerNotFound("2")
+
+	assert.ErrorIs(t, err, ErrUserNotInGroup)
+}
+
 func TestIsUserParticipateInTheGroup(t *testing.T) {
 	tests := []struct {
 		status   Status
@@ -335,7 +361,7 @@ func TestRemovePlayer_Success(t *testing.T) {
 			group.players = append(group.Players(), removePlayer)
 			group.players = append(group.Players(), removingPlayer)
 
-			err := group.RemovePlayer("2", "3")
+			err := group.RemovePlayer(context.Background(), "2", "3")
 
 			if test.expectedErr == nil {
 				assert.NoError(t, err)
@@ -355,7 +381,7 @@ func TestRemovePlayer_Success(t *testing.T) {
 func TestRemovePlayer_RemoveUserNotInGroup(t *testing.T) {
 	group, _ := CreateNewGroup("1", "test-group")
 
-	err := group.RemovePlayer("2", "1")
+	err := group.RemovePlayer(context.Background(), "2", "1")
 
 	assert.Error(t, err)
 	assert.Equal(t, ErrUserNotInGroup, err)
@@ -364,8 +390,45 @@ func TestRemovePlayer_RemoveUserNotInGroup(t *testing.T) {
 func TestRemovePlayer_RemovingUserNotInGroup(t *testing.T) {
 	group, _ := CreateNewGroup("1", "test-group")
 
-	err := group.RemovePlayer("1", "2")
+	err := group.RemovePlayer(context.Background(), "1", "2")
 
 	assert.Error(t, err)
 	assert.Equal(t, ErrUserNotInGroup, err)
 }
+
+func TestGroup_ApplyEvents(t *testing.T) {
+	group, _ := CreateNewGroup("1", "test-group")
+	_ = group.InviteUser(context.Background(), "2", "1")
+	_ = group.HandleInvitedUserResponse("2", true)
+	_ = group.UpdatePlayer(context.Background(), "1", "2", Admin, Active)
+	_ = group.InviteUser(context.Background(), "3", "2")
+	_ = group.HandleInvitedUserResponse("3", false)
+
+	restored := NewEmptyGroup(group.ID())
+	for _, event := range group.Events() {
+		assert.NoError(t, restored.ApplyEvent(event))
+	}
+
+	assert.Equal(t, group.Name().Value(), restored.Name().Value())
+	assert.Equal(t, group.InviteLevel(), restored.InviteLevel())
+	assert.Equal(t, group.InvitedUserIDs(), restored.InvitedUserIDs())
+	assert.Equal(t, group.Players(), restored.Players())
+	assert.Equal(t, 6, group.Events()[5].AggregateVersion())
+	assert.Equal(t, 6, group.PendingVersion())
+}
+
+func TestGroup_Snapshot(t *testing.T) {
+	group, _ := CreateNewGroup("1", "test-group")
+	_ = group.InviteUser(context.Background(), "2", "1")
+	_ = group.HandleInvitedUserResponse("2", true)
+	_ = group.InviteUser(context.Background(), "3", "1")
+
+	restored := NewEmptyGroup(group.ID())
+	err := restored.ApplySnapshot(group.ToSnapshot())
+
+	assert.NoError(t, err)
+	assert.Equal(t, group.Name().Value(), restored.Name().Value())
+	assert.Equal(t, group.InviteLevel(), restored.InviteLevel())
+	assert.Equal(t, group.InvitedUserIDs(), restored.InvitedUserIDs())
+	assert.Equal(t, group.Players(), restored.Players())
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/FSpruhs/kick-app/backend/group/grouppb"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+var ErrUnknownMatchPriority = ddd.ValidationError("group.unknown_match_priority", "unknown match priority")
+
+// MatchPriority is the default strategy matches of the group use to decide
+// which players get a slot once a match is full.
+type MatchPriority string
+
+const (
+	FirstComeFirstServe MatchPriority = "firstComeFirstServe"
+	RoundRobin          MatchPriority = "roundRobin"
+	AttendanceBased     MatchPriority = "attendanceBased"
+)
+
+// ToMatchPriority returns FirstComeFirstServe for groups which never chose a
+// priority.
+func ToMatchPriority(priority string) (MatchPriority, error) {
+	switch MatchPriority(priority) {
+	case "":
+		return FirstComeFirstServe, nil
+	case FirstComeFirstServe, RoundRobin, AttendanceBased:
+		return MatchPriority(priority), nil
+	default:
+		return "", ErrUnknownMatchPriority
+	}
+}
+
+// ChangeMatchPriority sets the priority new matches of the group use unless
+// they choose their own.
+func (g *Group) ChangeMatchPriority(ctx context.Context, changingUserID string, priority MatchPriority) error {
+	if err := g.authorizeSettings(ctx, changingUserID); err != nil {
+		return err
+	}
+
+	return g.raise(grouppb.MatchPriorityChangedEvent, grouppb.MatchPriorityChanged{
+		GroupID:   g.ID(),
+		Priority:  string(priority),
+		ChangedBy: changingUserID,
+	})
+}
+
+func (g *Group) MatchPriority() MatchPriority {
+	return g.matchPriority
+}
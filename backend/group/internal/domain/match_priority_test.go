@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+func TestToMatchPriority(t *testing.T) {
+	priority, err := ToMatchPriority("")
+	assert.NoError(t, err)
+	assert.Equal(t, FirstComeFirstServe, priority)
+
+	priority, err = ToMatchPriority("roundRobin")
+	assert.NoError(t, err)
+	assert.Equal(t, RoundRobin, priority)
+
+	_, err = ToMatchPriority("lottery")
+	assert.Equal(t, ErrUnknownMatchPriority, err)
+}
+
+func TestGroup_ChangeMatchPriority(t *testing.T) {
+	group := newGroupWithPlayers(t)
+	assert.Equal(t, FirstComeFirstServe, group.MatchPriority())
+
+	assert.Equal(t, policy.ErrMissingPermission, group.ChangeMatchPriority(context.Background(), "2", AttendanceBased))
+	assert.NoError(t, group.ChangeMatchPriority(context.Background(), "1", AttendanceBased))
+	assert.Equal(t, AttendanceBased, group.MatchPriority())
+
+	restored := NewEmptyGroup(group.ID())
+	assert.NoError(t, restored.ApplySnapshot(group.ToSnapshot()))
+	assert.Equal(t, AttendanceBased, restored.MatchPriority())
+}
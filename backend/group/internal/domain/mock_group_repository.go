@@ -1,7 +1,12 @@
 package domain
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 )
 
 type MockGroupRepository struct {
@@ -10,8 +15,8 @@ type MockGroupRepository struct {
 
 var _ GroupRepository = (*MockGroupRepository)(nil)
 
-func (m *MockGroupRepository) FindByID(id string) (*Group, error) {
-	args := m.Called(id)
+func (m *MockGroupRepository) FindByID(ctx context.Context, id string) (*Group, error) {
+	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -19,13 +24,13 @@ func (m *MockGroupRepository) FindByID(id string) (*Group, error) {
 	return args.Get(0).(*Group), args.Error(1)
 }
 
-func (m *MockGroupRepository) Save(group *Group) error {
-	args := m.Called(group)
+func (m *MockGroupRepository) Save(ctx context.Context, group *Group) error {
+	args := m.Called(ctx, group)
 	return args.Error(0)
 }
 
-func (m *MockGroupRepository) Create(newGroup *Group) (*Group, error) {
-	args := m.Called(newGroup)
+func (m *MockGroupRepository) Create(ctx context.Context, newGroup *Group) (*Group, error) {
+	args := m.Called(ctx, newGroup)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -33,7 +38,20 @@ func (m *MockGroupRepository) Create(newGroup *Group) (*Group, error) {
 	return args.Get(0).(*Group), args.Error(1)
 }
 
-func (m *MockGroupRepository) FindAllByUserID(userID string) ([]*Group, error) {
+func (m *MockGroupRepository) FindAllByUserID(
+	ctx context.Context,
+	userID string,
+	page pagination.Request,
+) (pagination.Page[*Group], error) {
 	//TODO implement me
 	panic("implement me")
 }
+
+func (m *MockGroupRepository) FindHistory(ctx context.Context, id string) ([]ddd.AggregateEvent, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]ddd.AggregateEvent), args.Error(1)
+}
@@ -1,10 +1,8 @@
 package domain
 
-import (
-	"errors"
-)
+import "github.com/FSpruhs/kick-app/backend/internal/ddd"
 
-var ErrInvalidName = errors.New("invalid name")
+var ErrInvalidName = ddd.ValidationError("group.invalid_name", "invalid name")
 
 type Name struct {
 	value string
@@ -1,16 +1,24 @@
 package domain
 
 type Player struct {
-	userID string
-	status Status
-	role   Role
+	userID        string
+	status        Status
+	role          Role
+	assignedRoles []string
 }
 
-func NewPlayer(userID string, status Status, role Role) *Player {
+// NewPlayer creates a player holding the built-in role and the custom roles
+// assigned to it.
+func NewPlayer(userID string, status Status, role Role, assignedRoles ...string) *Player {
+	if assignedRoles == nil {
+		assignedRoles = make([]string, 0)
+	}
+
 	return &Player{
-		userID: userID,
-		status: status,
-		role:   role,
+		userID:        userID,
+		status:        status,
+		role:          role,
+		assignedRoles: assignedRoles,
 	}
 }
 
@@ -25,3 +33,7 @@ func (p *Player) Status() Status {
 func (p *Player) Role() Role {
 	return p.role
 }
+
+func (p *Player) AssignedRoles() []string {
+	return p.assignedRoles
+}
@@ -1,6 +1,8 @@
 package domain
 
+import "context"
+
 type PlayerRepository interface {
-	ConfirmPlayer(playerID, groupID string, inviteLevel int) error
-	ConfirmUserLeavingGroup(playerID, groupID string) error
+	ConfirmPlayer(ctx context.Context, playerID, groupID string, inviteLevel int) error
+	ConfirmUserLeavingGroup(ctx context.Context, playerID, groupID string) error
 }
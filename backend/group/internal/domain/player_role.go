@@ -1,6 +1,12 @@
 package domain
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+var ErrInvalidRole = ddd.ValidationError("group.invalid_role", "invalid player role")
 
 type InvalidPlayerRoleError struct {
 	role string
@@ -10,6 +16,10 @@ func (e InvalidPlayerRoleError) Error() string {
 	return "invalid player role: " + e.role
 }
 
+func (e InvalidPlayerRoleError) Unwrap() error {
+	return ErrInvalidRole
+}
+
 type Role int
 
 const (
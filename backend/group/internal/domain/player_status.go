@@ -10,6 +10,10 @@ func (e InvalidStatusError) Error() string {
 	return "invalid player status: " + e.role
 }
 
+func (e InvalidStatusError) Unwrap() error {
+	return ErrInvalidStatus
+}
+
 type Status int
 
 const (
@@ -30,7 +34,7 @@ func ToStatus(status string) (Status, error) {
 		return Leaved, nil
 	case "removed":
 		return Removed, nil
-	case "not_found":
+	case "not_found", "not found":
 		return NotFound, nil
 	default:
 		return -1, InvalidStatusError{status}
@@ -1,6 +1,8 @@
 package domain
 
+import "context"
+
 type UserRepository interface {
-	GetUser(userID string) (*User, error)
-	GetUserAll(userIDs []string) ([]*User, error)
+	GetUser(ctx context.Context, userID string) (*User, error)
+	GetUserAll(ctx context.Context, userIDs []string) ([]*User, error)
 }
@@ -20,8 +20,8 @@ func NewPlayerRepository(conn *grpc.ClientConn) *PlayerRepository {
 	return &PlayerRepository{client: playerspb.NewPlayersServiceClient(conn)}
 }
 
-func (r *PlayerRepository) ConfirmPlayer(playerID, groupID string, inviteLevel int) error {
-	_, err := r.client.ConfirmPlayer(context.Background(), &playerspb.ConfirmPlayerRequest{
+func (r *PlayerRepository) ConfirmPlayer(ctx context.Context, playerID, groupID string, inviteLevel int) error {
+	_, err := r.client.ConfirmPlayer(ctx, &playerspb.ConfirmPlayerRequest{
 		PlayerId:    playerID,
 		GroupId:     groupID,
 		InviteLevel: int32(inviteLevel),
@@ -33,8 +33,8 @@ func (r *PlayerRepository) ConfirmPlayer(playerID, groupID string, inviteLevel i
 	return nil
 }
 
-func (r *PlayerRepository) ConfirmUserLeavingGroup(userID, groupID string) error {
-	_, err := r.client.ConfirmGroupLeavingUser(context.Background(), &playerspb.ConfirmGroupLeavingUserRequest{
+func (r *PlayerRepository) ConfirmUserLeavingGroup(ctx context.Context, userID, groupID string) error {
+	_, err := r.client.ConfirmGroupLeavingUser(ctx, &playerspb.ConfirmGroupLeavingUserRequest{
 		UserId:  userID,
 		GroupId: groupID,
 	})
@@ -25,22 +25,22 @@ func RegisterServer(app application.App, registrar grpc.ServiceRegistrar) error
 }
 
 func (s server) IsActivePlayer(
-	_ context.Context,
+	ctx context.Context,
 	request *grouppb.IsActivePlayerRequest,
 ) (*grouppb.IsActivePlayerResponse, error) {
 	query := &queries.IsPlayerActive{UserID: request.GetUserId(), GroupID: request.GetGroupId()}
-	result := s.app.IsPlayerActive(query)
+	result := s.app.IsPlayerActive(ctx, query)
 
 	return &grouppb.IsActivePlayerResponse{IsActive: result}, nil
 }
 
 func (s server) GetActivePlayersByGroupID(
-	_ context.Context,
+	ctx context.Context,
 	request *grouppb.GetActivePlayersByGroupIDRequest,
 ) (*grouppb.GetActivePlayersByGroupIDResponse, error) {
 	query := &queries.GetActivePlayersByGroup{GroupID: request.GetGroupId()}
 
-	result, err := s.app.GetActivePlayersByGroup(query)
+	result, err := s.app.GetActivePlayersByGroup(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("get active players by group id: %w", err)
 	}
@@ -49,11 +49,62 @@ func (s server) GetActivePlayersByGroupID(
 }
 
 func (s server) HasPlayerAdminRole(
-	_ context.Context,
+	ctx context.Context,
 	request *grouppb.HasPlayerAdminRoleRequest,
 ) (*grouppb.HasPlayerAdminRoleResponse, error) {
 	query := &queries.HasPlayerAdminRole{UserID: request.GetUserId(), GroupID: request.GetGroupId()}
-	result := s.app.HasPlayerAdminRole(query)
+	result := s.app.HasPlayerAdminRole(ctx, query)
 
 	return &grouppb.HasPlayerAdminRoleResponse{HasAdminRole: result}, nil
 }
+
+func (s server) GetPlayerAccess(
+	ctx context.Context,
+	request *grouppb.GetPlayerAccessRequest,
+) (*grouppb.GetPlayerAccessResponse, error) {
+	query := &queries.GetPlayerAccess{UserID: request.GetUserId(), GroupID: request.GetGroupId()}
+
+	result, err := s.app.GetPlayerAccess(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get player access: %w", err)
+	}
+
+	permissions := make([]string, len(result.Permissions))
+	for i, permission := range result.Permissions {
+		permissions[i] = string(permission)
+	}
+
+	return &grouppb.GetPlayerAccessResponse{
+		IsActive:    result.Active,
+		Role:        result.Role.String(),
+		Permissions: permissions,
+	}, nil
+}
+
+func (s server) GetActiveGroupsByUserID(
+	ctx context.Context,
+	request *grouppb.GetActiveGroupsByUserIDRequest,
+) (*grouppb.GetActiveGroupsByUserIDResponse, error) {
+	query := &queries.GetActiveGroupsByUser{UserID: request.GetUserId()}
+
+	result, err := s.app.GetActiveGroupsByUser(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get active groups by user id: %w", err)
+	}
+
+	return &grouppb.GetActiveGroupsByUserIDResponse{GroupIds: result}, nil
+}
+
+func (s server) GetMatchPriority(
+	ctx context.Context,
+	request *grouppb.GetMatchPriorityRequest,
+) (*grouppb.GetMatchPriorityResponse, error) {
+	query := &queries.GetMatchPriority{GroupID: request.GetGroupId()}
+
+	result, err := s.app.GetMatchPriority(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get match priority: %w", err)
+	}
+
+	return &grouppb.GetMatchPriorityResponse{Priority: string(result)}, nil
+}
@@ -20,8 +20,8 @@ func NewUserRepository(conn *grpc.ClientConn) *UserRepository {
 	return &UserRepository{client: userpb.NewUserServiceClient(conn)}
 }
 
-func (r *UserRepository) GetUser(userID string) (*domain.User, error) {
-	resp, err := r.client.GetUser(context.Background(), &userpb.GetUserRequest{UserId: userID})
+func (r *UserRepository) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	resp, err := r.client.GetUser(ctx, &userpb.GetUserRequest{UserId: userID})
 	if err != nil {
 		return nil, fmt.Errorf("get user %s: %w", userID, err)
 	}
@@ -29,8 +29,8 @@ func (r *UserRepository) GetUser(userID string) (*domain.User, error) {
 	return domain.NewUser(resp.GetUserId(), resp.GetNickName()), nil
 }
 
-func (r *UserRepository) GetUserAll(userIDs []string) ([]*domain.User, error) {
-	resp, err := r.client.GetUserAll(context.Background(), &userpb.GetUserAllRequest{UserIds: userIDs})
+func (r *UserRepository) GetUserAll(ctx context.Context, userIDs []string) ([]*domain.User, error) {
+	resp, err := r.client.GetUserAll(ctx, &userpb.GetUserAllRequest{UserIds: userIDs})
 	if err != nil {
 		return nil, fmt.Errorf("get users %v: %w", userIDs, err)
 	}
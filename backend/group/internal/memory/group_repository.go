@@ -0,0 +1,159 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+type storedGroup struct {
+	snapshot domain.GroupSnapshot
+	version  int
+}
+
+// GroupRepository keeps the groups as snapshots in memory. Every group found
+// is restored from its own copy, so changes are only seen by others once the
+// group was saved. The events of the groups are kept as their history.
+type GroupRepository struct {
+	mu      sync.RWMutex
+	groups  map[string]storedGroup
+	history map[string][]ddd.AggregateEvent
+}
+
+var _ domain.GroupRepository = (*GroupRepository)(nil)
+
+func NewGroupRepository() *GroupRepository {
+	return &GroupRepository{
+		groups:  make(map[string]storedGroup),
+		history: make(map[string][]ddd.AggregateEvent),
+	}
+}
+
+func (r *GroupRepository) FindByID(_ context.Context, id string) (*domain.Group, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.groups[id]
+	if !ok {
+		return nil, fmt.Errorf("finding group %s: %w", id, domain.ErrGroupNotFound)
+	}
+
+	return restore(id, stored)
+}
+
+func (r *GroupRepository) Save(_ context.Context, group *domain.Group) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.groups[group.ID()]
+	if !ok || stored.version != group.Version() {
+		return fmt.Errorf("saving group %s: %w", group.ID(), concurrentModification(group))
+	}
+
+	r.store(group)
+
+	return nil
+}
+
+func (r *GroupRepository) Create(_ context.Context, newGroup *domain.Group) (*domain.Group, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.groups[newGroup.ID()]; ok {
+		return nil, fmt.Errorf("creating new group: %w", concurrentModification(newGroup))
+	}
+
+	r.store(newGroup)
+
+	return newGroup, nil
+}
+
+func (r *GroupRepository) FindAllByUserID(
+	_ context.Context,
+	userID string,
+	page pagination.Request,
+) (pagination.Page[*domain.Group], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	groups := make([]*domain.Group, 0)
+
+	for id, stored := range r.groups {
+		group, err := restore(id, stored)
+		if err != nil {
+			return pagination.Page[*domain.Group]{}, err
+		}
+
+		if group.IsUserParticipateInTheGroup(userID) {
+			groups = append(groups, group)
+		}
+	}
+
+	return pagination.Slice(groups, page, (*domain.Group).ID, func(group *domain.Group, _ string) any {
+		return group.Name().Value()
+	}), nil
+}
+
+func (r *GroupRepository) FindHistory(_ context.Context, id string) ([]ddd.AggregateEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.history[id]), nil
+}
+
+func (r *GroupRepository) store(group *domain.Group) {
+	snapshot, _ := group.ToSnapshot().(domain.GroupSnapshot)
+
+	r.groups[group.ID()] = storedGroup{
+		snapshot: cloneSnapshot(snapshot),
+		version:  group.PendingVersion(),
+	}
+	r.history[group.ID()] = append(r.history[group.ID()], group.Events()...)
+}
+
+func restore(id string, stored storedGroup) (*domain.Group, error) {
+	group := domain.NewEmptyGroup(id)
+
+	if err := group.ApplySnapshot(cloneSnapshot(stored.snapshot)); err != nil {
+		return nil, fmt.Errorf("restoring group %s: %w", id, err)
+	}
+
+	group.SetVersion(stored.version)
+
+	return group, nil
+}
+
+// cloneSnapshot copies the slices of snapshot, which are shared with the
+// group it was taken from.
+func cloneSnapshot(snapshot domain.GroupSnapshot) domain.GroupSnapshot {
+	players := make([]domain.PlayerSnapshot, len(snapshot.Players))
+	for i, player := range snapshot.Players {
+		player.AssignedRoles = slices.Clone(player.AssignedRoles)
+		players[i] = player
+	}
+
+	roles := make([]domain.RoleSnapshot, len(snapshot.Roles))
+	for i, role := range snapshot.Roles {
+		role.Permissions = slices.Clone(role.Permissions)
+		roles[i] = role
+	}
+
+	snapshot.Players = players
+	snapshot.Roles = roles
+	snapshot.InvitedUserIDs = slices.Clone(snapshot.InvitedUserIDs)
+
+	return snapshot
+}
+
+func concurrentModification(group *domain.Group) error {
+	return ddd.ConcurrentModificationError{
+		AggregateName: group.AggregateName(),
+		AggregateID:   group.ID(),
+		Version:       group.Version(),
+	}
+}
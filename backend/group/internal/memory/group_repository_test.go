@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/group/internal/repositorytest"
+)
+
+func TestGroupRepository(t *testing.T) {
+	repositorytest.GroupRepository(t, func(*testing.T) domain.GroupRepository {
+		return NewGroupRepository()
+	})
+}
@@ -2,6 +2,7 @@ package mongodb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,114 +10,197 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/eventstore"
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb"
+	"github.com/FSpruhs/kick-app/backend/internal/outbox"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
 )
 
 const timeout = 10 * time.Second
 
 type GroupDocument struct {
 	ID             string            `bson:"_id,omitempty"`
-	Name           string            `json:"name,omitempty"`
-	Players        []*PlayerDocument `json:"players,omitempty"`
-	InvitedUserIDs []string          `json:"invitedUserIds,omitempty"`
-	InviteLevel    string            `json:"inviteLevel,omitempty"`
+	Name           string            `bson:"name"`
+	Players        []*PlayerDocument `bson:"players"`
+	InvitedUserIDs []string          `bson:"invitedUserIds"`
+	InviteLevel    string            `bson:"inviteLevel"`
+	Roles          []*RoleDocument   `bson:"roles,omitempty"`
+	MatchPriority  string            `bson:"matchPriority,omitempty"`
+	Version        int               `bson:"version"`
 }
 
 type PlayerDocument struct {
-	UserID string `bson:"userId,omitempty"`
-	Role   string `json:"role,omitempty"`
-	Status string `json:"status,omitempty"`
+	UserID        string   `bson:"userId,omitempty"`
+	Role          string   `bson:"role"`
+	Status        string   `bson:"status"`
+	AssignedRoles []string `bson:"assignedRoles,omitempty"`
 }
 
+type RoleDocument struct {
+	Name        string   `bson:"name"`
+	Permissions []string `bson:"permissions"`
+}
+
+// GroupRepository restores groups from their events. The group documents are
+// kept as read model for the queries.
 type GroupRepository struct {
 	collection *mongo.Collection
+	store      *eventstore.Store
+	outbox     *outbox.Store
 }
 
 var _ domain.GroupRepository = (*GroupRepository)(nil)
 
-func NewGroupRepository(database *mongo.Database, collectionName string) GroupRepository {
+func NewGroupRepository(
+	database *mongo.Database,
+	collectionName string,
+	store *eventstore.Store,
+	events *outbox.Store,
+) GroupRepository {
 	collection := database.Collection(collectionName)
 
-	return GroupRepository{collection}
+	return GroupRepository{collection, store, events}
 }
 
-func (g GroupRepository) FindByID(id string) (*domain.Group, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (g GroupRepository) FindByID(ctx context.Context, id string) (*domain.Group, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	var groupDoc GroupDocument
-	if err := g.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&groupDoc); err != nil {
-		return nil, fmt.Errorf("finding group by id: %w", err)
+	ctx, span := tracing.StartSpan(ctx, "group.GroupRepository.FindByID")
+	defer span.End()
+
+	group := domain.NewEmptyGroup(id)
+
+	// groups stored before their events were recorded got a snapshot by
+	// migration 2026101809
+	err := g.store.Load(ctx, group)
+	if errors.Is(err, eventstore.ErrAggregateNotFound) {
+		return nil, fmt.Errorf("finding group %s: %w", id, domain.ErrGroupNotFound)
 	}
 
-	group, err := toDomain(&groupDoc)
 	if err != nil {
-		return nil, fmt.Errorf("mapping group document to domain: %w", err)
+		return nil, fmt.Errorf("loading group %s: %w", id, err)
 	}
 
 	return group, nil
 }
 
-func (g GroupRepository) Save(group *domain.Group) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (g GroupRepository) Save(ctx context.Context, group *domain.Group) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "group.GroupRepository.Save")
+	defer span.End()
+
 	groupDoc := toDocument(group)
 
-	_, err := g.collection.ReplaceOne(ctx, bson.M{"_id": group.ID()}, groupDoc)
-	if err != nil {
+	if err := mongodb.WithTransaction(ctx, g.collection.Database().Client(), func(txCtx mongo.SessionContext) error {
+		if err := g.store.Save(txCtx, group); err != nil {
+			return err
+		}
+
+		result, err := g.collection.ReplaceOne(txCtx, mongodb.VersionFilter(group.ID(), group.Version()), groupDoc)
+		if err != nil {
+			return fmt.Errorf("replacing group document: %w", err)
+		}
+
+		if result.MatchedCount == 0 {
+			return ddd.ConcurrentModificationError{
+				AggregateName: group.AggregateName(),
+				AggregateID:   group.ID(),
+				Version:       group.Version(),
+			}
+		}
+
+		return g.outbox.Save(txCtx, group.Events()...)
+	}); err != nil {
 		return fmt.Errorf("saving group %s: %w", group.ID(), err)
 	}
 
 	return nil
 }
 
-func (g GroupRepository) Create(newGroup *domain.Group) (*domain.Group, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (g GroupRepository) Create(ctx context.Context, newGroup *domain.Group) (*domain.Group, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "group.GroupRepository.Create")
+	defer span.End()
+
 	groupDoc := toDocument(newGroup)
 
-	_, err := g.collection.InsertOne(ctx, groupDoc)
-	if err != nil {
+	if err := mongodb.WithTransaction(ctx, g.collection.Database().Client(), func(txCtx mongo.SessionContext) error {
+		if err := g.store.Save(txCtx, newGroup); err != nil {
+			return err
+		}
+
+		if _, err := g.collection.InsertOne(txCtx, groupDoc); err != nil {
+			return fmt.Errorf("inserting group document: %w", err)
+		}
+
+		return g.outbox.Save(txCtx, newGroup.Events()...)
+	}); err != nil {
 		return nil, fmt.Errorf("creating new group group: %w", err)
 	}
 
 	return newGroup, nil
 }
 
-func (g GroupRepository) FindAllByUserID(userID string) ([]*domain.Group, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (g GroupRepository) FindAllByUserID(
+	ctx context.Context,
+	userID string,
+	page pagination.Request,
+) (pagination.Page[*domain.Group], error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	filter := bson.M{"players.userId": userID}
+	ctx, span := tracing.StartSpan(ctx, "group.GroupRepository.FindAllByUserID")
+	defer span.End()
+
+	filter := bson.M{"players": bson.M{"$elemMatch": bson.M{
+		"userId": userID,
+		"status": bson.M{"$in": bson.A{domain.Status(domain.Active).String(), domain.Status(domain.Inactive).String()}},
+	}}}
 
-	cursor, err := g.collection.Find(ctx, filter)
+	docs, err := mongodb.FindPage[GroupDocument](ctx, g.collection, filter, page, nil)
 	if err != nil {
-		return nil, fmt.Errorf("while finding groups: %w", err)
+		return pagination.Page[*domain.Group]{}, fmt.Errorf("while finding groups: %w", err)
 	}
 
-	defer func() { _ = cursor.Close(ctx) }()
-
-	var groupDocs []*GroupDocument
-	if err := cursor.All(ctx, &groupDocs); err != nil {
-		return nil, fmt.Errorf("while finding groups: %w", err)
+	groups, err := toDomains(docs.Items)
+	if err != nil {
+		return pagination.Page[*domain.Group]{}, fmt.Errorf("while mapping group documents to domains: %w", err)
 	}
 
-	groups, err := toDomains(groupDocs)
+	return pagination.WithItems(docs, groups), nil
+}
+
+func (g GroupRepository) FindHistory(ctx context.Context, id string) ([]ddd.AggregateEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "group.GroupRepository.FindHistory")
+	defer span.End()
+
+	events, err := g.store.History(ctx, domain.GroupAggregate, id)
 	if err != nil {
-		return nil, fmt.Errorf("while mapping group documents to domains: %w", err)
+		return nil, fmt.Errorf("finding history of group %s: %w", id, err)
 	}
 
-	return groups, nil
+	return events, nil
 }
 
 func toDocument(group *domain.Group) *GroupDocument {
 	players := make([]*PlayerDocument, len(group.Players()))
 	for i, p := range group.Players() {
 		players[i] = &PlayerDocument{
-			UserID: p.UserID(),
-			Role:   p.Role().String(),
-			Status: p.Status().String(),
+			UserID:        p.UserID(),
+			Role:          p.Role().String(),
+			Status:        p.Status().String(),
+			AssignedRoles: p.AssignedRoles(),
 		}
 	}
 
@@ -126,7 +210,25 @@ func toDocument(group *domain.Group) *GroupDocument {
 		Players:        players,
 		InvitedUserIDs: group.InvitedUserIDs(),
 		InviteLevel:    group.InviteLevel().String(),
+		Roles:          toRoleDocuments(group.Roles()),
+		MatchPriority:  string(group.MatchPriority()),
+		Version:        group.PendingVersion(),
+	}
+}
+
+func toRoleDocuments(roles []*domain.GroupRole) []*RoleDocument {
+	docs := make([]*RoleDocument, len(roles))
+
+	for i, role := range roles {
+		permissions := make([]string, len(role.Permissions()))
+		for j, permission := range role.Permissions() {
+			permissions[j] = string(permission)
+		}
+
+		docs[i] = &RoleDocument{Name: role.Name(), Permissions: permissions}
 	}
+
+	return docs
 }
 
 func toDomain(groupDoc *GroupDocument) (*domain.Group, error) {
@@ -148,8 +250,7 @@ func toDomain(groupDoc *GroupDocument) (*domain.Group, error) {
 			return nil, fmt.Errorf("while mapping group document do domain: %w", err)
 		}
 
-		player := domain.NewPlayer(player.UserID, status, role)
-		players[index] = player
+		players[index] = domain.NewPlayer(player.UserID, status, role, player.AssignedRoles...)
 	}
 
 	inviteLevel, err := domain.ToRole(groupDoc.InviteLevel)
@@ -157,7 +258,26 @@ func toDomain(groupDoc *GroupDocument) (*domain.Group, error) {
 		return nil, fmt.Errorf("while mapping group document do domain: %w", err)
 	}
 
-	group := domain.NewGroup(groupDoc.ID, players, name, groupDoc.InvitedUserIDs, inviteLevel)
+	roles := make([]*domain.GroupRole, len(groupDoc.Roles))
+
+	for index, roleDoc := range groupDoc.Roles {
+		permissions, err := policy.ToPermissions(roleDoc.Permissions)
+		if err != nil {
+			return nil, fmt.Errorf("while mapping group document do domain: %w", err)
+		}
+
+		if roles[index], err = domain.NewGroupRole(roleDoc.Name, permissions); err != nil {
+			return nil, fmt.Errorf("while mapping group document do domain: %w", err)
+		}
+	}
+
+	matchPriority, err := domain.ToMatchPriority(groupDoc.MatchPriority)
+	if err != nil {
+		return nil, fmt.Errorf("while mapping group document do domain: %w", err)
+	}
+
+	group := domain.NewGroup(groupDoc.ID, players, name, groupDoc.InvitedUserIDs, inviteLevel, roles, matchPriority)
+	group.SetVersion(groupDoc.Version)
 
 	return group, nil
 }
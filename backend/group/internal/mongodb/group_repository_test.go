@@ -0,0 +1,69 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/FSpruhs/kick-app/backend/group/grouppb"
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/group/internal/repositorytest"
+	"github.com/FSpruhs/kick-app/backend/internal/eventstore"
+	"github.com/FSpruhs/kick-app/backend/internal/migrations"
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+	"github.com/FSpruhs/kick-app/backend/internal/outbox"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+)
+
+func TestGroupRepository(t *testing.T) {
+	repositorytest.GroupRepository(t, func(t *testing.T) domain.GroupRepository {
+		t.Helper()
+
+		db := mongotest.NewDatabase(t)
+
+		reg := registry.New()
+		require.NoError(t, grouppb.Registrations(reg))
+		require.NoError(t, reg.Register(domain.GroupSnapshotName, domain.GroupSnapshot{}))
+
+		store := eventstore.NewStore(db, "group.events", "group.snapshots", reg)
+		require.NoError(t, store.EnsureIndexes(context.Background()))
+
+		return NewGroupRepository(db, "group.groups", store, outbox.NewStore(db, "group.outbox", reg))
+	})
+}
+
+func TestMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := mongotest.NewDatabase(t)
+	groups := db.Collection("group.groups")
+	inviteLevel := domain.Role(domain.Admin)
+
+	_, err := groups.InsertOne(ctx, bson.M{"_id": "group-1", "name": "Group", "invitelevel": inviteLevel.String()})
+	require.NoError(t, err)
+
+	migrator, err := migrations.New(db, Migrations("group.groups", "group.events", "group.snapshots"))
+	require.NoError(t, err)
+
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+
+	var doc GroupDocument
+	require.NoError(t, groups.FindOne(ctx, bson.M{"_id": "group-1"}).Decode(&doc))
+	assert.Equal(t, inviteLevel.String(), doc.InviteLevel)
+	assert.Equal(t, toRoleDocuments(domain.DefaultRoles(inviteLevel)), doc.Roles)
+
+	snapshots, err := db.Collection("group.snapshots").CountDocuments(ctx, bson.M{"aggregateId": "group-1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), snapshots)
+
+	_, err = migrator.Down(ctx, 3)
+	require.NoError(t, err)
+
+	var raw bson.M
+	require.NoError(t, groups.FindOne(ctx, bson.M{"_id": "group-1"}).Decode(&raw))
+	assert.Equal(t, inviteLevel.String(), raw["invitelevel"])
+	assert.NotContains(t, raw, "roles")
+}
@@ -0,0 +1,115 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/eventstore"
+	"github.com/FSpruhs/kick-app/backend/internal/migrations"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+)
+
+// Migrations of the group documents stored in collectionName. The fields of
+// the documents were named after their lowercased field names before. Groups
+// created before roles could be configured get the default roles of their
+// invite level, so they keep the permissions it granted. Groups stored before
+// their events were recorded get a snapshot in the event store.
+func Migrations(collectionName, eventsCollection, snapshotsCollection string) []migrations.Migration {
+	return []migrations.Migration{
+		migrations.RenameFields(2026101801, collectionName, map[string]string{
+			"inviteduserids": "invitedUserIds",
+			"invitelevel":    "inviteLevel",
+		}),
+		{
+			Version:     2026101808,
+			Description: fmt.Sprintf("store default roles of %s", collectionName),
+			Up:          setDefaultRoles(collectionName),
+			Down:        unsetRoles(collectionName),
+		},
+		{
+			Version:     2026101809,
+			Description: fmt.Sprintf("snapshot %s in %s", collectionName, snapshotsCollection),
+			Up:          snapshotGroups(collectionName, eventsCollection, snapshotsCollection),
+			// the snapshots agree with the documents, so they are kept
+			Down: func(context.Context, *mongo.Database) error { return nil },
+		},
+	}
+}
+
+// setDefaultRoles filters on the renamed inviteLevel, so it has to run after
+// 2026101801.
+func setDefaultRoles(collectionName string) migrations.Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		for _, inviteLevel := range []domain.Role{domain.Member, domain.Admin, domain.Master} {
+			filter := bson.M{"roles": bson.M{"$exists": false}, "inviteLevel": inviteLevel.String()}
+			update := bson.M{"$set": bson.M{"roles": toRoleDocuments(domain.DefaultRoles(inviteLevel))}}
+
+			if _, err := db.Collection(collectionName).UpdateMany(ctx, filter, update); err != nil {
+				return fmt.Errorf("setting roles of %s with invite level %s: %w", collectionName, inviteLevel, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// unsetRoles also drops the roles defined since, the groups fall back to the
+// default roles of their invite level.
+func unsetRoles(collectionName string) migrations.Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		update := bson.M{"$unset": bson.M{"roles": ""}}
+
+		if _, err := db.Collection(collectionName).UpdateMany(ctx, bson.M{}, update); err != nil {
+			return fmt.Errorf("unsetting roles of %s: %w", collectionName, err)
+		}
+
+		return nil
+	}
+}
+
+// snapshotGroups takes the current state of every group without snapshot as
+// the snapshot its later events build on. It runs after 2026101808, so every
+// group has its roles.
+func snapshotGroups(collectionName, eventsCollection, snapshotsCollection string) migrations.Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		reg := registry.New()
+		if err := reg.Register(domain.GroupSnapshotName, domain.GroupSnapshot{}); err != nil {
+			return fmt.Errorf("registering group snapshot: %w", err)
+		}
+
+		store := eventstore.NewStore(db, eventsCollection, snapshotsCollection, reg)
+
+		cursor, err := db.Collection(collectionName).Find(ctx, bson.M{})
+		if err != nil {
+			return fmt.Errorf("finding groups of %s: %w", collectionName, err)
+		}
+
+		defer func() { _ = cursor.Close(ctx) }()
+
+		for cursor.Next(ctx) {
+			var groupDoc GroupDocument
+			if err := cursor.Decode(&groupDoc); err != nil {
+				return fmt.Errorf("decoding group of %s: %w", collectionName, err)
+			}
+
+			group, err := toDomain(&groupDoc)
+			if err != nil {
+				return fmt.Errorf("mapping group %s: %w", groupDoc.ID, err)
+			}
+
+			if err := store.SaveInitialSnapshot(ctx, group); err != nil {
+				return err
+			}
+		}
+
+		if err := cursor.Err(); err != nil {
+			return fmt.Errorf("iterating over groups of %s: %w", collectionName, err)
+		}
+
+		return nil
+	}
+}
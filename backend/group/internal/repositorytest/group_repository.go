@@ -0,0 +1,191 @@
+package repositorytest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/group/grouppb"
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+// GroupRepository is the contract every domain.GroupRepository fulfills.
+// newRepository must return an empty repository on every call.
+func GroupRepository(t *testing.T, newRepository func(t *testing.T) domain.GroupRepository) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("finds created group", func(t *testing.T) {
+		repository := newRepository(t)
+		group := createGroup(t, repository, "user-1", "Kickers")
+
+		found, err := repository.FindByID(ctx, group.ID())
+
+		require.NoError(t, err)
+		assert.Equal(t, group.ToSnapshot(), found.ToSnapshot())
+		assert.Equal(t, group.PendingVersion(), found.Version())
+	})
+
+	t.Run("does not find unknown group", func(t *testing.T) {
+		_, err := newRepository(t).FindByID(ctx, "unknown")
+
+		assert.ErrorIs(t, err, domain.ErrGroupNotFound)
+	})
+
+	t.Run("does not create group twice", func(t *testing.T) {
+		repository := newRepository(t)
+		group := createGroup(t, repository, "user-1", "Kickers")
+
+		_, err := repository.Create(ctx, group)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("saves changes", func(t *testing.T) {
+		repository := newRepository(t)
+		group := findGroup(t, repository, createGroup(t, repository, "user-1", "Kickers").ID())
+
+		require.NoError(t, group.InviteUser(ctx, "user-2", "user-1"))
+		require.NoError(t, repository.Save(ctx, group))
+
+		found := findGroup(t, repository, group.ID())
+		assert.Equal(t, group.ToSnapshot(), found.ToSnapshot())
+		assert.Equal(t, group.PendingVersion(), found.Version())
+	})
+
+	t.Run("finds history of group", func(t *testing.T) {
+		repository := newRepository(t)
+		group := findGroup(t, repository, createGroup(t, repository, "user-1", "Kickers").ID())
+
+		require.NoError(t, group.InviteUser(ctx, "user-2", "user-1"))
+		require.NoError(t, repository.Save(ctx, group))
+
+		history, err := repository.FindHistory(ctx, group.ID())
+
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, grouppb.GroupCreatedEvent, history[0].EventName())
+		assert.Equal(t, 1, history[0].AggregateVersion())
+		assert.Equal(t, grouppb.UserInvitedEvent, history[1].EventName())
+		assert.Equal(t, 2, history[1].AggregateVersion())
+		assert.Equal(t, "user-2", history[1].Payload().(grouppb.UserInvited).UserID)
+	})
+
+	t.Run("keeps unsaved changes apart", func(t *testing.T) {
+		repository := newRepository(t)
+		group := findGroup(t, repository, createGroup(t, repository, "user-1", "Kickers").ID())
+
+		require.NoError(t, group.InviteUser(ctx, "user-2", "user-1"))
+
+		assert.Empty(t, findGroup(t, repository, group.ID()).InvitedUserIDs())
+	})
+
+	t.Run("rejects save of outdated group", func(t *testing.T) {
+		repository := newRepository(t)
+		id := createGroup(t, repository, "user-1", "Kickers").ID()
+		group, outdated := findGroup(t, repository, id), findGroup(t, repository, id)
+
+		require.NoError(t, group.InviteUser(ctx, "user-2", "user-1"))
+		require.NoError(t, repository.Save(ctx, group))
+		require.NoError(t, outdated.InviteUser(ctx, "user-3", "user-1"))
+
+		err := repository.Save(ctx, outdated)
+
+		require.ErrorIs(t, err, ddd.ErrConcurrentModification)
+		assert.Equal(t, []string{"user-2"}, findGroup(t, repository, id).InvitedUserIDs())
+	})
+
+	t.Run("saves only one of concurrent changes", func(t *testing.T) {
+		repository := newRepository(t)
+		id := createGroup(t, repository, "user-1", "Kickers").ID()
+
+		const writers = 5
+
+		var (
+			wg     sync.WaitGroup
+			mu     sync.Mutex
+			saved  int
+			groups = make([]*domain.Group, writers)
+		)
+
+		for i := range groups {
+			groups[i] = findGroup(t, repository, id)
+			require.NoError(t, groups[i].InviteUser(ctx, fmt.Sprintf("user-%d", i+2), "user-1"))
+		}
+
+		for _, group := range groups {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				if err := repository.Save(ctx, group); err == nil {
+					mu.Lock()
+					saved++
+					mu.Unlock()
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, 1, saved)
+		assert.Len(t, findGroup(t, repository, id).InvitedUserIDs(), 1)
+	})
+
+	t.Run("finds groups of user page by page", func(t *testing.T) {
+		repository := newRepository(t)
+		beta := createGroup(t, repository, "user-1", "Beta")
+		alpha := createGroup(t, repository, "user-1", "Alpha")
+		other := findGroup(t, repository, createGroup(t, repository, "user-2", "Gamma").ID())
+
+		// invited users do not participate in the group yet
+		require.NoError(t, other.InviteUser(ctx, "user-1", "user-2"))
+		require.NoError(t, repository.Save(ctx, other))
+
+		request := pagination.Request{Limit: 1, Sort: domain.GroupListSchema.DefaultSort}
+
+		first, err := repository.FindAllByUserID(ctx, "user-1", request)
+		require.NoError(t, err)
+		require.Len(t, first.Items, 1)
+		assert.Equal(t, alpha.ID(), first.Items[0].ID())
+		assert.Nil(t, first.Prev)
+		require.NotNil(t, first.Next)
+
+		request.Cursor = first.Next
+		second, err := repository.FindAllByUserID(ctx, "user-1", request)
+		require.NoError(t, err)
+		require.Len(t, second.Items, 1)
+		assert.Equal(t, beta.ID(), second.Items[0].ID())
+		assert.Nil(t, second.Next)
+		assert.NotNil(t, second.Prev)
+	})
+}
+
+func createGroup(t *testing.T, repository domain.GroupRepository, userID, name string) *domain.Group {
+	t.Helper()
+
+	group, err := domain.CreateNewGroup(userID, name)
+	require.NoError(t, err)
+
+	created, err := repository.Create(context.Background(), group)
+	require.NoError(t, err)
+
+	return created
+}
+
+func findGroup(t *testing.T, repository domain.GroupRepository, id string) *domain.Group {
+	t.Helper()
+
+	group, err := repository.FindByID(context.Background(), id)
+	require.NoError(t, err)
+
+	return group
+}
@@ -0,0 +1,65 @@
+package changematchpriority
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+)
+
+var fields = ginconfig.FieldMapping{
+	domain.ErrUnknownMatchPriority: "priority",
+}
+
+// Handle
+// ChangeMatchPriority godoc
+// @Summary      changes the match priority of a group
+// @Description  sets which players get a slot of a full match, unless the match chooses its own priority
+// @Tags         group
+// @Accept       json
+// @Produce      json
+// @Param        message  body  Message  true  "firstComeFirstServe, roundRobin or attendanceBased"
+// @Success      200
+// @Failure      400
+// @Failure      403
+// @Failure      500
+// @Router       /group/{groupId}/match-priority [put].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var message Message
+
+		if !ginconfig.BindJSON(context, &message) {
+			return
+		}
+
+		changingUserID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		priority, err := domain.ToMatchPriority(message.Priority)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, fields.Map(err))
+
+			return
+		}
+
+		command := commands.ChangeMatchPriority{
+			GroupID:        context.Param("groupId"),
+			ChangingUserID: changingUserID,
+			Priority:       priority,
+		}
+
+		if err := app.ChangeMatchPriority(context.Request.Context(), &command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, nil)
+	}
+}
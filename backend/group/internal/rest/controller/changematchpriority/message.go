@@ -0,0 +1,5 @@
+package changematchpriority
+
+type Message struct {
+	Priority string `json:"priority" validate:"required"`
+}
@@ -4,13 +4,17 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 )
 
+var fields = ginconfig.FieldMapping{
+	domain.ErrInvalidName: "name",
+}
+
 // Handle
 // CreateGroup godoc
 // @Summary      creates a new Group
@@ -20,32 +24,30 @@ import (
 // @Produce      json
 // @Success      201  {object}  Response
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /group [post].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if validationErr := validator.New().Struct(&message); validationErr != nil {
-			context.JSON(http.StatusBadRequest, context.Error(validationErr))
-
+		userID, ok := ginconfig.ActingUserID(context, message.UserID)
+		if !ok {
 			return
 		}
 
 		groupCommand := commands.CreateGroup{
 			Name:   message.Name,
-			UserID: message.UserID,
+			UserID: userID,
 		}
 
-		result, err := app.CreateGroup(&groupCommand)
+		result, err := app.CreateGroup(context.Request.Context(), &groupCommand)
 		if err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+			ginconfig.AbortWithProblem(context, fields.Map(err))
 
 			return
 		}
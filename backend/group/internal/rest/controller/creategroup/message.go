@@ -2,5 +2,5 @@ package creategroup
 
 type Message struct {
 	Name   string `json:"name,omitempty"   validate:"required"`
-	UserID string `json:"userId,omitempty" validate:"required"`
+	UserID string `json:"userId,omitempty"`
 }
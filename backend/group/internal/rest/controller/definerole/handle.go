@@ -0,0 +1,66 @@
+package definerole
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+var fields = ginconfig.FieldMapping{
+	policy.ErrUnknownPermission: "permissions",
+}
+
+// Handle
+// DefineRole godoc
+// @Summary      defines a role of a group
+// @Description  creates a custom role or replaces the permissions of an existing one
+// @Tags         group
+// @Accept       json
+// @Produce      json
+// @Param        message  body  Message  true  "permissions of the role"
+// @Success      200
+// @Failure      400
+// @Failure      403
+// @Failure      500
+// @Router       /group/{groupId}/roles/{roleName} [put].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var message Message
+
+		if !ginconfig.BindJSON(context, &message) {
+			return
+		}
+
+		changingUserID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		permissions, err := policy.ToPermissions(message.Permissions)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, fields.Map(err))
+
+			return
+		}
+
+		command := commands.DefineRole{
+			GroupID:        context.Param("groupId"),
+			ChangingUserID: changingUserID,
+			Name:           context.Param("roleName"),
+			Permissions:    permissions,
+		}
+
+		if err := app.DefineRole(context.Request.Context(), &command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, nil)
+	}
+}
@@ -0,0 +1,88 @@
+package definerole
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+type mockApp struct {
+	application.App
+	mock.Mock
+}
+
+func (m *mockApp) DefineRole(ctx context.Context, cmd *commands.DefineRole) error {
+	return m.Called(ctx, cmd).Error(0)
+}
+
+func newRouter(app application.App) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
+	router.PUT("/group/:groupId/roles/:roleName", func(c *gin.Context) {
+		c.Set(ginconfig.UserIDKey, "user-1")
+	}, Handle(app))
+
+	return router
+}
+
+func TestHandle(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    int
+	}{
+		{
+			name:    "known permissions",
+			payload: `{"permissions": ["manage_registrations", "invite"]}`,
+			want:    http.StatusOK,
+		},
+		{
+			name:    "unknown permission",
+			payload: `{"permissions": ["pay_bills"]}`,
+			want:    http.StatusBadRequest,
+		},
+		{
+			name:    "missing permissions",
+			payload: `{}`,
+			want:    http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &mockApp{}
+			app.On("DefineRole", mock.Anything, &commands.DefineRole{
+				GroupID:        "group-1",
+				ChangingUserID: "user-1",
+				Name:           "treasurer",
+				Permissions:    []policy.Permission{policy.PermissionManageRegistrations, policy.PermissionInvite},
+			}).Return(nil)
+
+			req := httptest.NewRequest(http.MethodPut, "/group/group-1/roles/treasurer", bytes.NewBufferString(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			newRouter(app).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+
+			if tt.want == http.StatusOK {
+				app.AssertExpectations(t)
+			} else {
+				app.AssertNotCalled(t, "DefineRole", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package definerole
+
+type Message struct {
+	Permissions []string `json:"permissions" validate:"required"`
+}
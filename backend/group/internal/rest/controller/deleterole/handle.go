@@ -0,0 +1,44 @@
+package deleterole
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+)
+
+// Handle
+// DeleteRole godoc
+// @Summary      deletes a custom role of a group
+// @Description  deletes a custom role of a group and takes it away from its players
+// @Tags         group
+// @Produce      json
+// @Success      200
+// @Failure      403
+// @Failure      500
+// @Router       /group/{groupId}/roles/{roleName} [delete].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		changingUserID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		command := commands.DeleteRole{
+			GroupID:        context.Param("groupId"),
+			ChangingUserID: changingUserID,
+			Name:           context.Param("roleName"),
+		}
+
+		if err := app.DeleteRole(context.Request.Context(), &command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, nil)
+	}
+}
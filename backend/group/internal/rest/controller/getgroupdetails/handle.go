@@ -8,6 +8,7 @@ import (
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/queries"
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 )
 
 // Handle
@@ -26,9 +27,9 @@ func Handle(app application.App) gin.HandlerFunc {
 
 		command := &queries.GetGroup{GroupID: groupID}
 
-		group, err := app.GetGroup(command)
+		group, err := app.GetGroup(context.Request.Context(), command)
 		if err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
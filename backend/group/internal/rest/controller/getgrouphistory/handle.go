@@ -0,0 +1,58 @@
+package getgrouphistory
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/application/queries"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+)
+
+// Handle
+// GetGroupHistory godoc
+// @Summary      get the history of a group
+// @Description  get the events which changed a group, oldest first, e.g. who assigned a role and when
+// @Tags         group
+// @Accepted     json
+// @Produce      json
+// @Success      200  {array}  	Response
+// @Failure      403
+// @Failure      404
+// @Router       /group/{groupId}/history [get].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		userID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		command := &queries.GetGroupHistory{GroupID: context.Param("groupId"), UserID: userID}
+
+		history, err := app.GetGroupHistory(context.Request.Context(), command)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, toResponse(history))
+	}
+}
+
+func toResponse(history []ddd.AggregateEvent) []*Response {
+	response := make([]*Response, len(history))
+
+	for i, event := range history {
+		response[i] = &Response{
+			Event:      event.EventName(),
+			Version:    event.AggregateVersion(),
+			OccurredAt: event.OccurredAt(),
+			Payload:    event.Payload(),
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,87 @@
+package getgrouphistory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/application/queries"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+)
+
+type mockApp struct {
+	application.App
+	mock.Mock
+}
+
+func (m *mockApp) GetGroupHistory(ctx context.Context, cmd *queries.GetGroupHistory) ([]ddd.AggregateEvent, error) {
+	args := m.Called(ctx, cmd)
+
+	history, _ := args.Get(0).([]ddd.AggregateEvent)
+
+	return history, args.Error(1)
+}
+
+func newRouter(app application.App) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
+	router.GET("/group/:groupId/history", func(c *gin.Context) {
+		c.Set(ginconfig.UserIDKey, "user-1")
+	}, Handle(app))
+
+	return router
+}
+
+func TestHandle(t *testing.T) {
+	event := ddd.RestoreAggregateEvent(
+		"event-1", "group.GroupCreated", nil, nil, time.Now(), "group-1", "group.GroupAggregate", 1,
+	)
+
+	tests := []struct {
+		name        string
+		history     []ddd.AggregateEvent
+		err         error
+		want        int
+		contentType string
+	}{
+		{
+			name:        "member",
+			history:     []ddd.AggregateEvent{event},
+			want:        http.StatusOK,
+			contentType: "application/json; charset=utf-8",
+		},
+		{
+			name:        "non member",
+			err:         fmt.Errorf("authorizing %s: %w", policy.ViewHistory, policy.ErrActorNotActive),
+			want:        http.StatusForbidden,
+			contentType: "application/problem+json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &mockApp{}
+			app.On("GetGroupHistory", mock.Anything, &queries.GetGroupHistory{GroupID: "group-1", UserID: "user-1"}).
+				Return(tt.history, tt.err)
+
+			rec := httptest.NewRecorder()
+			newRouter(app).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/group/group-1/history", nil))
+
+			assert.Equal(t, tt.want, rec.Code)
+			assert.Equal(t, tt.contentType, rec.Header().Get("Content-Type"))
+			app.AssertExpectations(t)
+		})
+	}
+}
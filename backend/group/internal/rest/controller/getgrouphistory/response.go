@@ -0,0 +1,10 @@
+package getgrouphistory
+
+import "time"
+
+type Response struct {
+	Event      string    `json:"event"`
+	Version    int       `json:"version"`
+	OccurredAt time.Time `json:"occurredAt"`
+	Payload    any       `json:"payload"`
+}
@@ -1,14 +1,15 @@
 package getgroups
 
 import (
-	"errors"
-	"log"
 	"net/http"
 
+	"github.com/gin-gonic/gin"
+
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/queries"
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
-	"github.com/gin-gonic/gin"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 )
 
 // Handle
@@ -18,39 +19,39 @@ import (
 // @Tags         group
 // @Accepted       json
 // @Produce      json
-// @Success      200  {array}  	Response
+// @Param        limit   query  int       false  "page size, 1 to 100"
+// @Param        sort    query  string    false  "name or -name"
+// @Param        filter  query  []string  false  "field:operator:value, e.g. name:prefix:Kick"
+// @Param        cursor  query  string    false  "cursor of the next or prev link"
+// @Success      200  {object}  pagination.Response[Response]
 // @Failure      400
+// @Failure      403
 // @Router       /group/user/{userId} [get].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
-		userID, exists := context.Get("userID")
-		if !exists {
-			context.JSON(http.StatusBadRequest, context.Error(errors.New("user ID not found in context")))
-
+		userID, ok := ginconfig.ActingUserID(context, context.Param("userId"))
+		if !ok {
 			return
 		}
 
-		userIDStr, ok := userID.(string)
+		page, ok := pagination.FromQuery(context, domain.GroupListSchema)
 		if !ok {
-			context.JSON(http.StatusInternalServerError, gin.H{"error": "User ID is not a string"})
-
 			return
 		}
 
-		log.Printf("User ID: %s", userIDStr)
-
 		command := &queries.GetGroupsByUser{
-			UserID: userIDStr,
+			UserID: userID,
+			Page:   page,
 		}
 
-		groups, err := app.GetGroups(command)
+		groups, err := app.GetGroups(context.Request.Context(), command)
 		if err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
 
-		context.JSON(http.StatusOK, toResponse(groups))
+		context.JSON(http.StatusOK, pagination.NewResponse(context, groups, toResponse(groups.Items)))
 	}
 }
 
@@ -0,0 +1,56 @@
+package getroles
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/application/queries"
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+)
+
+// Handle
+// GetRoles godoc
+// @Summary      get the roles of a group
+// @Description  get the roles of a group with their permissions
+// @Tags         group
+// @Accepted     json
+// @Produce      json
+// @Success      200  {array}  	Response
+// @Failure      400
+// @Router       /group/{groupId}/roles [get].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		command := &queries.GetRoles{GroupID: context.Param("groupId")}
+
+		roles, err := app.GetRoles(context.Request.Context(), command)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, toResponse(roles))
+	}
+}
+
+func toResponse(roles []*domain.GroupRole) []*Response {
+	response := make([]*Response, len(roles))
+
+	for i, role := range roles {
+		permissions := make([]string, len(role.Permissions()))
+		for j, permission := range role.Permissions() {
+			permissions[j] = string(permission)
+		}
+
+		response[i] = &Response{
+			Name:        role.Name(),
+			Permissions: permissions,
+			BuiltIn:     role.IsBuiltIn(),
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,7 @@
+package getroles
+
+type Response struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+	BuiltIn     bool     `json:"builtIn"`
+}
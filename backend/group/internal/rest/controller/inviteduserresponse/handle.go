@@ -4,10 +4,10 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 )
 
 // Handle
@@ -19,31 +19,29 @@ import (
 // @Produce      json
 // @Success      200
 // @Failure      400
+// @Failure      403
 // @Router       /group/user [put].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if validationErr := validator.New().Struct(&message); validationErr != nil {
-			context.JSON(http.StatusBadRequest, context.Error(validationErr))
-
+		userID, ok := ginconfig.ActingUserID(context, message.UserID)
+		if !ok {
 			return
 		}
 
 		command := commands.InvitedUserResponse{
-			UserID:   message.UserID,
+			UserID:   userID,
 			GroupID:  message.GroupID,
 			Accepted: message.Accepted,
 		}
 
-		if err := app.InvitedUserResponse(&command); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
+		if err := app.InvitedUserResponse(context.Request.Context(), &command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
@@ -2,6 +2,6 @@ package inviteduserresponse
 
 type Message struct {
 	GroupID  string `json:"groupId"  validate:"required"`
-	UserID   string `json:"userId"   validate:"required"`
+	UserID   string `json:"userId"`
 	Accepted bool   `json:"accepted" validate:"required"`
 }
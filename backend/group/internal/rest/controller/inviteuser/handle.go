@@ -4,10 +4,10 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 )
 
 // Handle
@@ -19,32 +19,30 @@ import (
 // @Produce      json
 // @Success      201
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /group/user [post].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if err := validator.New().Struct(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		invitingUserID, ok := ginconfig.ActingUserID(context, message.InvitingUserID)
+		if !ok {
 			return
 		}
 
 		inviteUserCommand := commands.InviteUser{
 			InvitedUserID:  message.InvitedUserID,
-			InvitingUserID: message.InvitingUserID,
+			InvitingUserID: invitingUserID,
 			GroupID:        message.GroupID,
 		}
 
-		if err := app.InviteUser(&inviteUserCommand); err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+		if err := app.InviteUser(context.Request.Context(), &inviteUserCommand); err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
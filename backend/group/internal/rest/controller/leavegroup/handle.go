@@ -1,16 +1,17 @@
 package leavegroup
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 )
 
-var ErrRequiredGroupIDAndUserID = errors.New("groupId and userId are required")
+var ErrRequiredGroupIDAndUserID = ddd.ValidationError("group.group_id_and_user_id_required", "groupId and userId are required")
 
 // Handle InviteUser godoc
 // @Summary      user leaves a group
@@ -20,6 +21,7 @@ var ErrRequiredGroupIDAndUserID = errors.New("groupId and userId are required")
 // @Produce      json
 // @Success      201
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /group/user [delete].
 func Handle(app application.App) gin.HandlerFunc {
@@ -28,18 +30,22 @@ func Handle(app application.App) gin.HandlerFunc {
 		userID := context.Param("userId")
 
 		if groupID == "" || userID == "" {
-			context.JSON(http.StatusBadRequest, context.Error(ErrRequiredGroupIDAndUserID))
+			ginconfig.AbortWithProblem(context, ErrRequiredGroupIDAndUserID)
 
 			return
 		}
 
+		if _, ok := ginconfig.ActingUserID(context, userID); !ok {
+			return
+		}
+
 		command := commands.LeaveGroup{
 			GroupID: groupID,
 			UserID:  userID,
 		}
 
-		if err := app.LeaveGroup(&command); err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+		if err := app.LeaveGroup(context.Request.Context(), &command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
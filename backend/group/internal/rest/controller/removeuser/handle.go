@@ -4,10 +4,10 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 )
 
 // Handle RemoveUser godoc
@@ -18,32 +18,30 @@ import (
 // @Produce      json
 // @Success      200
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /group/user/status [put].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if err := validator.New().Struct(message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		removingUserID, ok := ginconfig.ActingUserID(context, message.RemovingUserID)
+		if !ok {
 			return
 		}
 
 		command := commands.RemovePlayer{
 			GroupID:        message.GroupID,
 			RemoveUserID:   message.RemoveUserID,
-			RemovingUserID: message.RemovingUserID,
+			RemovingUserID: removingUserID,
 		}
 
-		if err := app.RemovePlayer(&command); err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+		if err := app.RemovePlayer(context.Request.Context(), &command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
@@ -0,0 +1,45 @@
+package unassignrole
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+)
+
+// Handle
+// UnassignRole godoc
+// @Summary      takes a custom role away from a player
+// @Description  takes a custom role away from a player of a group
+// @Tags         group
+// @Produce      json
+// @Success      200
+// @Failure      403
+// @Failure      500
+// @Router       /group/{groupId}/players/{userId}/roles/{roleName} [delete].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		changingUserID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		command := commands.UnassignRole{
+			GroupID:        context.Param("groupId"),
+			ChangingUserID: changingUserID,
+			UserID:         context.Param("userId"),
+			Role:           context.Param("roleName"),
+		}
+
+		if err := app.UnassignRole(context.Request.Context(), &command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, nil)
+	}
+}
@@ -1,16 +1,22 @@
 package updateplayer
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
 	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 )
 
+var fields = ginconfig.FieldMapping{
+	domain.ErrInvalidRole:   "role",
+	domain.ErrInvalidStatus: "status",
+}
+
 // Handle
 // UpdatePlayer godoc
 // @Summary      updates player of a group
@@ -20,48 +26,41 @@ import (
 // @Produce      json
 // @Success      200
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /group/player [put].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if err := validator.New().Struct(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		updatingUserID, ok := ginconfig.ActingUserID(context, message.UpdatingUserID)
+		if !ok {
 			return
 		}
 
-		role, err := domain.ToRole(message.Role)
-		if err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
-			return
-		}
+		role, roleErr := domain.ToRole(message.Role)
+		status, statusErr := domain.ToStatus(message.Status)
 
-		status, err := domain.ToStatus(message.Status)
-		if err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
+		if err := errors.Join(roleErr, statusErr); err != nil {
+			ginconfig.AbortWithProblem(context, fields.Map(err))
 
 			return
 		}
 
 		command := commands.UpdatePlayer{
 			GroupID:        message.GroupID,
-			UpdatingUserID: message.UpdatingUserID,
+			UpdatingUserID: updatingUserID,
 			UpdatedUserID:  message.UpdatedUserID,
 			NewRole:        role,
 			NewStatus:      status,
 		}
 
-		if err := app.UpdatePlayer(&command); err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+		if err := app.UpdatePlayer(context.Request.Context(), &command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
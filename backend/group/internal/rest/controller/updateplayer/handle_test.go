@@ -0,0 +1,111 @@
+package updateplayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+)
+
+type mockApp struct {
+	application.App
+	mock.Mock
+}
+
+func (m *mockApp) UpdatePlayer(ctx context.Context, cmd *commands.UpdatePlayer) error {
+	return m.Called(ctx, cmd).Error(0)
+}
+
+func newRouter(app application.App) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
+	router.PUT("/group/player", func(c *gin.Context) {
+		c.Set(ginconfig.UserIDKey, "user-1")
+	}, Handle(app))
+
+	return router
+}
+
+func TestHandle(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    int
+	}{
+		{
+			name:    "updating user from token",
+			payload: `{"groupId": "group-1", "updatedUserID": "user-2", "status": "active", "role": "admin"}`,
+			want:    http.StatusOK,
+		},
+		{
+			name:    "updating user matches token",
+			payload: `{"groupId": "group-1", "updatedUserID": "user-2", "updatingUserID": "user-1", "status": "active", "role": "admin"}`,
+			want:    http.StatusOK,
+		},
+		{
+			name:    "updating user is someone else",
+			payload: `{"groupId": "group-1", "updatedUserID": "user-2", "updatingUserID": "user-3", "status": "active", "role": "admin"}`,
+			want:    http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &mockApp{}
+			app.On("UpdatePlayer", mock.Anything, &commands.UpdatePlayer{
+				GroupID:        "group-1",
+				UpdatingUserID: "user-1",
+				UpdatedUserID:  "user-2",
+				NewRole:        domain.Admin,
+				NewStatus:      domain.Active,
+			}).Return(nil)
+
+			req := httptest.NewRequest(http.MethodPut, "/group/player", bytes.NewBufferString(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			newRouter(app).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+
+			if tt.want == http.StatusOK {
+				app.AssertExpectations(t)
+			} else {
+				app.AssertNotCalled(t, "UpdatePlayer", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestHandle_RendersDomainErrorAsProblem(t *testing.T) {
+	app := &mockApp{}
+	app.On("UpdatePlayer", mock.Anything, mock.Anything).Return(fmt.Errorf("updating player: %w", domain.ErrUserNotInGroup))
+
+	payload := `{"groupId": "group-1", "updatedUserID": "user-2", "status": "active", "role": "admin"}`
+	req := httptest.NewRequest(http.MethodPut, "/group/player", bytes.NewBufferString(payload))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	newRouter(app).ServeHTTP(rec, req)
+
+	var problem ginconfig.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, ginconfig.ProblemContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, "group.user_not_in_group", problem.Code)
+	assert.Equal(t, "user is not in group", problem.Detail)
+}
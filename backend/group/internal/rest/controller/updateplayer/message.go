@@ -3,7 +3,7 @@ package updateplayer
 type Message struct {
 	GroupID        string `json:"groupId,omitempty"        validate:"required"`
 	UpdatedUserID  string `json:"updatedUserID,omitempty"  validate:"required"`
-	UpdatingUserID string `json:"updatingUserID,omitempty" validate:"required"`
+	UpdatingUserID string `json:"updatingUserID,omitempty"`
 	Status         string `json:"status,omitempty"         validate:"required"`
 	Role           string `json:"role,omitempty"           validate:"required"`
 }
@@ -4,29 +4,49 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/assignrole"
+	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/changematchpriority"
 	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/creategroup"
+	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/definerole"
+	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/deleterole"
 	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/getgroupdetails"
+	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/getgrouphistory"
 	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/getgroups"
+	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/getroles"
 	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/inviteduserresponse"
 	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/inviteuser"
 	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/leavegroup"
 	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/removeuser"
+	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/unassignrole"
 	"github.com/FSpruhs/kick-app/backend/group/internal/rest/controller/updateplayer"
 	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/idempotency"
 )
 
-func GroupRouter(router *gin.Engine, app application.App) {
+func GroupRouter(
+	router *gin.Engine,
+	app application.App,
+	verifier *ginconfig.TokenVerifier,
+	keys *idempotency.Keys,
+) {
 	api := router.Group("/api/v1")
-	api.Use(ginconfig.JWTValidator())
+	api.Use(ginconfig.JWTValidator(verifier))
 	api.Use(ginconfig.UserIDExtractor())
 	{
-		api.POST("/group", creategroup.Handle(app))
+		api.POST("/group", keys.GinMiddleware(), creategroup.Handle(app))
 		api.GET("/group/user/:userId", getgroups.Handle(app))
 		api.POST("/group/user", inviteuser.Handle(app))
 		api.PUT("/group/user", inviteduserresponse.Handle(app))
 		api.DELETE("group/:groupId/user/:userId", leavegroup.Handle(app))
 		api.GET("/group/:groupId", getgroupdetails.Handle(app))
+		api.GET("/group/:groupId/history", getgrouphistory.Handle(app))
 		api.PUT("/group/player", updateplayer.Handle(app))
 		api.PUT("/group/player/status", removeuser.Handle(app))
+		api.GET("/group/:groupId/roles", getroles.Handle(app))
+		api.PUT("/group/:groupId/roles/:roleName", definerole.Handle(app))
+		api.DELETE("/group/:groupId/roles/:roleName", deleterole.Handle(app))
+		api.PUT("/group/:groupId/players/:userId/roles/:roleName", assignrole.Handle(app))
+		api.DELETE("/group/:groupId/players/:userId/roles/:roleName", unassignrole.Handle(app))
+		api.PUT("/group/:groupId/match-priority", changematchpriority.Handle(app))
 	}
 }
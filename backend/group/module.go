@@ -2,29 +2,78 @@ package group
 
 import (
 	"fmt"
+	"slices"
 
+	"github.com/FSpruhs/kick-app/backend/group/grouppb"
 	"github.com/FSpruhs/kick-app/backend/group/internal/application"
+	"github.com/FSpruhs/kick-app/backend/group/internal/domain"
 	"github.com/FSpruhs/kick-app/backend/group/internal/grpc"
+	"github.com/FSpruhs/kick-app/backend/group/internal/memory"
 	"github.com/FSpruhs/kick-app/backend/group/internal/mongodb"
 	"github.com/FSpruhs/kick-app/backend/group/internal/rest"
+	"github.com/FSpruhs/kick-app/backend/internal/config"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/eventstore"
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+	"github.com/FSpruhs/kick-app/backend/internal/migrations"
 	"github.com/FSpruhs/kick-app/backend/internal/monolith"
+	"github.com/FSpruhs/kick-app/backend/internal/outbox"
+	"github.com/FSpruhs/kick-app/backend/internal/requestid"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
+)
+
+const (
+	groupsCollection    = "group.groups"
+	eventsCollection    = "group.events"
+	snapshotsCollection = "group.snapshots"
+	outboxCollection    = "group.outbox"
 )
 
 type Module struct{}
 
+var (
+	_ migrations.Source = (*Module)(nil)
+	_ indexes.Source    = (*Module)(nil)
+)
+
+func (m *Module) Migrations() []migrations.Migration {
+	return mongodb.Migrations(groupsCollection, eventsCollection, snapshotsCollection)
+}
+
+func (m *Module) Indexes() []indexes.Index {
+	return slices.Concat(
+		mongodb.Indexes(groupsCollection),
+		eventstore.Indexes(eventsCollection, snapshotsCollection),
+		outbox.Indexes(outboxCollection),
+	)
+}
+
 func (m *Module) Startup(mono monolith.Monolith) error {
-	groups := mongodb.NewGroupRepository(mono.DB(), "group.groups")
+	if err := grouppb.Registrations(mono.Registry()); err != nil {
+		return fmt.Errorf("register group events: %w", err)
+	}
 
-	conn, err := grpc.NewClient(mono.Config().RPC.Address())
+	if err := mono.Registry().Register(domain.GroupSnapshotName, domain.GroupSnapshot{}); err != nil {
+		return fmt.Errorf("register group snapshot: %w", err)
+	}
+
+	groups, publisher := newRepository(mono)
+
+	conn, err := grpc.NewClient(
+		mono.Config().RPC.Address(),
+		mono.Metrics().DialOption(),
+		tracing.DialOption(),
+		requestid.DialOption(),
+	)
 	if err != nil {
 		return fmt.Errorf("connect to rpc server: %w", err)
 	}
 
 	users := grpc.NewUserRepository(conn)
 
-	app := application.New(groups, users, mono.EventDispatcher())
+	app := application.New(groups, users, publisher)
 
-	rest.GroupRouter(mono.Router(), app)
+	rest.GroupRouter(mono.Router(), app, mono.TokenVerifier(), mono.Idempotency())
 
 	if err := grpc.RegisterServer(app, mono.RPC()); err != nil {
 		return fmt.Errorf("register group server: %w", err)
@@ -32,3 +81,32 @@ func (m *Module) Startup(mono monolith.Monolith) error {
 
 	return nil
 }
+
+// newRepository returns the groups and the publisher of their events. The
+// events of groups kept in memory are published right away, the ones of groups
+// kept in MongoDB are relayed from the outbox.
+func newRepository(mono monolith.Monolith) (domain.GroupRepository, ddd.EventPublisher[ddd.AggregateEvent]) {
+	if mono.Config().Storage == config.StorageMemory {
+		return memory.NewGroupRepository(), mono.EventDispatcher()
+	}
+
+	store := eventstore.NewStore(
+		mono.DB(),
+		eventsCollection,
+		snapshotsCollection,
+		mono.Registry(),
+		eventstore.SnapshotEvery(mono.Config().Events.SnapshotEvery),
+	)
+
+	events := outbox.NewStore(mono.DB(), outboxCollection, mono.Registry())
+	relay := outbox.NewRelay(
+		events,
+		mono.EventDispatcher(),
+		outbox.DeadLetters(mono.DeadLetters()),
+		outbox.ObserveLag("group", mono.Metrics()),
+		outbox.Logger(mono.Logger().With("module", "group")),
+	)
+	mono.Waiter().Add(relay.Start)
+
+	return mongodb.NewGroupRepository(mono.DB(), groupsCollection, store, events), relay
+}
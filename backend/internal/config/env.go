@@ -3,18 +3,55 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 
 	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/idempotency"
+	"github.com/FSpruhs/kick-app/backend/internal/logger"
 	"github.com/FSpruhs/kick-app/backend/internal/rpc"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
+)
+
+// The storages the modules can keep their data in. StorageMemory loses all
+// data on shutdown and is meant for local development without MongoDB.
+const (
+	StorageMongoDB = "mongodb"
+	StorageMemory  = "memory"
 )
 
 type AppConfig struct {
+	Storage      string
 	EnvMongoURI  string
 	DatabaseName string
 	RPC          rpc.Config
 	Gin          ginconfig.Config
+	Events       EventsConfig
+	Tracing      tracing.Config
+	Log          logger.Config
+	Idempotency  idempotency.Config
+	Migrations   MigrationsConfig
+}
+
+type EventsConfig struct {
+	Async       bool
+	Workers     int
+	QueueSize   int
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+	// DrainTimeout is how long queued events are still handled on shutdown.
+	DrainTimeout time.Duration
+	// SnapshotEvery is the number of events after which the event store
+	// takes a snapshot of an aggregate.
+	SnapshotEvery int
+}
+
+type MigrationsConfig struct {
+	// OnStartup applies the pending migrations before the modules start.
+	OnStartup bool
 }
 
 func InitConfig() AppConfig {
@@ -24,16 +61,97 @@ func InitConfig() AppConfig {
 	}
 
 	return AppConfig{
+		Storage:      getString("STORAGE", StorageMongoDB),
 		EnvMongoURI:  os.Getenv("DATABASE_URL"),
 		DatabaseName: os.Getenv("DATABASE_NAME"),
 		RPC: rpc.Config{
-			Port: os.Getenv("GRPC_PORT"),
-			Host: os.Getenv("GRPC_HOST"),
+			Port:            os.Getenv("GRPC_PORT"),
+			Host:            os.Getenv("GRPC_HOST"),
+			ShutdownTimeout: getDuration("GRPC_SHUTDOWN_TIMEOUT", 5*time.Second),
 		},
 		Gin: ginconfig.Config{
 			Port:           os.Getenv("GIN_PORT"),
 			RealmConfigURL: os.Getenv("REALM_CONFIG_URL"),
 			ClientID:       os.Getenv("CLIENT_ID"),
+			OIDCTimeout:    getDuration("OIDC_TIMEOUT", 10*time.Second),
+			TLSCAFile:      os.Getenv("OIDC_TLS_CA_FILE"),
+			// keep false outside of local development
+			TLSInsecureSkipVerify: getBool("OIDC_TLS_INSECURE_SKIP_VERIFY", false),
+			ReadTimeout:           getDuration("GIN_READ_TIMEOUT", 15*time.Second),
+			ReadHeaderTimeout:     getDuration("GIN_READ_HEADER_TIMEOUT", 5*time.Second),
+			WriteTimeout:          getDuration("GIN_WRITE_TIMEOUT", 15*time.Second),
+			IdleTimeout:           getDuration("GIN_IDLE_TIMEOUT", 60*time.Second),
+			ShutdownTimeout:       getDuration("GIN_SHUTDOWN_TIMEOUT", 10*time.Second),
+		},
+		Events: EventsConfig{
+			Async:         getBool("EVENTS_ASYNC", true),
+			Workers:       getInt("EVENTS_WORKERS", 2),
+			QueueSize:     getInt("EVENTS_QUEUE_SIZE", 100),
+			MaxAttempts:   getInt("EVENTS_MAX_ATTEMPTS", 5),
+			Backoff:       getDuration("EVENTS_BACKOFF", 200*time.Millisecond),
+			MaxBackoff:    getDuration("EVENTS_MAX_BACKOFF", 10*time.Second),
+			DrainTimeout:  getDuration("EVENTS_DRAIN_TIMEOUT", 10*time.Second),
+			SnapshotEvery: getInt("EVENTS_SNAPSHOT_EVERY", 50),
+		},
+		Tracing: tracing.Config{
+			Exporter:    getString("TRACING_EXPORTER", tracing.ExporterNone),
+			Endpoint:    os.Getenv("TRACING_ENDPOINT"),
+			ServiceName: getString("TRACING_SERVICE_NAME", "kick-app"),
+			SampleRatio: getFloat("TRACING_SAMPLE_RATIO", 1),
+		},
+		Log: logger.Config{
+			Level: getString("LOG_LEVEL", "info"),
 		},
+		Idempotency: idempotency.Config{
+			TTL:         getDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+			LockTimeout: getDuration("IDEMPOTENCY_LOCK_TIMEOUT", time.Minute),
+		},
+		Migrations: MigrationsConfig{
+			OnStartup: getBool("MIGRATIONS_ON_STARTUP", true),
+		},
+	}
+}
+
+func getString(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
 	}
+
+	return fallback
+}
+
+func getBool(key string, fallback bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
+func getInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
+func getFloat(key string, fallback float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
+func getDuration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+
+	return value
 }
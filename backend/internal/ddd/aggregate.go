@@ -1,5 +1,7 @@
 package ddd
 
+import "time"
+
 type (
 	AggregateNamer interface {
 		AggregateName() string
@@ -11,9 +13,20 @@ type (
 		ClearEvents()
 	}
 
+	Versioner interface {
+		Version() int
+		PendingVersion() int
+		SetVersion(version int)
+	}
+
+	EventApplier interface {
+		ApplyEvent(event Event) error
+	}
+
 	Aggregate struct {
 		Entity
-		events []AggregateEvent
+		events  []AggregateEvent
+		version int
 	}
 
 	AggregateEvent interface {
@@ -25,6 +38,9 @@ type (
 
 	aggregateEvent struct {
 		event
+		aggregateID   string
+		aggregateName string
+		version       int
 	}
 
 	AggregateBase struct {
@@ -36,6 +52,7 @@ type (
 var _ interface {
 	AggregateNamer
 	Eventer
+	Versioner
 } = (*Aggregate)(nil)
 
 func (a *AggregateBase) AddEvent(event Event) {
@@ -73,22 +90,66 @@ func (a *Aggregate) AddEvent(name string, payload EventPayload) {
 	a.events = append(
 		a.events,
 		&aggregateEvent{
-			event: NewEvent(name, payload),
+			event:         NewEvent(name, payload),
+			aggregateID:   a.ID(),
+			aggregateName: a.AggregateName(),
+			version:       a.PendingVersion() + 1,
 		})
 }
 
+// Version is the version of the aggregate as it was loaded, without the
+// events added since then.
+func (a *Aggregate) Version() int {
+	return a.version
+}
+
+func (a *Aggregate) PendingVersion() int {
+	return a.version + len(a.events)
+}
+
+// SetVersion is meant for repositories which restore an aggregate.
+func (a *Aggregate) SetVersion(version int) {
+	a.version = version
+}
+
 func (a *Aggregate) setEvents(events []AggregateEvent) {
 	a.events = events
 }
 
 func (e aggregateEvent) AggregateID() string {
-	return e.id
+	return e.aggregateID
 }
 
 func (e aggregateEvent) AggregateName() string {
-	return e.name
+	return e.aggregateName
 }
 
 func (e aggregateEvent) AggregateVersion() int {
-	return 1
+	return e.version
+}
+
+// RestoreAggregateEvent recreates an event which was read back from a store.
+func RestoreAggregateEvent(
+	id, name string,
+	payload EventPayload,
+	metadata Metadata,
+	occurredAt time.Time,
+	aggregateID, aggregateName string,
+	version int,
+) AggregateEvent {
+	if metadata == nil {
+		metadata = make(Metadata)
+	}
+
+	return &aggregateEvent{
+		event: event{
+			Entity:     NewEntity(id, name),
+			payload:    payload,
+			metadata:   metadata,
+			occurredAt: occurredAt,
+		},
+		aggregateID:   aggregateID,
+		aggregateName: aggregateName,
+		version:       version,
+	}
 }
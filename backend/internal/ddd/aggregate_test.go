@@ -0,0 +1,22 @@
+package ddd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregate_AddEventVersions(t *testing.T) {
+	aggregate := NewAggregate("aggregate-id", "test.Aggregate")
+	aggregate.SetVersion(3)
+
+	aggregate.AddEvent("test.First", nil)
+	aggregate.AddEvent("test.Second", nil)
+
+	assert.Equal(t, 3, aggregate.Version())
+	assert.Equal(t, 5, aggregate.PendingVersion())
+	assert.Equal(t, 4, aggregate.Events()[0].AggregateVersion())
+	assert.Equal(t, 5, aggregate.Events()[1].AggregateVersion())
+	assert.Equal(t, "aggregate-id", aggregate.Events()[1].AggregateID())
+	assert.Equal(t, "test.Aggregate", aggregate.Events()[1].AggregateName())
+}
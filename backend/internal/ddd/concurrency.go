@@ -0,0 +1,38 @@
+package ddd
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrConcurrentModification = ConflictError("concurrent_modification", "aggregate was modified concurrently")
+
+// ConcurrentModificationError is returned by repositories when the stored
+// aggregate no longer has the version it was loaded with.
+type ConcurrentModificationError struct {
+	AggregateName string
+	AggregateID   string
+	Version       int
+}
+
+func (e ConcurrentModificationError) Error() string {
+	return fmt.Sprintf("%s %s at version %d: %s", e.AggregateName, e.AggregateID, e.Version, ErrConcurrentModification)
+}
+
+func (e ConcurrentModificationError) Unwrap() error {
+	return ErrConcurrentModification
+}
+
+// RetryOnConflict runs fn again while it fails with ErrConcurrentModification,
+// at most attempts times. fn has to load the aggregate again on every run.
+func RetryOnConflict(attempts int, fn func() error) error {
+	var err error
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		if err = fn(); !errors.Is(err, ErrConcurrentModification) {
+			return err
+		}
+	}
+
+	return err
+}
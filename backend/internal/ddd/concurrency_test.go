@@ -0,0 +1,56 @@
+package ddd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryOnConflict(t *testing.T) {
+	conflict := fmt.Errorf("saving: %w", ConcurrentModificationError{"test.Aggregate", "1", 2})
+
+	t.Run("retries until success", func(t *testing.T) {
+		calls := 0
+
+		err := RetryOnConflict(3, func() error {
+			calls++
+			if calls < 3 {
+				return conflict
+			}
+
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("gives up after attempts", func(t *testing.T) {
+		calls := 0
+
+		err := RetryOnConflict(3, func() error {
+			calls++
+
+			return conflict
+		})
+
+		assert.ErrorIs(t, err, ErrConcurrentModification)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		calls := 0
+		someErr := errors.New("some error")
+
+		err := RetryOnConflict(3, func() error {
+			calls++
+
+			return someErr
+		})
+
+		assert.ErrorIs(t, err, someErr)
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,70 @@
+package ddd
+
+import "errors"
+
+// ErrorKind tells adapters how to report an Error without knowing every
+// error of the domain.
+type ErrorKind string
+
+const (
+	KindValidation   ErrorKind = "validation"
+	KindNotFound     ErrorKind = "not_found"
+	KindForbidden    ErrorKind = "forbidden"
+	KindConflict     ErrorKind = "conflict"
+	KindUnauthorized ErrorKind = "unauthorized"
+)
+
+// Error is a domain error with a stable code clients can branch on. Declare
+// them as sentinels and wrap them to add context, the code and message stay
+// the same.
+type Error struct {
+	kind    ErrorKind
+	code    string
+	message string
+}
+
+func NewError(kind ErrorKind, code, message string) *Error {
+	return &Error{kind: kind, code: code, message: message}
+}
+
+func ValidationError(code, message string) *Error {
+	return NewError(KindValidation, code, message)
+}
+
+func NotFoundError(code, message string) *Error {
+	return NewError(KindNotFound, code, message)
+}
+
+func ForbiddenError(code, message string) *Error {
+	return NewError(KindForbidden, code, message)
+}
+
+func ConflictError(code, message string) *Error {
+	return NewError(KindConflict, code, message)
+}
+
+func UnauthorizedError(code, message string) *Error {
+	return NewError(KindUnauthorized, code, message)
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+func (e *Error) Kind() ErrorKind {
+	return e.kind
+}
+
+func (e *Error) Code() string {
+	return e.code
+}
+
+// AsError returns the outermost Error in the chain of err.
+func AsError(err error) (*Error, bool) {
+	var domainErr *Error
+	if !errors.As(err, &domainErr) {
+		return nil, false
+	}
+
+	return domainErr, true
+}
@@ -0,0 +1,36 @@
+package ddd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsError(t *testing.T) {
+	errNotFound := NotFoundError("test.not_found", "test not found")
+
+	t.Run("finds wrapped error", func(t *testing.T) {
+		domainErr, ok := AsError(fmt.Errorf("loading test: %w", errNotFound))
+
+		require.True(t, ok)
+		assert.Equal(t, KindNotFound, domainErr.Kind())
+		assert.Equal(t, "test.not_found", domainErr.Code())
+		assert.Equal(t, "test not found", domainErr.Error())
+	})
+
+	t.Run("ignores other errors", func(t *testing.T) {
+		_, ok := AsError(errors.New("some error"))
+
+		assert.False(t, ok)
+	})
+
+	t.Run("concurrent modification is a conflict", func(t *testing.T) {
+		domainErr, ok := AsError(ConcurrentModificationError{"test.Aggregate", "1", 2})
+
+		require.True(t, ok)
+		assert.Equal(t, KindConflict, domainErr.Kind())
+	})
+}
@@ -12,16 +12,22 @@ var ErrInvalidEventPayload = errors.New("invalid event payload type")
 type (
 	EventPayload interface{}
 
+	// Metadata travels with an event without being part of its payload, e.g.
+	// the trace context of the request which caused the event.
+	Metadata map[string]string
+
 	Event interface {
 		IDer
 		EventName() string
 		Payload() EventPayload
+		Metadata() Metadata
 		OccurredAt() time.Time
 	}
 
 	event struct {
 		Entity
 		payload    EventPayload
+		metadata   Metadata
 		occurredAt time.Time
 	}
 )
@@ -32,6 +38,7 @@ func NewEvent(name string, payload EventPayload) event {
 	evt := event{
 		Entity:     NewEntity(uuid.New().String(), name),
 		payload:    payload,
+		metadata:   make(Metadata),
 		occurredAt: time.Now(),
 	}
 
@@ -46,6 +53,10 @@ func (e event) Payload() EventPayload {
 	return e.payload
 }
 
+func (e event) Metadata() Metadata {
+	return e.metadata
+}
+
 func (e event) OccurredAt() time.Time {
 	return e.occurredAt
 }
@@ -1,24 +1,112 @@
 package ddd
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWorkers     = 1
+	defaultQueueSize   = 100
+	defaultMaxAttempts = 1
+	defaultBackoff     = 100 * time.Millisecond
+	defaultMaxBackoff  = 10 * time.Second
+	defaultDrain       = 5 * time.Second
+)
+
+var (
+	ErrUnknownSubscription = errors.New("unknown subscription")
+	ErrDispatcherStopped   = errors.New("event dispatcher stopped")
+)
 
 type (
 	EventHandler[T Event] interface {
-		HandleEvent(event T) error
+		HandleEvent(ctx context.Context, event T) error
 	}
 
-	EventHandlerFunc[T Event] func(event T) error
+	EventHandlerFunc[T Event] func(ctx context.Context, event T) error
 
 	EventSubscriber[T Event] interface {
 		Subscribe(name string, handler EventHandler[T])
 	}
 
 	EventPublisher[T Event] interface {
-		Publish(events ...T) error
+		Publish(ctx context.Context, events ...T) error
+	}
+
+	// DeadLetter is an event a subscription could not handle within the
+	// configured attempts.
+	DeadLetter struct {
+		Subscription string
+		Event        Event
+		Attempts     int
+		Err          error
+		FailedAt     time.Time
+	}
+
+	DeadLetterStore interface {
+		Save(ctx context.Context, letter DeadLetter) error
+	}
+
+	// DispatchObserver is told how long publishing and handling an event
+	// took and whether it failed, e.g. to record metrics.
+	DispatchObserver interface {
+		EventPublished(name string, duration time.Duration, err error)
+		EventHandled(name string, duration time.Duration, err error)
+	}
+
+	// DispatchTracer is told when a subscription starts handling an event. It
+	// returns the context to handle the event with and the function ending
+	// the handling, e.g. to record a span.
+	DispatchTracer interface {
+		StartHandling(ctx context.Context, subscription string, event Event) (context.Context, func(err error))
+	}
+
+	// ContextRestorer returns a context carrying what was stored in the
+	// metadata of an event, e.g. the trace context and the request id.
+	ContextRestorer func(ctx context.Context, event Event) context.Context
+
+	DispatcherOption func(c *dispatcherCfg)
+
+	dispatcherCfg struct {
+		async       bool
+		workers     int
+		queueSize   int
+		maxAttempts int
+		backoff     time.Duration
+		maxBackoff  time.Duration
+		drain       time.Duration
+		deadLetters DeadLetterStore
+		observer    DispatchObserver
+		tracer      DispatchTracer
+		logger      *slog.Logger
+		restore     ContextRestorer
+	}
+
+	subscription[T Event] struct {
+		name    string
+		handler EventHandler[T]
+		queue   chan delivery[T]
+	}
+
+	// delivery is an event queued for a subscription. The subscription tells
+	// through done once it handled the event or stored it as dead letter.
+	delivery[T Event] struct {
+		event T
+		done  chan<- error
 	}
 
 	EventDispatcher[T Event] struct {
-		handlers map[string][]EventHandler[T]
+		cfg           dispatcherCfg
+		handlers      map[string][]*subscription[T]
+		subscriptions map[string]*subscription[T]
+		mu            sync.RWMutex
+		stopped       chan struct{}
+		stop          sync.Once
 	}
 )
 
@@ -27,29 +115,434 @@ var _ interface {
 	EventPublisher[Event]
 } = (*EventDispatcher[Event])(nil)
 
-func NewEventDispatcher[T Event]() *EventDispatcher[T] {
+// Async lets every subscription handle its events in its own pool of workers.
+// A failing handler no longer affects the publisher or the other handlers.
+// Publish still waits until every subscription handled the event or stored it
+// as dead letter, so a publisher like the outbox relay only forgets an event
+// once it cannot get lost anymore.
+func Async(workers, queueSize int) DispatcherOption {
+	return func(c *dispatcherCfg) {
+		c.async = true
+		c.workers = workers
+		c.queueSize = queueSize
+	}
+}
+
+// Retry handles an event up to maxAttempts times, doubling the wait between
+// the attempts starting at backoff up to maxBackoff. Only used in async mode.
+func Retry(maxAttempts int, backoff, maxBackoff time.Duration) DispatcherOption {
+	return func(c *dispatcherCfg) {
+		c.maxAttempts = maxAttempts
+		c.backoff = backoff
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// Drain keeps handling the events already queued for up to timeout once the
+// dispatcher is stopped. Events still queued afterwards become dead letters.
+// Only used in async mode.
+func Drain(timeout time.Duration) DispatcherOption {
+	return func(c *dispatcherCfg) {
+		c.drain = timeout
+	}
+}
+
+func Observe(observer DispatchObserver) DispatcherOption {
+	return func(c *dispatcherCfg) {
+		c.observer = observer
+	}
+}
+
+func Trace(tracer DispatchTracer) DispatcherOption {
+	return func(c *dispatcherCfg) {
+		c.tracer = tracer
+	}
+}
+
+// RestoreContext lets handlers see what the publisher of an event stored in
+// its metadata.
+func RestoreContext(restorers ...ContextRestorer) DispatcherOption {
+	return func(c *dispatcherCfg) {
+		c.restore = func(ctx context.Context, event Event) context.Context {
+			for _, restore := range restorers {
+				ctx = restore(ctx, event)
+			}
+
+			return ctx
+		}
+	}
+}
+
+func Logger(logger *slog.Logger) DispatcherOption {
+	return func(c *dispatcherCfg) {
+		c.logger = logger
+	}
+}
+
+func DeadLetters(store DeadLetterStore) DispatcherOption {
+	return func(c *dispatcherCfg) {
+		c.deadLetters = store
+	}
+}
+
+func NewEventDispatcher[T Event](options ...DispatcherOption) *EventDispatcher[T] {
+	cfg := dispatcherCfg{
+		workers:     defaultWorkers,
+		queueSize:   defaultQueueSize,
+		maxAttempts: defaultMaxAttempts,
+		backoff:     defaultBackoff,
+		maxBackoff:  defaultMaxBackoff,
+		drain:       defaultDrain,
+		observer:    noopObserver{},
+		tracer:      noopTracer{},
+		logger:      slog.Default(),
+		restore:     func(ctx context.Context, _ Event) context.Context { return ctx },
+	}
+
+	for _, option := range options {
+		option(&cfg)
+	}
+
 	return &EventDispatcher[T]{
-		handlers: make(map[string][]EventHandler[T]),
+		cfg:           cfg,
+		handlers:      make(map[string][]*subscription[T]),
+		subscriptions: make(map[string]*subscription[T]),
+		stopped:       make(chan struct{}),
 	}
 }
 
 func (d *EventDispatcher[T]) Subscribe(name string, handler EventHandler[T]) {
-	d.handlers[name] = append(d.handlers[name], handler)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	sub := &subscription[T]{
+		name:    d.subscriptionName(name, handler),
+		handler: handler,
+	}
+
+	if d.cfg.async {
+		sub.queue = make(chan delivery[T], d.cfg.queueSize)
+	}
+
+	d.handlers[name] = append(d.handlers[name], sub)
+	d.subscriptions[sub.name] = sub
 }
 
-func (d *EventDispatcher[T]) Publish(events ...T) error {
+func (d *EventDispatcher[T]) Publish(ctx context.Context, events ...T) error {
 	for _, event := range events {
-		for _, handler := range d.handlers[event.EventName()] {
-			err := handler.HandleEvent(event)
-			if err != nil {
-				return fmt.Errorf("while handling event: %w", err)
+		start := time.Now()
+		err := d.publish(ctx, event)
+		d.cfg.observer.EventPublished(event.EventName(), time.Since(start), err)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *EventDispatcher[T]) publish(ctx context.Context, event T) error {
+	d.mu.RLock()
+	subs := d.handlers[event.EventName()]
+
+	if d.cfg.async {
+		return d.deliver(ctx, subs, event)
+	}
+
+	defer d.mu.RUnlock()
+
+	for _, sub := range subs {
+		if err := d.handleOnce(ctx, sub, event); err != nil {
+			return fmt.Errorf("while handling event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Redeliver hands an event to a single subscription again, e.g. to replay a
+// dead letter after the cause of the failure has been fixed. Without a
+// subscription name the event is published to all its subscriptions again.
+func (d *EventDispatcher[T]) Redeliver(ctx context.Context, subscriptionName string, event T) error {
+	if subscriptionName == "" {
+		return d.Publish(ctx, event)
+	}
+
+	d.mu.RLock()
+	sub, exists := d.subscriptions[subscriptionName]
+
+	if !exists {
+		d.mu.RUnlock()
+
+		return fmt.Errorf("redeliver to %s: %w", subscriptionName, ErrUnknownSubscription)
+	}
+
+	if d.cfg.async {
+		return d.deliver(ctx, []*subscription[T]{sub}, event)
+	}
+
+	d.mu.RUnlock()
+
+	if err := d.handleOnce(ctx, sub, event); err != nil {
+		return fmt.Errorf("while handling event: %w", err)
+	}
+
+	return nil
+}
+
+// Start runs the workers of all subscriptions until the context is done. Then
+// it rejects further events and drains the queues before it returns. It
+// returns immediately if the dispatcher is synchronous.
+func (d *EventDispatcher[T]) Start(ctx context.Context) error {
+	if !d.cfg.async {
+		return nil
+	}
+
+	d.mu.RLock()
+	subs := make([]*subscription[T], 0, len(d.subscriptions))
+	for _, sub := range d.subscriptions {
+		subs = append(subs, sub)
+	}
+	d.mu.RUnlock()
+
+	// handlers may finish their work while draining, so they only stop once
+	// the drain timeout has passed
+	handleCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	go func() {
+		<-ctx.Done()
+
+		timer := time.NewTimer(d.cfg.drain)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			cancel()
+		case <-handleCtx.Done():
+		}
+	}()
+
+	var wg sync.WaitGroup
+
+	for _, sub := range subs {
+		for range d.cfg.workers {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				d.work(ctx, handleCtx, sub)
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	d.stop.Do(func() { close(d.stopped) })
+
+	// wait for publishers which were enqueuing while the dispatcher stopped
+	d.mu.Lock()
+	d.mu.Unlock() //nolint:staticcheck // empty critical section as barrier
+
+	for _, sub := range subs {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			d.drainQueue(handleCtx, sub)
+		}()
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// deliver enqueues the event for the subscriptions while the read lock is
+// held, so the stopping dispatcher drains it. It releases the lock before it
+// waits for the subscriptions to be done with the event.
+func (d *EventDispatcher[T]) deliver(ctx context.Context, subs []*subscription[T], event T) error {
+	done := make(chan error, len(subs))
+
+	for _, sub := range subs {
+		if err := d.enqueue(sub, delivery[T]{event: event, done: done}); err != nil {
+			d.mu.RUnlock()
+
+			return err
+		}
+	}
+
+	d.mu.RUnlock()
+
+	errs := make([]error, 0, len(subs))
+
+	for range subs {
+		select {
+		case err := <-done:
+			errs = append(errs, err)
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for handlers of event %s: %w", event.ID(), ctx.Err())
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (d *EventDispatcher[T]) enqueue(sub *subscription[T], next delivery[T]) error {
+	select {
+	case <-d.stopped:
+		return ErrDispatcherStopped
+	default:
+	}
+
+	select {
+	case sub.queue <- next:
+		return nil
+	case <-d.stopped:
+		return ErrDispatcherStopped
+	}
+}
+
+func (d *EventDispatcher[T]) work(ctx, handleCtx context.Context, sub *subscription[T]) {
+	for {
+		// leave queued events to the drain once the context is done
+		if ctx.Err() != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case next := <-sub.queue:
+			next.done <- d.handle(handleCtx, sub, next.event)
+		}
+	}
+}
+
+func (d *EventDispatcher[T]) drainQueue(ctx context.Context, sub *subscription[T]) {
+	for {
+		select {
+		case next := <-sub.queue:
+			if ctx.Err() != nil {
+				next.done <- d.deadLetter(ctx, sub, next.event, 0, ErrDispatcherStopped)
+
+				continue
 			}
+
+			next.done <- d.handle(ctx, sub, next.event)
+		default:
+			return
+		}
+	}
+}
+
+// handle returns an error only if the event was neither handled nor stored
+// as dead letter.
+func (d *EventDispatcher[T]) handle(ctx context.Context, sub *subscription[T], event T) error {
+	var err error
+
+	wait := d.cfg.backoff
+
+	for attempt := 1; attempt <= d.cfg.maxAttempts; attempt++ {
+		if err = d.handleOnce(ctx, sub, event); err == nil {
+			return nil
 		}
+
+		if attempt == d.cfg.maxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return d.deadLetter(ctx, sub, event, attempt, err)
+		case <-time.After(wait):
+		}
+
+		wait = min(2*wait, d.cfg.maxBackoff)
+	}
+
+	return d.deadLetter(ctx, sub, event, d.cfg.maxAttempts, err)
+}
+
+func (d *EventDispatcher[T]) handleOnce(ctx context.Context, sub *subscription[T], event T) error {
+	ctx, end := d.cfg.tracer.StartHandling(d.cfg.restore(ctx, event), sub.name, event)
+	start := time.Now()
+	err := sub.handler.HandleEvent(ctx, event)
+	d.cfg.observer.EventHandled(event.EventName(), time.Since(start), err)
+	end(err)
+
+	return err
+}
+
+// deadLetter returns an error if the event could not be stored, so its
+// publisher keeps it.
+func (d *EventDispatcher[T]) deadLetter(
+	ctx context.Context,
+	sub *subscription[T],
+	event T,
+	attempts int,
+	cause error,
+) error {
+	// the context of the worker may already be done during shutdown
+	ctx = context.WithoutCancel(d.cfg.restore(ctx, event))
+
+	logger := d.cfg.logger.With(
+		slog.String("event_id", event.ID()),
+		slog.String("event", event.EventName()),
+		slog.String("subscription", sub.name),
+	)
+
+	logger.ErrorContext(ctx, "handling event failed", slog.Int("attempts", attempts), slog.Any("error", cause))
+
+	if d.cfg.deadLetters == nil {
+		return fmt.Errorf("handling event %s by %s: %w", event.ID(), sub.name, cause)
+	}
+
+	letter := DeadLetter{
+		Subscription: sub.name,
+		Event:        event,
+		Attempts:     attempts,
+		Err:          cause,
+		FailedAt:     time.Now(),
+	}
+
+	if err := d.cfg.deadLetters.Save(ctx, letter); err != nil {
+		logger.ErrorContext(ctx, "saving dead letter failed", slog.Any("error", err))
+
+		return fmt.Errorf("saving dead letter of event %s: %w", event.ID(), err)
 	}
 
 	return nil
 }
 
-func (f EventHandlerFunc[T]) HandleEvent(event T) error {
-	return f(event)
+func (d *EventDispatcher[T]) subscriptionName(name string, handler EventHandler[T]) string {
+	subName := fmt.Sprintf("%s/%T", name, handler)
+
+	if _, exists := d.subscriptions[subName]; !exists {
+		return subName
+	}
+
+	for i := 2; ; i++ {
+		indexed := fmt.Sprintf("%s#%d", subName, i)
+		if _, exists := d.subscriptions[indexed]; !exists {
+			return indexed
+		}
+	}
+}
+
+func (f EventHandlerFunc[T]) HandleEvent(ctx context.Context, event T) error {
+	return f(ctx, event)
+}
+
+type noopObserver struct{}
+
+func (noopObserver) EventPublished(string, time.Duration, error) {}
+
+func (noopObserver) EventHandled(string, time.Duration, error) {}
+
+type noopTracer struct{}
+
+func (noopTracer) StartHandling(ctx context.Context, _ string, _ Event) (context.Context, func(error)) {
+	return ctx, func(error) {}
 }
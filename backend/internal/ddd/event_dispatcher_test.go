@@ -0,0 +1,299 @@
+package ddd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingHandler struct {
+	mu       sync.Mutex
+	failures int
+	delay    time.Duration
+	calls    int
+	handled  chan Event
+}
+
+func newRecordingHandler(failures int) *recordingHandler {
+	return &recordingHandler{failures: failures, handled: make(chan Event, 10)}
+}
+
+func (h *recordingHandler) HandleEvent(_ context.Context, event Event) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	time.Sleep(h.delay)
+
+	h.calls++
+	if h.calls <= h.failures {
+		return errors.New("some error")
+	}
+
+	h.handled <- event
+
+	return nil
+}
+
+type deadLetterRecorder struct {
+	letters chan DeadLetter
+}
+
+func (r *deadLetterRecorder) Save(_ context.Context, letter DeadLetter) error {
+	r.letters <- letter
+
+	return nil
+}
+
+func newTestEvent() Event {
+	event := NewEvent("test.Event", nil)
+
+	return &event
+}
+
+func startDispatcher(t *testing.T, dispatcher *EventDispatcher[Event]) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		_ = dispatcher.Start(ctx)
+
+		close(done)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+// publishQueued publishes the events in the background until they are queued
+// for the only subscription. The errors of the publishers arrive once the
+// events were handled.
+func publishQueued(t *testing.T, dispatcher *EventDispatcher[Event], count int) <-chan error {
+	t.Helper()
+
+	errs := make(chan error, count)
+
+	for range count {
+		go func() {
+			errs <- dispatcher.Publish(context.Background(), newTestEvent())
+		}()
+	}
+
+	queue := dispatcher.handlers["test.Event"][0].queue
+	require.Eventually(t, func() bool { return len(queue) == count }, time.Second, time.Millisecond)
+
+	return errs
+}
+
+func TestEventDispatcher_PublishSyncStopsAtFirstError(t *testing.T) {
+	dispatcher := NewEventDispatcher[Event]()
+	failing := newRecordingHandler(1)
+	other := newRecordingHandler(0)
+	dispatcher.Subscribe("test.Event", failing)
+	dispatcher.Subscribe("test.Event", other)
+
+	err := dispatcher.Publish(context.Background(), newTestEvent())
+
+	assert.Error(t, err)
+	assert.Equal(t, 0, other.calls)
+}
+
+func TestEventDispatcher_PublishAsyncRetries(t *testing.T) {
+	dispatcher := NewEventDispatcher[Event](
+		Async(1, 10),
+		Retry(3, time.Millisecond, 2*time.Millisecond),
+	)
+	handler := newRecordingHandler(2)
+	dispatcher.Subscribe("test.Event", handler)
+	startDispatcher(t, dispatcher)
+
+	event := newTestEvent()
+	assert.NoError(t, dispatcher.Publish(context.Background(), event))
+
+	// publish returns once the event was handled
+	require.Len(t, handler.handled, 1)
+	assert.Equal(t, event.ID(), (<-handler.handled).ID())
+}
+
+func TestEventDispatcher_PublishAsyncFailsWithoutDeadLetters(t *testing.T) {
+	dispatcher := NewEventDispatcher[Event](Async(1, 10), Retry(2, time.Millisecond, time.Millisecond))
+	dispatcher.Subscribe("test.Event", newRecordingHandler(5))
+	dispatcher.Subscribe("test.Event", newRecordingHandler(0))
+	startDispatcher(t, dispatcher)
+
+	assert.Error(t, dispatcher.Publish(context.Background(), newTestEvent()))
+}
+
+func TestEventDispatcher_PublishAsyncStopsWaitingWhenContextIsDone(t *testing.T) {
+	dispatcher := NewEventDispatcher[Event](Async(1, 10))
+	dispatcher.Subscribe("test.Event", newRecordingHandler(0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// the dispatcher is not started, so nobody handles the event
+	assert.ErrorIs(t, dispatcher.Publish(ctx, newTestEvent()), context.DeadlineExceeded)
+}
+
+func TestEventDispatcher_PublishAsyncIsolatesFailures(t *testing.T) {
+	deadLetters := &deadLetterRecorder{letters: make(chan DeadLetter, 1)}
+	dispatcher := NewEventDispatcher[Event](
+		Async(1, 10),
+		Retry(2, time.Millisecond, time.Millisecond),
+		DeadLetters(deadLetters),
+	)
+	failing := newRecordingHandler(5)
+	other := newRecordingHandler(0)
+	dispatcher.Subscribe("test.Event", failing)
+	dispatcher.Subscribe("test.Event", other)
+	startDispatcher(t, dispatcher)
+
+	event := newTestEvent()
+	assert.NoError(t, dispatcher.Publish(context.Background(), event))
+
+	select {
+	case letter := <-deadLetters.letters:
+		assert.Equal(t, "test.Event/*ddd.recordingHandler", letter.Subscription)
+		assert.Equal(t, event.ID(), letter.Event.ID())
+		assert.Equal(t, 2, letter.Attempts)
+	case <-time.After(time.Second):
+		t.Fatal("dead letter was not saved")
+	}
+
+	select {
+	case handled := <-other.handled:
+		assert.Equal(t, event.ID(), handled.ID())
+	case <-time.After(time.Second):
+		t.Fatal("event was not handled by other handler")
+	}
+}
+
+func TestEventDispatcher_Redeliver(t *testing.T) {
+	dispatcher := NewEventDispatcher[Event]()
+	first := newRecordingHandler(0)
+	second := newRecordingHandler(0)
+	dispatcher.Subscribe("test.Event", first)
+	dispatcher.Subscribe("test.Event", second)
+
+	err := dispatcher.Redeliver(context.Background(), "test.Event/*ddd.recordingHandler#2", newTestEvent())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, first.calls)
+	assert.Equal(t, 1, second.calls)
+}
+
+func TestEventDispatcher_RedeliverUnknownSubscription(t *testing.T) {
+	dispatcher := NewEventDispatcher[Event]()
+
+	err := dispatcher.Redeliver(context.Background(), "unknown", newTestEvent())
+
+	assert.ErrorIs(t, err, ErrUnknownSubscription)
+}
+
+func TestEventDispatcher_StartDrainsQueuedEvents(t *testing.T) {
+	dispatcher := NewEventDispatcher[Event](Async(1, 10), Drain(time.Second))
+	handler := newRecordingHandler(0)
+	dispatcher.Subscribe("test.Event", handler)
+
+	errs := publishQueued(t, dispatcher, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.NoError(t, dispatcher.Start(ctx))
+	assert.Len(t, handler.handled, 3)
+
+	for range 3 {
+		assert.NoError(t, <-errs)
+	}
+
+	assert.ErrorIs(t, dispatcher.Publish(context.Background(), newTestEvent()), ErrDispatcherStopped)
+}
+
+func TestEventDispatcher_StartDeadLettersEventsAfterDrainTimeout(t *testing.T) {
+	deadLetters := &deadLetterRecorder{letters: make(chan DeadLetter, 3)}
+	dispatcher := NewEventDispatcher[Event](Async(1, 10), Drain(10*time.Millisecond), DeadLetters(deadLetters))
+	handler := newRecordingHandler(0)
+	handler.delay = 50 * time.Millisecond
+	dispatcher.Subscribe("test.Event", handler)
+
+	errs := publishQueued(t, dispatcher, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.NoError(t, dispatcher.Start(ctx))
+	assert.Len(t, handler.handled, 1)
+	require.Len(t, deadLetters.letters, 2)
+
+	letter := <-deadLetters.letters
+	assert.ErrorIs(t, letter.Err, ErrDispatcherStopped)
+
+	// the dead letters keep the events, so the publishers may forget them
+	for range 3 {
+		assert.NoError(t, <-errs)
+	}
+}
+
+type recordingTracer struct {
+	subscriptions []string
+	errs          []error
+}
+
+func (r *recordingTracer) StartHandling(ctx context.Context, subscription string, _ Event) (context.Context, func(error)) {
+	r.subscriptions = append(r.subscriptions, subscription)
+
+	return ctx, func(err error) {
+		r.errs = append(r.errs, err)
+	}
+}
+
+func TestEventDispatcher_TracesEveryHandling(t *testing.T) {
+	tracer := &recordingTracer{}
+	dispatcher := NewEventDispatcher[Event](Trace(tracer))
+	dispatcher.Subscribe("test.Event", newRecordingHandler(1))
+
+	assert.Error(t, dispatcher.Publish(context.Background(), newTestEvent()))
+	assert.NoError(t, dispatcher.Publish(context.Background(), newTestEvent()))
+
+	assert.Equal(t, []string{"test.Event/*ddd.recordingHandler", "test.Event/*ddd.recordingHandler"}, tracer.subscriptions)
+	require.Len(t, tracer.errs, 2)
+	assert.Error(t, tracer.errs[0])
+	assert.NoError(t, tracer.errs[1])
+}
+
+type contextKey struct{}
+
+func TestEventDispatcher_RestoreContextFromMetadata(t *testing.T) {
+	restore := func(ctx context.Context, event Event) context.Context {
+		return context.WithValue(ctx, contextKey{}, event.Metadata()["requestId"])
+	}
+	dispatcher := NewEventDispatcher[Event](Async(1, 10), RestoreContext(restore))
+
+	restored := make(chan any, 1)
+	dispatcher.Subscribe("test.Event", EventHandlerFunc[Event](func(ctx context.Context, _ Event) error {
+		restored <- ctx.Value(contextKey{})
+
+		return nil
+	}))
+	startDispatcher(t, dispatcher)
+
+	event := newTestEvent()
+	event.Metadata()["requestId"] = "request-id"
+	assert.NoError(t, dispatcher.Publish(context.Background(), event))
+
+	select {
+	case requestID := <-restored:
+		assert.Equal(t, "request-id", requestID)
+	case <-time.After(time.Second):
+		t.Fatal("event was not handled")
+	}
+}
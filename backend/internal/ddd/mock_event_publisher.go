@@ -1,6 +1,10 @@
 package ddd
 
-import "github.com/stretchr/testify/mock"
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
 
 type MockEventPublisher struct {
 	mock.Mock
@@ -8,7 +12,7 @@ type MockEventPublisher struct {
 
 var _ EventPublisher[AggregateEvent] = (*MockEventPublisher)(nil)
 
-func (m *MockEventPublisher) Publish(events ...AggregateEvent) error {
-	args := m.Called(events)
+func (m *MockEventPublisher) Publish(ctx context.Context, events ...AggregateEvent) error {
+	args := m.Called(ctx, events)
 	return args.Error(0)
 }
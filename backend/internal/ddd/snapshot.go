@@ -0,0 +1,12 @@
+package ddd
+
+type (
+	Snapshot interface {
+		SnapshotName() string
+	}
+
+	Snapshotter interface {
+		ToSnapshot() Snapshot
+		ApplySnapshot(snapshot Snapshot) error
+	}
+)
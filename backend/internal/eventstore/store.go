@@ -0,0 +1,342 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+	"github.com/FSpruhs/kick-app/backend/internal/requestid"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
+)
+
+const defaultSnapshotEvery = 50
+
+var ErrAggregateNotFound = errors.New("aggregate not found")
+
+type EventSourcedAggregate interface {
+	ddd.IDer
+	ddd.AggregateNamer
+	ddd.Versioner
+	ddd.EventApplier
+	Events() []ddd.AggregateEvent
+}
+
+type eventDocument struct {
+	ID            string            `bson:"_id"`
+	AggregateID   string            `bson:"aggregateId"`
+	AggregateName string            `bson:"aggregateName"`
+	Version       int               `bson:"version"`
+	Name          string            `bson:"name"`
+	Payload       bson.Raw          `bson:"payload"`
+	Metadata      map[string]string `bson:"metadata,omitempty"`
+	OccurredAt    time.Time         `bson:"occurredAt"`
+}
+
+type snapshotDocument struct {
+	AggregateID   string    `bson:"aggregateId"`
+	AggregateName string    `bson:"aggregateName"`
+	Version       int       `bson:"version"`
+	Name          string    `bson:"name"`
+	State         bson.Raw  `bson:"state"`
+	TakenAt       time.Time `bson:"takenAt"`
+}
+
+type Option func(s *Store)
+
+// SnapshotEvery takes a snapshot of aggregates implementing ddd.Snapshotter
+// each time they pass another n events.
+func SnapshotEvery(n int) Option {
+	return func(s *Store) {
+		s.snapshotEvery = n
+	}
+}
+
+// Store appends the events of aggregates and restores aggregates by replaying
+// them on top of their latest snapshot.
+type Store struct {
+	events        *mongo.Collection
+	snapshots     *mongo.Collection
+	registry      registry.Registry
+	snapshotEvery int
+}
+
+func NewStore(
+	database *mongo.Database,
+	eventsCollection, snapshotsCollection string,
+	reg registry.Registry,
+	options ...Option,
+) *Store {
+	store := &Store{
+		events:        database.Collection(eventsCollection),
+		snapshots:     database.Collection(snapshotsCollection),
+		registry:      reg,
+		snapshotEvery: defaultSnapshotEvery,
+	}
+
+	for _, option := range options {
+		option(store)
+	}
+
+	return store
+}
+
+// Indexes make a version of an aggregate unique, so two writers can never
+// append the same version, and keep one snapshot per aggregate.
+func Indexes(eventsCollection, snapshotsCollection string) []indexes.Index {
+	return []indexes.Index{
+		{
+			Collection: eventsCollection,
+			Keys: bson.D{
+				{Key: "aggregateName", Value: 1},
+				{Key: "aggregateId", Value: 1},
+				{Key: "version", Value: 1},
+			},
+			Unique: true,
+		},
+		{
+			Collection: snapshotsCollection,
+			Keys: bson.D{
+				{Key: "aggregateName", Value: 1},
+				{Key: "aggregateId", Value: 1},
+			},
+			Unique: true,
+		},
+	}
+}
+
+// EnsureIndexes creates the Indexes of the store.
+func (s *Store) EnsureIndexes(ctx context.Context) error {
+	if _, err := indexes.Reconcile(ctx, s.events.Database(), Indexes(s.events.Name(), s.snapshots.Name())); err != nil {
+		return fmt.Errorf("creating event store indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Load restores the aggregate from its latest snapshot and the events which
+// followed it. The aggregate must only carry its id.
+func (s *Store) Load(ctx context.Context, aggregate EventSourcedAggregate) error {
+	found, err := s.loadSnapshot(ctx, aggregate)
+	if err != nil {
+		return err
+	}
+
+	events, err := s.find(ctx, aggregate.AggregateName(), aggregate.ID(), aggregate.Version())
+	if err != nil {
+		return err
+	}
+
+	if !found && len(events) == 0 {
+		return fmt.Errorf("loading %s %s: %w", aggregate.AggregateName(), aggregate.ID(), ErrAggregateNotFound)
+	}
+
+	for _, event := range events {
+		if err := aggregate.ApplyEvent(event); err != nil {
+			return fmt.Errorf("applying event %s: %w", event.ID(), err)
+		}
+
+		aggregate.SetVersion(event.AggregateVersion())
+	}
+
+	return nil
+}
+
+// Save appends the new events of the aggregate. Call it with the session
+// context of a transaction to store other documents along with the events.
+func (s *Store) Save(ctx context.Context, aggregate EventSourcedAggregate) error {
+	events := aggregate.Events()
+	if len(events) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(events))
+
+	for i, event := range events {
+		payload, err := s.registry.Serialize(event.EventName(), event.Payload())
+		if err != nil {
+			return fmt.Errorf("serializing event %s: %w", event.EventName(), err)
+		}
+
+		tracing.Inject(ctx, event.Metadata())
+		requestid.Inject(ctx, event.Metadata())
+
+		docs[i] = eventDocument{
+			ID:            event.ID(),
+			AggregateID:   event.AggregateID(),
+			AggregateName: event.AggregateName(),
+			Version:       event.AggregateVersion(),
+			Name:          event.EventName(),
+			Payload:       payload,
+			Metadata:      event.Metadata(),
+			OccurredAt:    event.OccurredAt(),
+		}
+	}
+
+	if _, err := s.events.InsertMany(ctx, docs); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ddd.ConcurrentModificationError{
+				AggregateName: aggregate.AggregateName(),
+				AggregateID:   aggregate.ID(),
+				Version:       aggregate.Version(),
+			}
+		}
+
+		return fmt.Errorf("appending events of %s %s: %w", aggregate.AggregateName(), aggregate.ID(), err)
+	}
+
+	if s.snapshotEvery > 0 && aggregate.PendingVersion()/s.snapshotEvery > aggregate.Version()/s.snapshotEvery {
+		return s.SaveSnapshot(ctx, aggregate)
+	}
+
+	return nil
+}
+
+// SaveSnapshot stores the current state of the aggregate at its pending
+// version. Aggregates which are no ddd.Snapshotter are ignored.
+func (s *Store) SaveSnapshot(ctx context.Context, aggregate EventSourcedAggregate) error {
+	doc, err := s.toSnapshotDocument(aggregate)
+	if err != nil || doc == nil {
+		return err
+	}
+
+	filter := bson.M{"aggregateName": doc.AggregateName, "aggregateId": doc.AggregateID}
+	if _, err := s.snapshots.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true)); err != nil {
+		return fmt.Errorf("saving snapshot of %s %s: %w", aggregate.AggregateName(), aggregate.ID(), err)
+	}
+
+	return nil
+}
+
+// SaveInitialSnapshot stores the current state of the aggregate at its
+// pending version unless it has a snapshot already. It lets aggregates stored
+// before their events were recorded be loaded from the store.
+func (s *Store) SaveInitialSnapshot(ctx context.Context, aggregate EventSourcedAggregate) error {
+	doc, err := s.toSnapshotDocument(aggregate)
+	if err != nil || doc == nil {
+		return err
+	}
+
+	filter := bson.M{"aggregateName": doc.AggregateName, "aggregateId": doc.AggregateID}
+	update := bson.M{"$setOnInsert": doc}
+
+	if _, err := s.snapshots.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true)); err != nil {
+		return fmt.Errorf("saving initial snapshot of %s %s: %w", aggregate.AggregateName(), aggregate.ID(), err)
+	}
+
+	return nil
+}
+
+func (s *Store) toSnapshotDocument(aggregate EventSourcedAggregate) (*snapshotDocument, error) {
+	snapshotter, ok := aggregate.(ddd.Snapshotter)
+	if !ok {
+		return nil, nil //nolint:nilnil // no document means the aggregate takes no snapshots
+	}
+
+	snapshot := snapshotter.ToSnapshot()
+
+	state, err := s.registry.Serialize(snapshot.SnapshotName(), snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("serializing snapshot %s: %w", snapshot.SnapshotName(), err)
+	}
+
+	return &snapshotDocument{
+		AggregateID:   aggregate.ID(),
+		AggregateName: aggregate.AggregateName(),
+		Version:       aggregate.PendingVersion(),
+		Name:          snapshot.SnapshotName(),
+		State:         state,
+		TakenAt:       time.Now(),
+	}, nil
+}
+
+// History returns all events of an aggregate in the order they happened.
+func (s *Store) History(ctx context.Context, aggregateName, aggregateID string) ([]ddd.AggregateEvent, error) {
+	return s.find(ctx, aggregateName, aggregateID, 0)
+}
+
+func (s *Store) loadSnapshot(ctx context.Context, aggregate EventSourcedAggregate) (bool, error) {
+	snapshotter, ok := aggregate.(ddd.Snapshotter)
+	if !ok {
+		return false, nil
+	}
+
+	filter := bson.M{"aggregateName": aggregate.AggregateName(), "aggregateId": aggregate.ID()}
+
+	var doc snapshotDocument
+	if err := s.snapshots.FindOne(ctx, filter).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("finding snapshot of %s %s: %w", aggregate.AggregateName(), aggregate.ID(), err)
+	}
+
+	state, err := s.registry.Deserialize(doc.Name, doc.State)
+	if err != nil {
+		return false, fmt.Errorf("deserializing snapshot %s: %w", doc.Name, err)
+	}
+
+	snapshot, ok := state.(ddd.Snapshot)
+	if !ok {
+		return false, fmt.Errorf("snapshot %s: %w", doc.Name, ddd.ErrInvalidEventPayload)
+	}
+
+	if err := snapshotter.ApplySnapshot(snapshot); err != nil {
+		return false, fmt.Errorf("applying snapshot %s: %w", doc.Name, err)
+	}
+
+	aggregate.SetVersion(doc.Version)
+
+	return true, nil
+}
+
+func (s *Store) find(ctx context.Context, aggregateName, aggregateID string, afterVersion int) ([]ddd.AggregateEvent, error) {
+	filter := bson.M{
+		"aggregateName": aggregateName,
+		"aggregateId":   aggregateID,
+		"version":       bson.M{"$gt": afterVersion},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "version", Value: 1}})
+
+	cursor, err := s.events.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding events of %s %s: %w", aggregateName, aggregateID, err)
+	}
+
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var docs []*eventDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("iterating over events of %s %s: %w", aggregateName, aggregateID, err)
+	}
+
+	events := make([]ddd.AggregateEvent, len(docs))
+
+	for i, doc := range docs {
+		payload, err := s.registry.Deserialize(doc.Name, doc.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("deserializing event %s: %w", doc.ID, err)
+		}
+
+		events[i] = ddd.RestoreAggregateEvent(
+			doc.ID,
+			doc.Name,
+			payload,
+			doc.Metadata,
+			doc.OccurredAt,
+			doc.AggregateID,
+			doc.AggregateName,
+			doc.Version,
+		)
+	}
+
+	return events, nil
+}
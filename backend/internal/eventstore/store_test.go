@@ -0,0 +1,192 @@
+package eventstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+)
+
+const (
+	counterAggregate = "test.Counter"
+	addedEvent       = "test.Added"
+	counterSnapshot  = "test.CounterSnapshot"
+)
+
+type added struct {
+	Amount int `bson:"amount"`
+}
+
+type counterState struct {
+	Total int `bson:"total"`
+}
+
+func (counterState) SnapshotName() string {
+	return counterSnapshot
+}
+
+type counter struct {
+	ddd.Aggregate
+	total int
+}
+
+func newCounter(id string) *counter {
+	return &counter{Aggregate: ddd.NewAggregate(id, counterAggregate)}
+}
+
+func (c *counter) add(amount int) {
+	c.total += amount
+	c.AddEvent(addedEvent, added{Amount: amount})
+}
+
+func (c *counter) ApplyEvent(event ddd.Event) error {
+	payload, ok := event.Payload().(added)
+	if !ok {
+		return ddd.ErrInvalidEventPayload
+	}
+
+	c.total += payload.Amount
+
+	return nil
+}
+
+func (c *counter) ToSnapshot() ddd.Snapshot {
+	return counterState{Total: c.total}
+}
+
+func (c *counter) ApplySnapshot(snapshot ddd.Snapshot) error {
+	state, ok := snapshot.(counterState)
+	if !ok {
+		return ddd.ErrInvalidEventPayload
+	}
+
+	c.total = state.Total
+
+	return nil
+}
+
+func newStore(t *testing.T, options ...Option) *Store {
+	t.Helper()
+
+	reg := registry.New()
+	require.NoError(t, reg.Register(addedEvent, added{}))
+	require.NoError(t, reg.Register(counterSnapshot, counterState{}))
+
+	store := NewStore(mongotest.NewDatabase(t), "test.events", "test.snapshots", reg, options...)
+	require.NoError(t, store.EnsureIndexes(context.Background()))
+
+	return store
+}
+
+func saveCounter(t *testing.T, store *Store, c *counter, amounts ...int) {
+	t.Helper()
+
+	for _, amount := range amounts {
+		c.add(amount)
+	}
+
+	require.NoError(t, store.Save(context.Background(), c))
+	c.SetVersion(c.PendingVersion())
+	c.ClearEvents()
+}
+
+func loadCounter(t *testing.T, store *Store, id string) *counter {
+	t.Helper()
+
+	c := newCounter(id)
+	require.NoError(t, store.Load(context.Background(), c))
+
+	return c
+}
+
+func TestStore_LoadUnknownAggregate(t *testing.T) {
+	store := newStore(t)
+
+	err := store.Load(context.Background(), newCounter("counter-1"))
+
+	assert.ErrorIs(t, err, ErrAggregateNotFound)
+}
+
+func TestStore_LoadReplaysEvents(t *testing.T) {
+	store := newStore(t, SnapshotEvery(0))
+	saveCounter(t, store, newCounter("counter-1"), 1, 2, 3)
+
+	c := loadCounter(t, store, "counter-1")
+
+	assert.Equal(t, 6, c.total)
+	assert.Equal(t, 3, c.Version())
+}
+
+func TestStore_LoadFromSnapshotAndFollowingEvents(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t, SnapshotEvery(3))
+
+	c := newCounter("counter-1")
+	saveCounter(t, store, c, 1, 2, 3)
+	saveCounter(t, store, c, 4)
+
+	// the snapshot taken at version 3 has to stand in for the first events
+	_, err := store.events.DeleteMany(ctx, bson.M{"version": bson.M{"$lte": 3}})
+	require.NoError(t, err)
+
+	loaded := loadCounter(t, store, "counter-1")
+
+	assert.Equal(t, 10, loaded.total)
+	assert.Equal(t, 4, loaded.Version())
+}
+
+func TestStore_SaveConflictingVersion(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+	saveCounter(t, store, newCounter("counter-1"), 1)
+
+	first := loadCounter(t, store, "counter-1")
+	second := loadCounter(t, store, "counter-1")
+
+	first.add(2)
+	require.NoError(t, store.Save(ctx, first))
+
+	second.add(3)
+	err := store.Save(ctx, second)
+
+	assert.ErrorIs(t, err, ddd.ErrConcurrentModification)
+	assert.Equal(t, 3, loadCounter(t, store, "counter-1").total)
+}
+
+func TestStore_SaveInitialSnapshotKeepsExistingSnapshot(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t, SnapshotEvery(0))
+
+	c := newCounter("counter-1")
+	c.total = 5
+	require.NoError(t, store.SaveInitialSnapshot(ctx, c))
+
+	c.total = 7
+	require.NoError(t, store.SaveInitialSnapshot(ctx, c))
+
+	assert.Equal(t, 5, loadCounter(t, store, "counter-1").total)
+}
+
+func TestStore_History(t *testing.T) {
+	store := newStore(t)
+	c := newCounter("counter-1")
+	saveCounter(t, store, c, 1, 2)
+	saveCounter(t, store, c, 3)
+	saveCounter(t, store, newCounter("counter-2"), 4)
+
+	history, err := store.History(context.Background(), counterAggregate, "counter-1")
+	require.NoError(t, err)
+
+	require.Len(t, history, 3)
+
+	for i, event := range history {
+		assert.Equal(t, i+1, event.AggregateVersion())
+		assert.Equal(t, added{Amount: i + 1}, event.Payload())
+	}
+}
@@ -0,0 +1,37 @@
+package ginconfig
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+const UserIDKey = "userID"
+
+var (
+	ErrNotAuthenticated = ddd.UnauthorizedError("auth.not_authenticated", "user ID not found in context")
+	ErrUserMismatch     = ddd.ForbiddenError("auth.user_mismatch", "user does not match the authenticated user")
+)
+
+// ActingUserID returns the ID of the authenticated user set by
+// UserIDExtractor. Request fields naming the acting user may be passed as
+// claimed; they are only accepted if they are empty or name the same user.
+// On failure the request is aborted and ok is false.
+func ActingUserID(c *gin.Context, claimed ...string) (userID string, ok bool) {
+	userID = c.GetString(UserIDKey)
+	if userID == "" {
+		AbortWithProblem(c, ErrNotAuthenticated)
+
+		return "", false
+	}
+
+	for _, claim := range claimed {
+		if claim != "" && claim != userID {
+			AbortWithProblem(c, ErrUserMismatch)
+
+			return "", false
+		}
+	}
+
+	return userID, true
+}
@@ -0,0 +1,53 @@
+package ginconfig
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActingUserID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		userID     string
+		claimed    []string
+		wantUserID string
+		wantOK     bool
+		wantStatus int
+	}{
+		{name: "authenticated user", userID: "user-1", wantUserID: "user-1", wantOK: true, wantStatus: http.StatusOK},
+		{name: "matching claim", userID: "user-1", claimed: []string{"user-1"}, wantUserID: "user-1", wantOK: true, wantStatus: http.StatusOK},
+		{name: "empty claim", userID: "user-1", claimed: []string{""}, wantUserID: "user-1", wantOK: true, wantStatus: http.StatusOK},
+		{name: "other user claimed", userID: "user-1", claimed: []string{"user-2"}, wantStatus: http.StatusForbidden},
+		{name: "not authenticated", claimed: []string{"user-1"}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+
+			if tt.userID != "" {
+				c.Set(UserIDKey, tt.userID)
+			}
+
+			userID, ok := ActingUserID(c, tt.claimed...)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantUserID, userID)
+			assert.Equal(t, !tt.wantOK, c.IsAborted())
+
+			status := http.StatusOK
+			if err := c.Errors.Last(); err != nil {
+				status = ProblemFor(err.Err).Status
+			}
+
+			assert.Equal(t, tt.wantStatus, status)
+		})
+	}
+}
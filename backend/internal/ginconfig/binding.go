@@ -0,0 +1,166 @@
+package ginconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+const (
+	ReasonType    = "type"
+	ReasonInvalid = "invalid"
+)
+
+var validate = newValidator()
+
+// FieldError names an invalid field of a request by its json name. Reason is
+// the failed validation tag, e.g. "required", or the code of the domain error.
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// FieldErrors are all invalid fields of a request. They are reported as
+// ErrInvalidRequest.
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	fields := make([]string, len(e))
+	for i, fieldErr := range e {
+		fields[i] = fieldErr.Field + " (" + fieldErr.Reason + ")"
+	}
+
+	return "invalid fields: " + strings.Join(fields, ", ")
+}
+
+func (e FieldErrors) Unwrap() error {
+	return ErrInvalidRequest
+}
+
+// BindJSON binds the body of the request to message and validates it by its
+// validate tags. All invalid fields are reported at once. On failure the
+// request is aborted and ok is false.
+func BindJSON(c *gin.Context, message any) (ok bool) {
+	if err := c.ShouldBindJSON(message); err != nil {
+		AbortWithProblem(c, bindingError(err))
+
+		return false
+	}
+
+	if err := Validate(message); err != nil {
+		AbortWithProblem(c, err)
+
+		return false
+	}
+
+	return true
+}
+
+// Validate checks message by its validate tags and returns FieldErrors for
+// every invalid field.
+func Validate(message any) error {
+	err := validate.Struct(message)
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return err
+	}
+
+	fieldErrs := make(FieldErrors, len(validationErrs))
+	for i, validationErr := range validationErrs {
+		fieldErrs[i] = FieldError{Field: fieldName(validationErr.Namespace()), Reason: validationErr.Tag()}
+	}
+
+	return fieldErrs
+}
+
+// FieldMapping names the request field which held the value of a domain value
+// object, so the errors of the value object are reported for that field.
+type FieldMapping map[error]string
+
+// Map turns err into FieldErrors if all errors it joins belong to a request
+// field. Otherwise err is returned as it is.
+func (m FieldMapping) Map(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+
+	fieldErrs := make(FieldErrors, 0, len(errs))
+
+	for _, e := range errs {
+		fieldErr, ok := m.fieldError(e)
+		if !ok {
+			return err
+		}
+
+		fieldErrs = append(fieldErrs, fieldErr)
+	}
+
+	return fieldErrs
+}
+
+func (m FieldMapping) fieldError(err error) (FieldError, bool) {
+	for target, field := range m {
+		if !errors.Is(err, target) {
+			continue
+		}
+
+		if domainErr, ok := ddd.AsError(err); ok {
+			return FieldError{Field: field, Reason: domainErr.Code(), Detail: domainErr.Error()}, true
+		}
+
+		return FieldError{Field: field, Reason: ReasonInvalid}, true
+	}
+
+	return FieldError{}, false
+}
+
+func bindingError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return FieldErrors{{Field: typeErr.Field, Reason: ReasonType, Detail: typeErr.Type.String()}}
+	}
+
+	return InvalidRequest(err)
+}
+
+// fieldName drops the name of the struct from the namespace of a field, e.g.
+// "Message.players[0].name" becomes "players[0].name".
+func fieldName(namespace string) string {
+	_, field, found := strings.Cut(namespace, ".")
+	if !found {
+		return namespace
+	}
+
+	return field
+}
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+
+		if name == "" {
+			return field.Name
+		}
+
+		return name
+	})
+
+	return v
+}
@@ -0,0 +1,133 @@
+package ginconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+type testMessage struct {
+	Name    string       `json:"name"    validate:"required"`
+	Email   string       `json:"email"   validate:"required,email"`
+	Age     int          `json:"age"     validate:"min=18"`
+	Players []testPlayer `json:"players" validate:"dive"`
+}
+
+type testPlayer struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func TestBindJSON(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		payload    string
+		wantStatus int
+		wantErrors FieldErrors
+	}{
+		{
+			name:       "valid",
+			payload:    `{"name": "John", "email": "john@example.com", "age": 18}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "every invalid field",
+			payload:    `{"email": "john", "age": 17, "players": [{"name": ""}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantErrors: FieldErrors{
+				{Field: "name", Reason: "required"},
+				{Field: "email", Reason: "email"},
+				{Field: "age", Reason: "min"},
+				{Field: "players[0].name", Reason: "required"},
+			},
+		},
+		{
+			name:       "wrong type",
+			payload:    `{"name": "John", "email": "john@example.com", "age": "18"}`,
+			wantStatus: http.StatusBadRequest,
+			wantErrors: FieldErrors{{Field: "age", Reason: ReasonType, Detail: "int"}},
+		},
+		{
+			name:       "malformed body",
+			payload:    `{"name": `,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(ErrorHandler(slog.Default()))
+			router.POST("/test", func(c *gin.Context) {
+				var message testMessage
+				if !BindJSON(c, &message) {
+					return
+				}
+
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.wantStatus == http.StatusOK {
+				return
+			}
+
+			var problem Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+
+			assert.Equal(t, ErrInvalidRequest.Code(), problem.Code)
+			assert.Equal(t, tt.wantErrors, problem.Errors)
+		})
+	}
+}
+
+func TestFieldMapping_Map(t *testing.T) {
+	errInvalidName := ddd.ValidationError("test.invalid_name", "name is invalid")
+	errInvalidEmail := ddd.ValidationError("test.invalid_email", "email is invalid")
+	errUnmapped := errors.New("some error")
+
+	fields := FieldMapping{
+		errInvalidName:  "name",
+		errInvalidEmail: "email",
+	}
+
+	t.Run("maps joined errors", func(t *testing.T) {
+		err := fields.Map(errors.Join(fmt.Errorf("creating name: %w", errInvalidName), errInvalidEmail))
+
+		var fieldErrs FieldErrors
+		require.ErrorAs(t, err, &fieldErrs)
+		assert.ErrorIs(t, err, ErrInvalidRequest)
+		assert.Equal(t, FieldErrors{
+			{Field: "name", Reason: "test.invalid_name", Detail: "name is invalid"},
+			{Field: "email", Reason: "test.invalid_email", Detail: "email is invalid"},
+		}, fieldErrs)
+	})
+
+	t.Run("keeps errors without field", func(t *testing.T) {
+		err := errors.Join(errInvalidName, errUnmapped)
+
+		assert.Equal(t, err, fields.Map(err))
+	})
+
+	t.Run("keeps nil", func(t *testing.T) {
+		assert.NoError(t, fields.Map(nil))
+	})
+}
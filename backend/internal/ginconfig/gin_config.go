@@ -2,6 +2,7 @@ package ginconfig
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -10,13 +11,25 @@ type Config struct {
 	Port           string
 	RealmConfigURL string
 	ClientID       string
+	OIDCTimeout    time.Duration
+	// TLSCAFile adds the certificates of a PEM file to the trusted roots when
+	// talking to the issuer.
+	TLSCAFile             string
+	TLSInsecureSkipVerify bool
+	ReadTimeout           time.Duration
+	ReadHeaderTimeout     time.Duration
+	WriteTimeout          time.Duration
+	IdleTimeout           time.Duration
+	// ShutdownTimeout is how long in-flight requests may take to finish once
+	// the server stops.
+	ShutdownTimeout time.Duration
 }
 
 func CorsMiddleware() gin.HandlerFunc {
 	return func(context *gin.Context) {
 		context.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		context.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		context.Writer.Header().Set("Access-Control-Allow-Headers", "Origin, Authorization, Content-Type")
+		context.Writer.Header().Set("Access-Control-Allow-Headers", "Origin, Authorization, Content-Type, Idempotency-Key")
 		context.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 
 		if context.Request.Method == http.MethodOptions {
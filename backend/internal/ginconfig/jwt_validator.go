@@ -0,0 +1,45 @@
+package ginconfig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+// verifiedSubjectKey holds the subject of the token verified by JWTValidator.
+const verifiedSubjectKey = "verifiedSubject"
+
+var (
+	ErrTokenMissing = ddd.UnauthorizedError("auth.token_missing", "bearer token missing")
+	ErrInvalidToken = ddd.UnauthorizedError("auth.invalid_token", "invalid token")
+)
+
+func JWTValidator(verifier *TokenVerifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, found := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !found || token == "" {
+			AbortWithProblem(c, ErrTokenMissing)
+
+			return
+		}
+
+		verified, err := verifier.Verify(c.Request.Context(), token)
+		if err != nil {
+			AbortWithProblem(c, fmt.Errorf("%w: %w", ErrInvalidToken, err))
+
+			return
+		}
+
+		if verified.Subject == "" {
+			AbortWithProblem(c, fmt.Errorf("%w: user ID not found in token", ErrInvalidToken))
+
+			return
+		}
+
+		c.Set(verifiedSubjectKey, verified.Subject)
+		c.Next()
+	}
+}
@@ -0,0 +1,323 @@
+package ginconfig
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClientID = "kick"
+
+type signingKey struct {
+	id  string
+	key *rsa.PrivateKey
+}
+
+// fakeIssuer serves the discovery document and the keys of an OIDC issuer.
+type fakeIssuer struct {
+	server *httptest.Server
+	mu     sync.Mutex
+	keys   []signingKey
+}
+
+func newFakeIssuer(t *testing.T, tls bool) *fakeIssuer {
+	t.Helper()
+
+	issuer := &fakeIssuer{}
+	issuer.rotateKey(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                issuer.server.URL,
+			"jwks_uri":                              issuer.server.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(issuer.jwks())
+	})
+
+	if tls {
+		issuer.server = httptest.NewTLSServer(mux)
+	} else {
+		issuer.server = httptest.NewServer(mux)
+	}
+
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+func (f *fakeIssuer) rotateKey(t *testing.T) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.keys = append(f.keys, signingKey{id: fmt.Sprintf("key-%d", len(f.keys)+1), key: key})
+}
+
+func (f *fakeIssuer) jwks() map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	keys := make([]map[string]string, len(f.keys))
+	for i, k := range f.keys {
+		keys[i] = map[string]string{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": k.id,
+			"n":   base64.RawURLEncoding.EncodeToString(k.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.key.E)).Bytes()),
+		}
+	}
+
+	return map[string]any{"keys": keys}
+}
+
+func (f *fakeIssuer) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	f.mu.Lock()
+	current := f.keys[len(f.keys)-1]
+	f.mu.Unlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = current.id
+
+	signed, err := token.SignedString(current.key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func (f *fakeIssuer) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss": f.server.URL,
+		"sub": "user-1",
+		"aud": "account",
+		"azp": testClientID,
+		"exp": time.Now().Add(time.Minute).Unix(),
+		"iat": time.Now().Unix(),
+	}
+}
+
+func newTestVerifier(t *testing.T, issuer *fakeIssuer) *TokenVerifier {
+	t.Helper()
+
+	verifier, err := NewTokenVerifier(Config{RealmConfigURL: issuer.server.URL, ClientID: testClientID})
+	require.NoError(t, err)
+
+	return verifier
+}
+
+func TestTokenVerifier_Verify(t *testing.T) {
+	issuer := newFakeIssuer(t, false)
+	verifier := newTestVerifier(t, issuer)
+
+	tests := []struct {
+		name    string
+		claims  func(claims jwt.MapClaims)
+		wantErr bool
+	}{
+		{
+			name:   "valid token",
+			claims: func(jwt.MapClaims) {},
+		},
+		{
+			name: "client in audience",
+			claims: func(claims jwt.MapClaims) {
+				claims["aud"] = []string{"account", testClientID}
+				delete(claims, "azp")
+			},
+		},
+		{
+			name: "expired token",
+			claims: func(claims jwt.MapClaims) {
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+			},
+			wantErr: true,
+		},
+		{
+			name: "other client",
+			claims: func(claims jwt.MapClaims) {
+				claims["azp"] = "other"
+			},
+			wantErr: true,
+		},
+		{
+			name: "other issuer",
+			claims: func(claims jwt.MapClaims) {
+				claims["iss"] = "http://localhost:8080/realms/other"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := issuer.claims()
+			tt.claims(claims)
+
+			token, err := verifier.Verify(context.Background(), issuer.sign(t, claims))
+
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "user-1", token.Subject)
+		})
+	}
+}
+
+func TestTokenVerifier_VerifyRejectsForeignSignature(t *testing.T) {
+	issuer := newFakeIssuer(t, false)
+	other := newFakeIssuer(t, false)
+	verifier := newTestVerifier(t, issuer)
+
+	claims := issuer.claims()
+	token := other.sign(t, claims)
+
+	_, err := verifier.Verify(context.Background(), token)
+
+	assert.Error(t, err)
+}
+
+func TestTokenVerifier_VerifyAfterKeyRotation(t *testing.T) {
+	issuer := newFakeIssuer(t, false)
+	verifier := newTestVerifier(t, issuer)
+
+	_, err := verifier.Verify(context.Background(), issuer.sign(t, issuer.claims()))
+	require.NoError(t, err)
+
+	issuer.rotateKey(t)
+
+	_, err = verifier.Verify(context.Background(), issuer.sign(t, issuer.claims()))
+	assert.NoError(t, err)
+}
+
+func TestTokenVerifier_VerifyWithCAFile(t *testing.T) {
+	issuer := newFakeIssuer(t, true)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: issuer.server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+
+	untrusted := newTestVerifier(t, issuer)
+	_, err := untrusted.Verify(context.Background(), issuer.sign(t, issuer.claims()))
+	assert.Error(t, err)
+
+	trusted, err := NewTokenVerifier(Config{
+		RealmConfigURL: issuer.server.URL,
+		ClientID:       testClientID,
+		TLSCAFile:      caFile,
+	})
+	require.NoError(t, err)
+
+	_, err = trusted.Verify(context.Background(), issuer.sign(t, issuer.claims()))
+	assert.NoError(t, err)
+}
+
+func TestNewTokenVerifier_InvalidCAFile(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("no certificate"), 0o600))
+
+	_, err := NewTokenVerifier(Config{TLSCAFile: caFile})
+
+	assert.ErrorIs(t, err, ErrInvalidCAFile)
+}
+
+func TestJWTValidator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	issuer := newFakeIssuer(t, false)
+	router := gin.New()
+	router.Use(ErrorHandler(slog.Default()))
+	router.GET("/", JWTValidator(newTestVerifier(t, issuer)), UserIDExtractor(), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(UserIDKey))
+	})
+
+	withoutSubject := issuer.claims()
+	delete(withoutSubject, "sub")
+
+	tests := []struct {
+		name     string
+		header   string
+		want     int
+		wantUser string
+	}{
+		{name: "missing token", header: "", want: http.StatusUnauthorized},
+		{name: "invalid token", header: "Bearer invalid", want: http.StatusUnauthorized},
+		{name: "token without subject", header: "Bearer " + issuer.sign(t, withoutSubject), want: http.StatusUnauthorized},
+		{
+			name:     "valid token",
+			header:   "Bearer " + issuer.sign(t, issuer.claims()),
+			want:     http.StatusOK,
+			wantUser: "user-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+
+			if tt.wantUser != "" {
+				assert.Equal(t, tt.wantUser, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestUserIDExtractor_RequiresVerifiedToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	issuer := newFakeIssuer(t, false)
+	router := gin.New()
+	router.Use(ErrorHandler(slog.Default()))
+	router.GET("/", UserIDExtractor(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// a well-formed token is not trusted unless JWTValidator verified it
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+issuer.sign(t, issuer.claims()))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -0,0 +1,113 @@
+package ginconfig
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+const (
+	ProblemContentType = "application/problem+json"
+	CodeInternal       = "internal"
+)
+
+var ErrInvalidRequest = ddd.ValidationError("request.invalid", "request is invalid")
+
+// Problem is the body of every failed request as described in RFC 7807. Code
+// is stable, so clients branch on it instead of the human-readable detail.
+// Errors lists the invalid fields of a request.
+type Problem struct {
+	Type     string      `json:"type"`
+	Title    string      `json:"title"`
+	Status   int         `json:"status"`
+	Detail   string      `json:"detail,omitempty"`
+	Instance string      `json:"instance,omitempty"`
+	Code     string      `json:"code"`
+	Errors   FieldErrors `json:"errors,omitempty"`
+}
+
+// AbortWithProblem stops the request with err. ErrorHandler renders it once
+// the handlers returned.
+func AbortWithProblem(c *gin.Context, err error) {
+	_ = c.Error(err)
+	c.Abort()
+}
+
+// InvalidRequest marks err of binding or validating a request as
+// ErrInvalidRequest.
+func InvalidRequest(err error) error {
+	return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+}
+
+// ErrorHandler renders the last error of a request as problem, if nothing
+// was written yet. Errors which are no ddd.Error are reported as internal
+// errors without any detail, so wrapped messages never reach the client.
+func ErrorHandler(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		problem := ProblemFor(err)
+		problem.Instance = c.Request.URL.Path
+
+		if problem.Status == http.StatusInternalServerError {
+			logger.ErrorContext(c.Request.Context(), "request failed", slog.Any("error", err))
+		}
+
+		c.Header("Content-Type", ProblemContentType)
+		c.JSON(problem.Status, problem)
+	}
+}
+
+// ProblemFor maps the outermost ddd.Error in the chain of err to its problem.
+func ProblemFor(err error) Problem {
+	domainErr, ok := ddd.AsError(err)
+	if !ok {
+		return newProblem(http.StatusInternalServerError, CodeInternal, "")
+	}
+
+	problem := newProblem(statusOf(domainErr.Kind()), domainErr.Code(), domainErr.Error())
+
+	var fieldErrs FieldErrors
+	if errors.As(err, &fieldErrs) {
+		problem.Errors = fieldErrs
+	}
+
+	return problem
+}
+
+func newProblem(status int, code, detail string) Problem {
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+func statusOf(kind ddd.ErrorKind) int {
+	switch kind {
+	case ddd.KindValidation:
+		return http.StatusBadRequest
+	case ddd.KindUnauthorized:
+		return http.StatusUnauthorized
+	case ddd.KindForbidden:
+		return http.StatusForbidden
+	case ddd.KindNotFound:
+		return http.StatusNotFound
+	case ddd.KindConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
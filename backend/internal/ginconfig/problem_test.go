@@ -0,0 +1,128 @@
+package ginconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+func TestErrorHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	errNotFound := ddd.NotFoundError("test.not_found", "test not found")
+
+	tests := []struct {
+		name    string
+		handler gin.HandlerFunc
+		want    Problem
+	}{
+		{
+			name: "domain error",
+			handler: func(c *gin.Context) {
+				AbortWithProblem(c, fmt.Errorf("loading test 1: %w", errNotFound))
+			},
+			want: Problem{
+				Type:     "about:blank",
+				Title:    "Not Found",
+				Status:   http.StatusNotFound,
+				Detail:   "test not found",
+				Instance: "/test",
+				Code:     "test.not_found",
+			},
+		},
+		{
+			name: "invalid request",
+			handler: func(c *gin.Context) {
+				AbortWithProblem(c, InvalidRequest(errors.New("unexpected EOF")))
+			},
+			want: Problem{
+				Type:     "about:blank",
+				Title:    "Bad Request",
+				Status:   http.StatusBadRequest,
+				Detail:   "request is invalid",
+				Instance: "/test",
+				Code:     "request.invalid",
+			},
+		},
+		{
+			name: "unknown error",
+			handler: func(c *gin.Context) {
+				AbortWithProblem(c, errors.New("connection refused"))
+			},
+			want: Problem{
+				Type:     "about:blank",
+				Title:    "Internal Server Error",
+				Status:   http.StatusInternalServerError,
+				Instance: "/test",
+				Code:     CodeInternal,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(ErrorHandler(slog.Default()))
+			router.GET("/test", tt.handler)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+			var problem Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+
+			assert.Equal(t, tt.want.Status, rec.Code)
+			assert.Equal(t, ProblemContentType, rec.Header().Get("Content-Type"))
+			assert.Equal(t, tt.want, problem)
+		})
+	}
+}
+
+func TestErrorHandler_KeepsWrittenResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(ErrorHandler(slog.Default()))
+	router.GET("/test", func(c *gin.Context) {
+		_ = c.Error(errors.New("some error"))
+		c.Status(http.StatusAccepted)
+		c.Writer.WriteHeaderNow()
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestProblemFor(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{ddd.ValidationError("test", "test"), http.StatusBadRequest},
+		{ddd.UnauthorizedError("test", "test"), http.StatusUnauthorized},
+		{ddd.ForbiddenError("test", "test"), http.StatusForbidden},
+		{ddd.NotFoundError("test", "test"), http.StatusNotFound},
+		{ddd.ConflictError("test", "test"), http.StatusConflict},
+		{ddd.ConcurrentModificationError{AggregateName: "test.Aggregate", AggregateID: "1", Version: 2}, http.StatusConflict},
+		{errors.New("some error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			assert.Equal(t, tt.want, ProblemFor(tt.err).Status)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	defaultTimeout  = 2 * time.Second
+	defaultInterval = 5 * time.Second
+)
+
+// Check reports why a dependency is not usable. It returns nil if it is.
+type Check func(ctx context.Context) error
+
+type State string
+
+const (
+	StateStarting State = "starting"
+	StateStarted  State = "started"
+	StateFailed   State = "failed"
+)
+
+type Option func(c *Checker)
+
+// Timeout limits how long a single check may take.
+func Timeout(timeout time.Duration) Option {
+	return func(c *Checker) {
+		c.timeout = timeout
+	}
+}
+
+// Interval is how often Start reports the readiness to the gRPC health
+// service.
+func Interval(interval time.Duration) Option {
+	return func(c *Checker) {
+		c.interval = interval
+	}
+}
+
+func Logger(logger *slog.Logger) Option {
+	return func(c *Checker) {
+		c.logger = logger
+	}
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker knows whether the monolith is ready to serve. It is ready once all
+// modules are started and all checks pass, and it stays unready after the
+// monolith began to shut down.
+type Checker struct {
+	mu       sync.RWMutex
+	checks   []namedCheck
+	modules  []string
+	states   map[string]State
+	stopping bool
+	timeout  time.Duration
+	interval time.Duration
+	grpc     *grpchealth.Server
+	logger   *slog.Logger
+}
+
+func New(options ...Option) *Checker {
+	checker := &Checker{
+		states:   make(map[string]State),
+		timeout:  defaultTimeout,
+		interval: defaultInterval,
+		grpc:     grpchealth.NewServer(),
+		logger:   slog.Default(),
+	}
+
+	for _, option := range options {
+		option(checker)
+	}
+
+	// not serving until the first report
+	checker.grpc.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return checker
+}
+
+// AddCheck adds a check which must pass for the monolith to be ready. A check
+// of a module should be prefixed with the module, e.g. "group.users".
+func (c *Checker) AddCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+func (c *Checker) SetModuleState(module string, state State) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.states[module]; !exists {
+		c.modules = append(c.modules, module)
+	}
+
+	c.states[module] = state
+}
+
+// RegisterGRPC registers the standard grpc.health.v1 service, which reports
+// the same readiness as Ready.
+func (c *Checker) RegisterGRPC(registrar grpc.ServiceRegistrar) {
+	healthpb.RegisterHealthServer(registrar, c.grpc)
+}
+
+// Ready runs all checks and reports the state of every module and check. The
+// report is served without authentication, so it only tells whether a check
+// passed and the reason is logged.
+func (c *Checker) Ready(ctx context.Context) Report {
+	c.mu.RLock()
+	checks := append([]namedCheck(nil), c.checks...)
+	report := Report{
+		Status:  StatusOK,
+		Modules: make(map[string]State, len(c.modules)),
+		Checks:  make(map[string]string, len(checks)),
+	}
+
+	for _, module := range c.modules {
+		report.Modules[module] = c.states[module]
+		if c.states[module] != StateStarted {
+			report.Status = StatusUnavailable
+		}
+	}
+
+	if c.stopping {
+		report.Status = StatusUnavailable
+	}
+	c.mu.RUnlock()
+
+	for _, check := range checks {
+		report.Checks[check.name] = StatusOK
+
+		if err := c.run(ctx, check.check); err != nil {
+			report.Checks[check.name] = StatusUnavailable
+			report.Status = StatusUnavailable
+
+			c.logger.WarnContext(ctx, "readiness check failed", slog.String("check", check.name), slog.Any("error", err))
+		}
+	}
+
+	return report
+}
+
+// Start reports the readiness to the gRPC health service until the context
+// is done. Then it marks the monolith as unready.
+func (c *Checker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.report(ctx)
+
+		select {
+		case <-ctx.Done():
+			c.mu.Lock()
+			c.stopping = true
+			c.mu.Unlock()
+
+			c.grpc.Shutdown()
+
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) report(ctx context.Context) {
+	status := healthpb.HealthCheckResponse_SERVING
+
+	report := c.Ready(ctx)
+	if report.Status != StatusOK {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+
+		c.logger.WarnContext(ctx, "monolith is not ready", slog.Any("checks", report.Checks))
+	}
+
+	c.grpc.SetServingStatus("", status)
+}
+
+func (c *Checker) run(ctx context.Context, check Check) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return check(ctx)
+}
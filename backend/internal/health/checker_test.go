@@ -0,0 +1,130 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func passing(context.Context) error {
+	return nil
+}
+
+func failing(context.Context) error {
+	return errors.New("connection refused")
+}
+
+func TestChecker_Ready(t *testing.T) {
+	tests := []struct {
+		name       string
+		state      State
+		check      Check
+		wantStatus string
+	}{
+		{"started and passing", StateStarted, passing, StatusOK},
+		{"module still starting", StateStarting, passing, StatusUnavailable},
+		{"module failed", StateFailed, passing, StatusUnavailable},
+		{"check failing", StateStarted, failing, StatusUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := New()
+			checker.SetModuleState("group", tt.state)
+			checker.AddCheck("mongodb", tt.check)
+
+			report := checker.Ready(context.Background())
+
+			assert.Equal(t, tt.wantStatus, report.Status)
+			assert.Equal(t, map[string]State{"group": tt.state}, report.Modules)
+		})
+	}
+}
+
+func TestChecker_ReadyHidesCheckErrors(t *testing.T) {
+	checker := New()
+	checker.AddCheck("mongodb", failing)
+	checker.AddCheck("group.outbox", passing)
+
+	report := checker.Ready(context.Background())
+
+	assert.Equal(t, map[string]string{"mongodb": StatusUnavailable, "group.outbox": StatusOK}, report.Checks)
+}
+
+func TestChecker_ReadyTimesOutChecks(t *testing.T) {
+	checker := New(Timeout(time.Millisecond))
+	checker.AddCheck("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+
+	report := checker.Ready(context.Background())
+
+	assert.Equal(t, StatusUnavailable, report.Status)
+}
+
+func TestChecker_StartReportsToGRPC(t *testing.T) {
+	checker := New(Interval(time.Millisecond))
+	checker.SetModuleState("group", StateStarted)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		_ = checker.Start(ctx)
+
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return servingStatus(t, checker) == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker))
+	assert.Equal(t, StatusUnavailable, checker.Ready(context.Background()).Status)
+}
+
+func TestRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	checker := New()
+	checker.SetModuleState("group", StateStarting)
+
+	router := gin.New()
+	Routes(router, checker)
+
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"/healthz", http.StatusOK},
+		{"/readyz", http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+		assert.Equal(t, tt.want, rec.Code, tt.path)
+	}
+}
+
+func servingStatus(t *testing.T, checker *Checker) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	resp, err := checker.grpc.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+
+	return resp.GetStatus()
+}
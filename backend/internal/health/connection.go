@@ -0,0 +1,25 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Connection checks that a gRPC client connection is ready. An idle
+// connection is connected first, so the check does not depend on traffic.
+func Connection(conn *grpc.ClientConn) Check {
+	return func(ctx context.Context) error {
+		conn.Connect()
+
+		for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("connection to %s is %s: %w", conn.Target(), state, ctx.Err())
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,33 @@
+package health
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Routes adds /healthz, which only tells that the process is alive, and
+// /readyz, which tells whether the monolith can serve requests.
+func Routes(router *gin.Engine, checker *Checker) {
+	router.GET("/healthz", Live())
+	router.GET("/readyz", Ready(checker))
+}
+
+func Live() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.JSON(http.StatusOK, gin.H{"status": StatusOK})
+	}
+}
+
+func Ready(checker *Checker) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		report := checker.Ready(context.Request.Context())
+		if report.Status != StatusOK {
+			context.JSON(http.StatusServiceUnavailable, report)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, report)
+	}
+}
@@ -0,0 +1,12 @@
+package health
+
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+type Report struct {
+	Status  string            `json:"status"`
+	Modules map[string]State  `json:"modules"`
+	Checks  map[string]string `json:"checks"`
+}
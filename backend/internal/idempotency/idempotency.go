@@ -0,0 +1,243 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+)
+
+const (
+	Header         = "Idempotency-Key"
+	ReplayedHeader = "Idempotent-Replayed"
+	maxKeyLength   = 255
+)
+
+var (
+	ErrInvalidKey = ddd.ValidationError("idempotency.invalid_key", "idempotency key must have 1 to 255 characters")
+	ErrKeyReused  = ddd.ConflictError(
+		"idempotency.key_reused",
+		"idempotency key was already used for another request",
+	)
+	ErrInProgress = ddd.ConflictError(
+		"idempotency.in_progress",
+		"request with this idempotency key is still in progress",
+	)
+)
+
+type Config struct {
+	// TTL is how long the response of a request is kept for replays.
+	TTL time.Duration
+	// LockTimeout is how long a key stays claimed by a request which never
+	// finished, e.g. because the server stopped while handling it.
+	LockTimeout time.Duration
+}
+
+// Response is the stored response of a request.
+type Response struct {
+	Status      int
+	ContentType string
+	Location    string
+	Body        []byte
+}
+
+// Record is a claimed key. Response is nil while its request is in progress.
+type Record struct {
+	Key         string
+	Fingerprint string
+	Response    *Response
+}
+
+type Store interface {
+	// Begin claims key for a request. If the key is claimed already, its
+	// record is returned instead.
+	Begin(ctx context.Context, key, fingerprint string) (*Record, error)
+	// Complete stores the response of the request which claimed key.
+	Complete(ctx context.Context, key string, response Response) error
+	// Release frees key, so the request may be sent again. A key whose
+	// response was stored stays claimed.
+	Release(ctx context.Context, key string) error
+}
+
+type Option func(k *Keys)
+
+func Logger(logger *slog.Logger) Option {
+	return func(k *Keys) {
+		k.logger = logger
+	}
+}
+
+// Keys makes requests carrying an Idempotency-Key header safe to retry. The
+// first request with a key is handled and its response stored, every retry
+// gets the stored response without being handled again.
+type Keys struct {
+	store  Store
+	logger *slog.Logger
+}
+
+func New(store Store, options ...Option) *Keys {
+	keys := &Keys{
+		store:  store,
+		logger: slog.Default(),
+	}
+
+	for _, option := range options {
+		option(keys)
+	}
+
+	return keys
+}
+
+// GinMiddleware must run after ginconfig.UserIDExtractor, because keys are
+// scoped to the acting user. Requests without key are handled as usual.
+// Failed requests are not stored, so their retry is handled again. A request
+// which was handled but whose response could not be stored keeps its key
+// claimed instead, so its retry is not handled twice.
+func (k *Keys) GinMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(Header)
+		if key == "" {
+			c.Next()
+
+			return
+		}
+
+		if len(key) > maxKeyLength {
+			ginconfig.AbortWithProblem(c, ErrInvalidKey)
+
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			ginconfig.AbortWithProblem(c, ginconfig.InvalidRequest(err))
+
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		scopedKey := c.GetString(ginconfig.UserIDKey) + ":" + key
+		requestFingerprint := fingerprint(c.Request, body)
+
+		record, err := k.store.Begin(ctx, scopedKey, requestFingerprint)
+		if err != nil {
+			ginconfig.AbortWithProblem(c, err)
+
+			return
+		}
+
+		if record != nil {
+			replay(c, record, requestFingerprint)
+
+			return
+		}
+
+		k.handle(c, scopedKey)
+	}
+}
+
+func (k *Keys) handle(c *gin.Context, key string) {
+	ctx := c.Request.Context()
+	recorder := &responseRecorder{ResponseWriter: c.Writer}
+	c.Writer = recorder
+
+	handled := false
+
+	defer func() {
+		c.Writer = recorder.ResponseWriter
+
+		if handled {
+			return
+		}
+
+		// the request may have been canceled, the key is freed anyway
+		if err := k.store.Release(context.WithoutCancel(ctx), key); err != nil {
+			k.logger.ErrorContext(ctx, "releasing idempotency key failed", slog.Any("error", err))
+		}
+	}()
+
+	c.Next()
+
+	// errors are rendered by ginconfig.ErrorHandler once this returned
+	failed := len(c.Errors) > 0 && !recorder.Written()
+	if failed || recorder.Status() >= http.StatusInternalServerError {
+		return
+	}
+
+	// the request changed something, so it must not be handled again even if
+	// its response cannot be stored
+	handled = true
+
+	response := Response{
+		Status:      recorder.Status(),
+		ContentType: recorder.Header().Get("Content-Type"),
+		Location:    recorder.Header().Get("Location"),
+		Body:        recorder.body.Bytes(),
+	}
+
+	// a retry is rejected as in progress until the claim expires
+	if err := k.store.Complete(context.WithoutCancel(ctx), key, response); err != nil {
+		k.logger.ErrorContext(ctx, "storing idempotent response failed", slog.Any("error", err))
+	}
+}
+
+func replay(c *gin.Context, record *Record, requestFingerprint string) {
+	if record.Fingerprint != requestFingerprint {
+		ginconfig.AbortWithProblem(c, ErrKeyReused)
+
+		return
+	}
+
+	if record.Response == nil {
+		ginconfig.AbortWithProblem(c, ErrInProgress)
+
+		return
+	}
+
+	if record.Response.Location != "" {
+		c.Header("Location", record.Response.Location)
+	}
+
+	c.Header(ReplayedHeader, "true")
+	c.Data(record.Response.Status, record.Response.ContentType, record.Response.Body)
+	c.Abort()
+}
+
+// fingerprint identifies a request by its method, path and body, so a key
+// sent to another endpoint counts as reused as well.
+func fingerprint(request *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(request.Method + " " + request.URL.Path + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// responseRecorder keeps a copy of the body written to the client.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+
+	return r.ResponseWriter.Write(data) //nolint:wrapcheck // the writer of gin is passed through
+}
+
+func (r *responseRecorder) WriteString(data string) (int, error) {
+	r.body.WriteString(data)
+
+	return r.ResponseWriter.WriteString(data) //nolint:wrapcheck // the writer of gin is passed through
+}
@@ -0,0 +1,207 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+)
+
+func newMemoryStore() *MemoryStore {
+	return NewMemoryStore(Config{TTL: time.Hour, LockTimeout: time.Minute})
+}
+
+func newRouter(store Store, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
+	router.Use(func(c *gin.Context) {
+		c.Set(ginconfig.UserIDKey, c.GetHeader("X-User"))
+	})
+	router.POST("/group", New(store).GinMiddleware(), handler)
+
+	return router
+}
+
+func send(router *gin.Engine, user, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/group", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-User", user)
+
+	if key != "" {
+		req.Header.Set(Header, key)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestKeys_ReplaysStoredResponse(t *testing.T) {
+	calls := 0
+	router := newRouter(newMemoryStore(), func(c *gin.Context) {
+		calls++
+		c.Header("Location", "/api/v1/group/1")
+		c.JSON(http.StatusCreated, gin.H{"id": "1", "calls": calls})
+	})
+
+	first := send(router, "user-1", "key-1", `{"name": "group"}`)
+	retry := send(router, "user-1", "key-1", `{"name": "group"}`)
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, first.Body.String(), retry.Body.String())
+	assert.Equal(t, "application/json; charset=utf-8", retry.Header().Get("Content-Type"))
+	assert.Equal(t, "/api/v1/group/1", retry.Header().Get("Location"))
+	assert.Equal(t, "true", retry.Header().Get(ReplayedHeader))
+	assert.Empty(t, first.Header().Get(ReplayedHeader))
+}
+
+func TestKeys_RejectsReusedKeyWithOtherBody(t *testing.T) {
+	router := newRouter(newMemoryStore(), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	send(router, "user-1", "key-1", `{"name": "group"}`)
+	rec := send(router, "user-1", "key-1", `{"name": "other group"}`)
+
+	var problem ginconfig.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, ErrKeyReused.Code(), problem.Code)
+}
+
+func TestKeys_RejectsKeyInProgress(t *testing.T) {
+	store := newMemoryStore()
+	router := newRouter(store, func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	_, err := store.Begin(context.Background(), "user-1:key-1", fingerprint(
+		httptest.NewRequest(http.MethodPost, "/group", nil),
+		[]byte(`{"name": "group"}`),
+	))
+	require.NoError(t, err)
+
+	rec := send(router, "user-1", "key-1", `{"name": "group"}`)
+
+	var problem ginconfig.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, ErrInProgress.Code(), problem.Code)
+}
+
+// failingCompleteStore loses the responses it should store.
+type failingCompleteStore struct {
+	*MemoryStore
+}
+
+func (s failingCompleteStore) Complete(context.Context, string, Response) error {
+	return errors.New("connection refused")
+}
+
+func TestKeys_KeepsKeyClaimedWhenResponseIsNotStored(t *testing.T) {
+	calls := 0
+	router := newRouter(failingCompleteStore{newMemoryStore()}, func(c *gin.Context) {
+		calls++
+		c.Status(http.StatusCreated)
+	})
+
+	first := send(router, "user-1", "key-1", `{"name": "group"}`)
+	retry := send(router, "user-1", "key-1", `{"name": "group"}`)
+
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusConflict, retry.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestKeys_ScopesKeyToUser(t *testing.T) {
+	calls := 0
+	router := newRouter(newMemoryStore(), func(c *gin.Context) {
+		calls++
+		c.Status(http.StatusCreated)
+	})
+
+	send(router, "user-1", "key-1", `{"name": "group"}`)
+	send(router, "user-2", "key-1", `{"name": "group"}`)
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestKeys_HandlesRequestWithoutKey(t *testing.T) {
+	calls := 0
+	router := newRouter(newMemoryStore(), func(c *gin.Context) {
+		calls++
+		c.Status(http.StatusCreated)
+	})
+
+	send(router, "user-1", "", `{"name": "group"}`)
+	send(router, "user-1", "", `{"name": "group"}`)
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestKeys_ReleasesKeyOfFailedRequest(t *testing.T) {
+	calls := 0
+	router := newRouter(newMemoryStore(), func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			ginconfig.AbortWithProblem(c, errors.New("connection refused"))
+
+			return
+		}
+
+		c.Status(http.StatusCreated)
+	})
+
+	failed := send(router, "user-1", "key-1", `{"name": "group"}`)
+	retry := send(router, "user-1", "key-1", `{"name": "group"}`)
+
+	assert.Equal(t, http.StatusInternalServerError, failed.Code)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestKeys_PassesBodyOn(t *testing.T) {
+	router := newRouter(newMemoryStore(), func(c *gin.Context) {
+		var message struct {
+			Name string `json:"name"`
+		}
+
+		require.NoError(t, c.ShouldBindJSON(&message))
+		c.String(http.StatusCreated, message.Name)
+	})
+
+	rec := send(router, "user-1", "key-1", `{"name": "group"}`)
+
+	assert.Equal(t, "group", rec.Body.String())
+}
+
+func TestMemoryStore_ExpiredRecordIsAbsent(t *testing.T) {
+	store := NewMemoryStore(Config{TTL: time.Hour, LockTimeout: -time.Second})
+	ctx := context.Background()
+
+	record, err := store.Begin(ctx, "key-1", "first")
+	require.NoError(t, err)
+	assert.Nil(t, record)
+
+	// the claim of the first request expired already
+	record, err = store.Begin(ctx, "key-1", "second")
+	require.NoError(t, err)
+	assert.Nil(t, record)
+}
@@ -0,0 +1,72 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryRecord struct {
+	record    Record
+	expiresAt time.Time
+}
+
+// MemoryStore keeps the records in memory. Like the MongoStore, a claimed key
+// expires after the lock timeout and a stored response after the TTL.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]*memoryRecord
+	cfg     Config
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+func NewMemoryStore(cfg Config) *MemoryStore {
+	return &MemoryStore{
+		records: make(map[string]*memoryRecord),
+		cfg:     cfg,
+	}
+}
+
+func (s *MemoryStore) Begin(_ context.Context, key, fingerprint string) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	if stored, ok := s.records[key]; ok && now.Before(stored.expiresAt) {
+		record := stored.record
+
+		return &record, nil
+	}
+
+	s.records[key] = &memoryRecord{
+		record:    Record{Key: key, Fingerprint: fingerprint, Response: nil},
+		expiresAt: now.Add(s.cfg.LockTimeout),
+	}
+
+	return nil, nil //nolint:nilnil // no record means the key was claimed
+}
+
+func (s *MemoryStore) Complete(_ context.Context, key string, response Response) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if stored, ok := s.records[key]; ok {
+		stored.record.Response = &response
+		stored.expiresAt = time.Now().Add(s.cfg.TTL)
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if stored, ok := s.records[key]; ok && stored.record.Response == nil {
+		delete(s.records, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+)
+
+// claimAttempts bounds how often Begin claims a key whose record vanished.
+const claimAttempts = 3
+
+type recordDocument struct {
+	ID          string            `bson:"_id"`
+	Fingerprint string            `bson:"fingerprint"`
+	Response    *responseDocument `bson:"response,omitempty"`
+	CreatedAt   time.Time         `bson:"createdAt"`
+	ExpiresAt   time.Time         `bson:"expiresAt"`
+}
+
+type responseDocument struct {
+	Status      int    `bson:"status"`
+	ContentType string `bson:"contentType"`
+	Location    string `bson:"location,omitempty"`
+	Body        []byte `bson:"body"`
+}
+
+// MongoStore keeps the records in a collection, which removes them by a TTL
+// index once they expired. A claimed key expires after the lock timeout, a
+// stored response after the TTL.
+type MongoStore struct {
+	collection *mongo.Collection
+	cfg        Config
+	find       func(ctx context.Context, key string) (*recordDocument, error)
+}
+
+var _ Store = (*MongoStore)(nil)
+
+func NewMongoStore(database *mongo.Database, collectionName string, cfg Config) *MongoStore {
+	store := &MongoStore{
+		collection: database.Collection(collectionName),
+		cfg:        cfg,
+	}
+	store.find = store.findRecord
+
+	return store
+}
+
+// Indexes let mongodb remove expired records.
+func Indexes(collectionName string) []indexes.Index {
+	return []indexes.Index{
+		{Collection: collectionName, Keys: bson.D{{Key: "expiresAt", Value: 1}}, ExpireAfter: indexes.After(0)},
+	}
+}
+
+// EnsureIndexes creates the Indexes of the store.
+func (s *MongoStore) EnsureIndexes(ctx context.Context) error {
+	if _, err := indexes.Reconcile(ctx, s.collection.Database(), Indexes(s.collection.Name())); err != nil {
+		return fmt.Errorf("creating idempotency indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Begin claims a key which is not stored or whose record expired. The TTL
+// index removes expired records only once a minute, so they are replaced here.
+// If the TTL index removes the record between the claim and its lookup, the
+// key is claimed again.
+func (s *MongoStore) Begin(ctx context.Context, key, fingerprint string) (*Record, error) {
+	var err error
+
+	for range claimAttempts {
+		var record *Record
+
+		record, err = s.claim(ctx, key, fingerprint)
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return record, err
+		}
+	}
+
+	return nil, err
+}
+
+func (s *MongoStore) claim(ctx context.Context, key, fingerprint string) (*Record, error) {
+	now := time.Now()
+	doc := recordDocument{
+		ID:          key,
+		Fingerprint: fingerprint,
+		Response:    nil,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.cfg.LockTimeout),
+	}
+
+	filter := bson.M{"_id": key, "expiresAt": bson.M{"$lte": now}}
+
+	_, err := s.collection.ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true))
+	if err == nil {
+		return nil, nil //nolint:nilnil // no record means the key was claimed
+	}
+
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, fmt.Errorf("claiming idempotency key: %w", err)
+	}
+
+	existing, err := s.find(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("finding idempotency record: %w", err)
+	}
+
+	return existing.toRecord(), nil
+}
+
+func (s *MongoStore) findRecord(ctx context.Context, key string) (*recordDocument, error) {
+	var record recordDocument
+	if err := s.collection.FindOne(ctx, bson.M{"_id": key}).Decode(&record); err != nil {
+		return nil, err //nolint:wrapcheck // wrapped by the caller
+	}
+
+	return &record, nil
+}
+
+func (s *MongoStore) Complete(ctx context.Context, key string, response Response) error {
+	update := bson.M{"$set": bson.M{
+		"response": responseDocument{
+			Status:      response.Status,
+			ContentType: response.ContentType,
+			Location:    response.Location,
+			Body:        response.Body,
+		},
+		"expiresAt": time.Now().Add(s.cfg.TTL),
+	}}
+
+	if _, err := s.collection.UpdateByID(ctx, key, update); err != nil {
+		return fmt.Errorf("storing idempotent response: %w", err)
+	}
+
+	return nil
+}
+
+func (s *MongoStore) Release(ctx context.Context, key string) error {
+	if _, err := s.collection.DeleteOne(ctx, bson.M{"_id": key, "response": nil}); err != nil {
+		return fmt.Errorf("releasing idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (d *recordDocument) toRecord() *Record {
+	record := &Record{
+		Key:         d.ID,
+		Fingerprint: d.Fingerprint,
+		Response:    nil,
+	}
+
+	if d.Response != nil {
+		record.Response = &Response{
+			Status:      d.Response.Status,
+			ContentType: d.Response.ContentType,
+			Location:    d.Response.Location,
+			Body:        d.Response.Body,
+		}
+	}
+
+	return record
+}
@@ -0,0 +1,73 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+)
+
+func TestMongoStore_Begin(t *testing.T) {
+	store := NewMongoStore(mongotest.NewDatabase(t), "idempotency.keys", Config{TTL: time.Hour, LockTimeout: time.Minute})
+	ctx := context.Background()
+
+	record, err := store.Begin(ctx, "key-1", "fingerprint")
+	require.NoError(t, err)
+	assert.Nil(t, record)
+
+	record, err = store.Begin(ctx, "key-1", "fingerprint")
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Nil(t, record.Response)
+
+	response := Response{Status: http.StatusCreated, ContentType: "text/plain", Body: []byte("ok")}
+	require.NoError(t, store.Complete(ctx, "key-1", response))
+
+	record, err = store.Begin(ctx, "key-1", "fingerprint")
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, &response, record.Response)
+}
+
+func TestMongoStore_BeginClaimsExpiredKey(t *testing.T) {
+	store := NewMongoStore(mongotest.NewDatabase(t), "idempotency.keys", Config{TTL: time.Hour, LockTimeout: -time.Second})
+	ctx := context.Background()
+
+	_, err := store.Begin(ctx, "key-1", "first")
+	require.NoError(t, err)
+
+	// the claim of the first request expired already
+	record, err := store.Begin(ctx, "key-1", "second")
+	require.NoError(t, err)
+	assert.Nil(t, record)
+}
+
+func TestMongoStore_BeginReclaimsKeyRemovedByTTL(t *testing.T) {
+	store := NewMongoStore(mongotest.NewDatabase(t), "idempotency.keys", Config{TTL: time.Hour, LockTimeout: time.Minute})
+	ctx := context.Background()
+
+	_, err := store.Begin(ctx, "key-1", "first")
+	require.NoError(t, err)
+
+	// the TTL index removes the record between the claim and its lookup
+	store.find = func(ctx context.Context, key string) (*recordDocument, error) {
+		_, err := store.collection.DeleteOne(ctx, bson.M{"_id": key})
+		require.NoError(t, err)
+
+		return store.findRecord(ctx, key)
+	}
+
+	record, err := store.Begin(ctx, "key-1", "second")
+	require.NoError(t, err)
+	assert.Nil(t, record)
+
+	var claimed recordDocument
+	require.NoError(t, store.collection.FindOne(ctx, bson.M{"_id": "key-1"}).Decode(&claimed))
+	assert.Equal(t, "second", claimed.Fingerprint)
+}
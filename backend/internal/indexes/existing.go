@@ -0,0 +1,187 @@
+package indexes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// text indexes are stored with these keys and their fields as weights
+const (
+	textKey      = "_fts"
+	textIndexKey = "_ftsx"
+)
+
+type existingIndex struct {
+	Name               string   `bson:"name"`
+	Key                bson.D   `bson:"key"`
+	Unique             bool     `bson:"unique"`
+	ExpireAfterSeconds *float64 `bson:"expireAfterSeconds"`
+	Weights            bson.D   `bson:"weights"`
+}
+
+type indexStats struct {
+	Name     string `bson:"name"`
+	Accesses struct {
+		Ops int64 `bson:"ops"`
+	} `bson:"accesses"`
+}
+
+func list(ctx context.Context, collection *mongo.Collection) ([]existingIndex, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing indexes of %s: %w", collection.Name(), err)
+	}
+
+	var existing []existingIndex
+	if err := cursor.All(ctx, &existing); err != nil {
+		return nil, fmt.Errorf("reading indexes of %s: %w", collection.Name(), err)
+	}
+
+	return existing, nil
+}
+
+// unused returns the names of the indexes not used since mongodb started. It
+// returns nothing if the statistics cannot be read.
+func unused(ctx context.Context, collection *mongo.Collection) []string {
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{{{Key: "$indexStats", Value: bson.D{}}}})
+	if err != nil {
+		return nil
+	}
+
+	var stats []indexStats
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil
+	}
+
+	var names []string
+
+	for _, stat := range stats {
+		if stat.Accesses.Ops == 0 {
+			names = append(names, stat.Name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// match finds the existing index of the declared one, by name or else by
+// keys, and tells how it differs from the declaration.
+func match(index Index, existing []existingIndex) (*existingIndex, string) {
+	keys := declaredKeys(index.Keys)
+
+	for i := range existing {
+		if existing[i].Name == index.name() {
+			return &existing[i], differences(index, keys, existing[i])
+		}
+	}
+
+	for i := range existing {
+		if slices.Equal(keys, existing[i].keys()) {
+			return &existing[i], fmt.Sprintf("exists as %s", existing[i].Name)
+		}
+	}
+
+	return nil, ""
+}
+
+func differences(index Index, keys []string, existing existingIndex) string {
+	switch {
+	case !slices.Equal(keys, existing.keys()):
+		return fmt.Sprintf("keys are %v instead of %v", existing.keys(), keys)
+	case existing.Unique != index.Unique:
+		return fmt.Sprintf("unique is %t instead of %t", existing.Unique, index.Unique)
+	case !sameExpiry(index, existing):
+		return "expiry differs"
+	default:
+		return ""
+	}
+}
+
+func sameExpiry(index Index, existing existingIndex) bool {
+	if index.ExpireAfter == nil || existing.ExpireAfterSeconds == nil {
+		return index.ExpireAfter == nil && existing.ExpireAfterSeconds == nil
+	}
+
+	return index.ExpireAfter.Seconds() == *existing.ExpireAfterSeconds
+}
+
+// keys describes the keys of the existing index like declaredKeys does.
+func (e existingIndex) keys() []string {
+	var result []string
+
+	for _, key := range e.Key {
+		switch key.Key {
+		case textKey:
+			result = append(result, textKeys(e.Weights)...)
+		case textIndexKey:
+		default:
+			result = append(result, key.Key+":"+keyValue(key.Value))
+		}
+	}
+
+	return result
+}
+
+// declaredKeys describes the keys as "field:direction". The fields of a text
+// index are sorted, because mongodb stores them as weights without order.
+func declaredKeys(keys bson.D) []string {
+	var (
+		result []string
+		text   bson.D
+	)
+
+	for _, key := range keys {
+		if key.Value == Text {
+			if text == nil {
+				result = append(result, "")
+			}
+
+			text = append(text, bson.E{Key: key.Key, Value: 1})
+
+			continue
+		}
+
+		result = append(result, key.Key+":"+keyValue(key.Value))
+	}
+
+	if text == nil {
+		return result
+	}
+
+	at := slices.Index(result, "")
+
+	return slices.Concat(result[:at], textKeys(text), result[at+1:])
+}
+
+func textKeys(weights bson.D) []string {
+	result := make([]string, len(weights))
+	for i, weight := range weights {
+		result[i] = weight.Key + ":" + Text
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+func keyValue(value any) string {
+	switch typed := value.(type) {
+	case int:
+		return strconv.Itoa(typed)
+	case int32:
+		return strconv.Itoa(int(typed))
+	case int64:
+		return strconv.FormatInt(typed, 10)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
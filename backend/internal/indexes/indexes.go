@@ -0,0 +1,217 @@
+package indexes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Text is the value of a key of a text index.
+const Text = "text"
+
+const idIndexName = "_id_"
+
+// Index is an index a module declares on one of its collections.
+type Index struct {
+	Collection string
+	// Name defaults to the name mongodb gives the keys, e.g. "userId_1_groupId_1".
+	Name   string
+	Keys   bson.D
+	Unique bool
+	// ExpireAfter makes it a TTL index, which removes a document once the
+	// time in its key is ExpireAfter past.
+	ExpireAfter *time.Duration
+}
+
+// After is the duration of a TTL index.
+func After(d time.Duration) *time.Duration {
+	return &d
+}
+
+func (i Index) String() string {
+	return i.Collection + "." + i.name()
+}
+
+func (i Index) name() string {
+	if i.Name != "" {
+		return i.Name
+	}
+
+	parts := make([]string, len(i.Keys))
+	for j, key := range i.Keys {
+		parts[j] = fmt.Sprintf("%s_%v", key.Key, key.Value)
+	}
+
+	return strings.Join(parts, "_")
+}
+
+func (i Index) model() mongo.IndexModel {
+	opts := options.Index().SetName(i.name())
+
+	if i.Unique {
+		opts.SetUnique(true)
+	}
+
+	if i.ExpireAfter != nil {
+		opts.SetExpireAfterSeconds(int32(i.ExpireAfter.Seconds()))
+	}
+
+	return mongo.IndexModel{Keys: i.Keys, Options: opts}
+}
+
+// Source declares the indexes of the collections it owns.
+type Source interface {
+	Indexes() []Index
+}
+
+// Drift is a declared index which exists with other keys or options.
+type Drift struct {
+	Index  Index
+	Reason string
+}
+
+// Existing is an index found in the database.
+type Existing struct {
+	Collection string
+	Name       string
+}
+
+func (e Existing) String() string {
+	return e.Collection + "." + e.Name
+}
+
+// Report is the outcome of reconciling the declared indexes.
+type Report struct {
+	// Created are the missing indexes which were created.
+	Created []Index
+	// Missing are the missing indexes which were not created in a dry run.
+	Missing []Index
+	// Drifted are never changed. Rebuilding an index can block the
+	// collection, so it is left to a migration.
+	Drifted []Drift
+	// Undeclared are indexes on the collections which no one declared.
+	Undeclared []Existing
+	// Unused are declared indexes without any use since mongodb started.
+	// They are only known if the database user may read index statistics.
+	Unused []Existing
+}
+
+// Log writes the report, drift and undeclared indexes as warning.
+func (r Report) Log(logger *slog.Logger) {
+	for _, index := range r.Created {
+		logger.Info("created index", slog.String("index", index.String()))
+	}
+
+	for _, index := range r.Missing {
+		logger.Warn("missing index", slog.String("index", index.String()))
+	}
+
+	for _, drift := range r.Drifted {
+		logger.Warn("index drifted", slog.String("index", drift.Index.String()), slog.String("reason", drift.Reason))
+	}
+
+	for _, index := range r.Undeclared {
+		logger.Warn("undeclared index", slog.String("index", index.String()))
+	}
+
+	for _, index := range r.Unused {
+		logger.Info("unused index", slog.String("index", index.String()))
+	}
+}
+
+type config struct {
+	dryRun bool
+}
+
+type Option func(*config)
+
+// DryRun reports the missing indexes instead of creating them.
+func DryRun() Option {
+	return func(c *config) {
+		c.dryRun = true
+	}
+}
+
+// Reconcile creates the declared indexes which are missing and reports the
+// ones which differ from their declaration as well as the indexes no one
+// declared on the collections.
+func Reconcile(ctx context.Context, db *mongo.Database, declared []Index, opts ...Option) (Report, error) {
+	cfg := config{dryRun: false}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var report Report
+
+	for _, collectionName := range collections(declared) {
+		collection := db.Collection(collectionName)
+
+		existing, err := list(ctx, collection)
+		if err != nil {
+			return Report{}, err
+		}
+
+		seen := make(map[string]bool, len(existing))
+
+		for _, index := range declared {
+			if index.Collection != collectionName {
+				continue
+			}
+
+			found, drift := match(index, existing)
+			if found != nil {
+				seen[found.Name] = true
+			}
+
+			switch {
+			case drift != "":
+				report.Drifted = append(report.Drifted, Drift{Index: index, Reason: drift})
+			case found != nil:
+			case cfg.dryRun:
+				report.Missing = append(report.Missing, index)
+			default:
+				if _, err := collection.Indexes().CreateOne(ctx, index.model()); err != nil {
+					return Report{}, fmt.Errorf("creating index %s: %w", index, err)
+				}
+
+				seen[index.name()] = true
+				report.Created = append(report.Created, index)
+			}
+		}
+
+		for _, index := range existing {
+			if !seen[index.Name] && index.Name != idIndexName {
+				report.Undeclared = append(report.Undeclared, Existing{Collection: collectionName, Name: index.Name})
+			}
+		}
+
+		for _, name := range unused(ctx, collection) {
+			if seen[name] {
+				report.Unused = append(report.Unused, Existing{Collection: collectionName, Name: name})
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// collections returns the declared collections in the order of their first
+// index.
+func collections(declared []Index) []string {
+	var result []string
+
+	for _, index := range declared {
+		if !slices.Contains(result, index.Collection) {
+			result = append(result, index.Collection)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,156 @@
+package indexes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+)
+
+func TestIndex_Name(t *testing.T) {
+	index := Index{Collection: "users", Keys: bson.D{{Key: "userId", Value: 1}, {Key: "occurredAt", Value: -1}}}
+
+	assert.Equal(t, "userId_1_occurredAt_-1", index.name())
+	assert.Equal(t, "users.userId_1_occurredAt_-1", index.String())
+
+	index.Name = "by_user"
+	assert.Equal(t, "by_user", index.name())
+}
+
+func TestMatch(t *testing.T) {
+	declared := Index{Collection: "users", Keys: bson.D{{Key: "email", Value: 1}}, Unique: true}
+	expiring := Index{Collection: "keys", Keys: bson.D{{Key: "expiresAt", Value: 1}}, ExpireAfter: After(time.Minute)}
+	text := Index{
+		Collection: "groups",
+		Keys:       bson.D{{Key: "owner", Value: 1}, {Key: "name", Value: Text}, {Key: "about", Value: Text}},
+	}
+	seconds := float64(60)
+
+	tests := []struct {
+		name     string
+		index    Index
+		existing []existingIndex
+		found    string
+		drift    string
+	}{
+		{
+			name:     "finds index by name",
+			index:    declared,
+			existing: []existingIndex{{Name: "email_1", Key: bson.D{{Key: "email", Value: int32(1)}}, Unique: true}},
+			found:    "email_1",
+		},
+		{
+			name:     "misses index",
+			index:    declared,
+			existing: []existingIndex{{Name: "_id_", Key: bson.D{{Key: "_id", Value: int32(1)}}}},
+		},
+		{
+			name:     "detects changed option",
+			index:    declared,
+			existing: []existingIndex{{Name: "email_1", Key: bson.D{{Key: "email", Value: 1.0}}}},
+			found:    "email_1",
+			drift:    "unique is false instead of true",
+		},
+		{
+			name:     "detects changed keys",
+			index:    Index{Collection: "users", Name: "by_email", Keys: bson.D{{Key: "email", Value: 1}}},
+			existing: []existingIndex{{Name: "by_email", Key: bson.D{{Key: "email", Value: int32(-1)}}}},
+			found:    "by_email",
+			drift:    "keys are [email:-1] instead of [email:1]",
+		},
+		{
+			name:     "detects other name",
+			index:    declared,
+			existing: []existingIndex{{Name: "unique_email", Key: bson.D{{Key: "email", Value: int32(1)}}, Unique: true}},
+			found:    "unique_email",
+			drift:    "exists as unique_email",
+		},
+		{
+			name:  "compares expiry",
+			index: expiring,
+			existing: []existingIndex{
+				{Name: "expiresAt_1", Key: bson.D{{Key: "expiresAt", Value: int32(1)}}, ExpireAfterSeconds: &seconds},
+			},
+			found: "expiresAt_1",
+		},
+		{
+			name:     "detects missing expiry",
+			index:    expiring,
+			existing: []existingIndex{{Name: "expiresAt_1", Key: bson.D{{Key: "expiresAt", Value: int32(1)}}}},
+			found:    "expiresAt_1",
+			drift:    "expiry differs",
+		},
+		{
+			name:  "compares text index by its weights",
+			index: text,
+			existing: []existingIndex{{
+				Name: "owner_1_name_text_about_text",
+				Key: bson.D{
+					{Key: "owner", Value: int32(1)},
+					{Key: textKey, Value: Text},
+					{Key: textIndexKey, Value: int32(1)},
+				},
+				Weights: bson.D{{Key: "about", Value: int32(1)}, {Key: "name", Value: int32(1)}},
+			}},
+			found: "owner_1_name_text_about_text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found, drift := match(tt.index, tt.existing)
+
+			if tt.found == "" {
+				assert.Nil(t, found)
+			} else {
+				require.NotNil(t, found)
+				assert.Equal(t, tt.found, found.Name)
+			}
+
+			assert.Equal(t, tt.drift, drift)
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	db := mongotest.NewDatabase(t)
+
+	_, err := db.Collection("users").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "nickName", Value: 1}},
+		Options: options.Index().SetName("legacy"),
+	})
+	require.NoError(t, err)
+
+	declared := []Index{
+		{Collection: "users", Keys: bson.D{{Key: "email", Value: 1}}, Unique: true},
+		{Collection: "keys", Keys: bson.D{{Key: "expiresAt", Value: 1}}, ExpireAfter: After(0)},
+	}
+
+	dryRun, err := Reconcile(ctx, db, declared, DryRun())
+	require.NoError(t, err)
+	assert.Equal(t, declared, dryRun.Missing)
+	assert.Empty(t, dryRun.Created)
+
+	report, err := Reconcile(ctx, db, declared)
+	require.NoError(t, err)
+	assert.Equal(t, declared, report.Created)
+	assert.Equal(t, []Existing{{Collection: "users", Name: "legacy"}}, report.Undeclared)
+
+	again, err := Reconcile(ctx, db, declared)
+	require.NoError(t, err)
+	assert.Empty(t, again.Created)
+	assert.Empty(t, again.Drifted)
+
+	declared[0].Unique = false
+	drifted, err := Reconcile(ctx, db, declared)
+	require.NoError(t, err)
+	assert.Equal(t, []Drift{{Index: declared[0], Reason: "unique is true instead of false"}}, drifted.Drifted)
+}
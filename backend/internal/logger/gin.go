@@ -0,0 +1,33 @@
+package logger
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GinMiddleware logs every request once it was handled. It replaces the
+// default logger of gin, so requests end up in the same JSON output with
+// their request id.
+func GinMiddleware(logger *slog.Logger) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		start := time.Now()
+
+		context.Next()
+
+		level := slog.LevelInfo
+		if context.Writer.Status() >= 500 {
+			level = slog.LevelError
+		}
+
+		logger.LogAttrs(context.Request.Context(), level, "handled request",
+			slog.String("method", context.Request.Method),
+			slog.String("path", context.Request.URL.Path),
+			slog.String("route", context.FullPath()),
+			slog.Int("status", context.Writer.Status()),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("client_ip", context.ClientIP()),
+		)
+	}
+}
@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/requestid"
+)
+
+func TestNew_InvalidLevel(t *testing.T) {
+	_, err := New(Config{Level: "verbose"}, &bytes.Buffer{})
+
+	assert.Error(t, err)
+}
+
+func TestLogger_AddsRequestID(t *testing.T) {
+	var out bytes.Buffer
+
+	log, err := New(Config{Level: "info"}, &out)
+	require.NoError(t, err)
+
+	log.With("module", "group").InfoContext(requestid.NewContext(context.Background(), "request-id"), "saved group")
+	log.Debug("not logged")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "saved group", record["msg"])
+	assert.Equal(t, "group", record["module"])
+	assert.Equal(t, "request-id", record["request_id"])
+}
@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute labels requests no route was found for, so arbitrary paths
+// do not create new series.
+const unmatchedRoute = "unmatched"
+
+// GinMiddleware records the duration of every request by its route template,
+// e.g. /api/v1/group/:groupId.
+func (m *Metrics) GinMiddleware() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		start := time.Now()
+
+		context.Next()
+
+		route := context.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		m.httpDuration.
+			WithLabelValues(context.Request.Method, route, strconv.Itoa(context.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
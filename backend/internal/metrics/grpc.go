@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		service, method := splitMethod(info.FullMethod)
+		m.grpcServerDuration.
+			WithLabelValues(service, method, status.Code(err).String()).
+			Observe(time.Since(start).Seconds())
+
+		return resp, err
+	}
+}
+
+func (m *Metrics) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		fullMethod string,
+		req, reply any,
+		conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+
+		err := invoker(ctx, fullMethod, req, reply, conn, opts...)
+
+		service, method := splitMethod(fullMethod)
+		m.grpcClientDuration.
+			WithLabelValues(service, method, status.Code(err).String()).
+			Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// DialOption adds the client metrics to a connection.
+func (m *Metrics) DialOption() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(m.UnaryClientInterceptor())
+}
+
+// splitMethod splits /grouppb.GroupService/IsActivePlayer into the service
+// and the method.
+func splitMethod(fullMethod string) (string, string) {
+	service, method, found := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !found {
+		return "unknown", fullMethod
+	}
+
+	return service, method
+}
@@ -0,0 +1,145 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/outbox"
+)
+
+const namespace = "kickapp"
+
+// Metrics collects the metrics of the monolith. Everything passing the gin
+// router, the gRPC server and clients, the event dispatcher, the outbox relays
+// and the Mongo client is measured, so modules do not need to record anything
+// themselves.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpDuration       *prometheus.HistogramVec
+	grpcServerDuration *prometheus.HistogramVec
+	grpcClientDuration *prometheus.HistogramVec
+	eventPublished     *prometheus.HistogramVec
+	eventHandled       *prometheus.HistogramVec
+	eventFailures      *prometheus.CounterVec
+	outboxLag          *prometheus.GaugeVec
+	mongoDuration      *prometheus.HistogramVec
+}
+
+var (
+	_ ddd.DispatchObserver = (*Metrics)(nil)
+	_ outbox.LagObserver   = (*Metrics)(nil)
+)
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of HTTP requests per route and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		grpcServerDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc_server",
+			Name:      "handling_seconds",
+			Help:      "Duration of handled gRPC calls per method and code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "method", "code"}),
+		grpcClientDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc_client",
+			Name:      "handling_seconds",
+			Help:      "Duration of gRPC calls made per method and code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "method", "code"}),
+		eventPublished: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "events",
+			Name:      "publish_duration_seconds",
+			Help:      "Duration of publishing events per event name.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"event", "result"}),
+		eventHandled: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "events",
+			Name:      "handle_duration_seconds",
+			Help:      "Duration of a single attempt to handle an event per event name.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"event", "result"}),
+		eventFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "events",
+			Name:      "failures_total",
+			Help:      "Failed attempts to publish or handle an event per event name.",
+		}, []string{"event", "stage"}),
+		outboxLag: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "outbox",
+			Name:      "lag_seconds",
+			Help:      "How long the oldest event of an outbox has been pending.",
+		}, []string{"outbox"}),
+		mongoDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "mongodb",
+			Name:      "command_duration_seconds",
+			Help:      "Duration of Mongo commands per command, collection and result.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"command", "collection", "result"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpDuration,
+		m.grpcServerDuration,
+		m.grpcClientDuration,
+		m.eventPublished,
+		m.eventHandled,
+		m.eventFailures,
+		m.outboxLag,
+		m.mongoDuration,
+	)
+
+	return m
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Metrics) EventPublished(name string, duration time.Duration, err error) {
+	m.eventPublished.WithLabelValues(name, result(err)).Observe(duration.Seconds())
+
+	if err != nil {
+		m.eventFailures.WithLabelValues(name, "publish").Inc()
+	}
+}
+
+func (m *Metrics) EventHandled(name string, duration time.Duration, err error) {
+	m.eventHandled.WithLabelValues(name, result(err)).Observe(duration.Seconds())
+
+	if err != nil {
+		m.eventFailures.WithLabelValues(name, "handle").Inc()
+	}
+}
+
+func (m *Metrics) OutboxLag(outbox string, lag time.Duration) {
+	m.outboxLag.WithLabelValues(outbox).Set(lag.Seconds())
+}
+
+func result(err error) string {
+	if err != nil {
+		return "error"
+	}
+
+	return "ok"
+}
@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetrics_GinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	m := New()
+	router := gin.New()
+	router.Use(m.GinMiddleware())
+	router.GET("/group/:groupId", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	for _, path := range []string{"/group/1", "/group/2", "/unknown"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	metrics := scrape(t, m)
+
+	assert.Equal(t, 2, testutil.CollectAndCount(m.httpDuration))
+	assert.Contains(t, metrics, `kickapp_http_request_duration_seconds_count{method="GET",route="/group/:groupId",status="204"} 2`)
+	assert.Contains(t, metrics, `kickapp_http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`)
+}
+
+func TestMetrics_UnaryServerInterceptor(t *testing.T) {
+	m := New()
+	info := &grpc.UnaryServerInfo{FullMethod: "/grouppb.GroupService/IsActivePlayer"}
+
+	_, err := m.UnaryServerInterceptor()(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, scrape(t, m),
+		`kickapp_grpc_server_handling_seconds_count{code="NotFound",method="IsActivePlayer",service="grouppb.GroupService"} 1`)
+}
+
+func TestMetrics_OutboxLag(t *testing.T) {
+	m := New()
+
+	m.OutboxLag("group", 3*time.Second)
+
+	assert.Equal(t, 3.0, testutil.ToFloat64(m.outboxLag.WithLabelValues("group")))
+}
+
+func TestMetrics_Events(t *testing.T) {
+	m := New()
+
+	m.EventPublished("group.GroupCreated", time.Millisecond, nil)
+	m.EventHandled("group.GroupCreated", time.Millisecond, errors.New("some error"))
+	m.EventHandled("group.GroupCreated", time.Millisecond, nil)
+
+	assert.Equal(t, 0.0, testutil.ToFloat64(m.eventFailures.WithLabelValues("group.GroupCreated", "publish")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.eventFailures.WithLabelValues("group.GroupCreated", "handle")))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.eventHandled))
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	m := New()
+	m.EventPublished("group.GroupCreated", time.Millisecond, nil)
+
+	assert.Contains(t, scrape(t, m), `kickapp_events_publish_duration_seconds_count{event="group.GroupCreated",result="ok"} 1`)
+}
+
+func TestSplitMethod(t *testing.T) {
+	service, method := splitMethod("/userpb.UserService/GetUser")
+
+	assert.Equal(t, "userpb.UserService", service)
+	assert.Equal(t, "GetUser", method)
+}
+
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	return rec.Body.String()
+}
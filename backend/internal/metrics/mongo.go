@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// CommandMonitor records the duration of every command the Mongo client runs
+// for the repositories.
+func (m *Metrics) CommandMonitor() *event.CommandMonitor {
+	// the collection is only part of the started event
+	var collections sync.Map
+
+	observe := func(requestID int64, command string, duration time.Duration, result string) {
+		collection, _ := collections.LoadAndDelete(requestID)
+		name, _ := collection.(string)
+
+		m.mongoDuration.WithLabelValues(command, name, result).Observe(duration.Seconds())
+	}
+
+	return &event.CommandMonitor{
+		Started: func(_ context.Context, evt *event.CommandStartedEvent) {
+			if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+				collections.Store(evt.RequestID, collection)
+			}
+		},
+		Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+			observe(evt.RequestID, evt.CommandName, evt.Duration, "ok")
+		},
+		Failed: func(_ context.Context, evt *event.CommandFailedEvent) {
+			observe(evt.RequestID, evt.CommandName, evt.Duration, "error")
+		},
+	}
+}
@@ -0,0 +1,310 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb"
+)
+
+// CollectionName is the collection the applied migrations are recorded in.
+const CollectionName = "schema_migrations"
+
+var (
+	ErrInvalidVersion   = errors.New("migration version must be positive")
+	ErrDuplicateVersion = errors.New("duplicate migration version")
+	ErrMissingUp        = errors.New("migration has no up step")
+	ErrIrreversible     = errors.New("migration cannot be rolled back")
+	ErrUnknownVersion   = errors.New("applied migration is unknown")
+	ErrInvalidSteps     = errors.New("number of migrations to roll back must be positive")
+
+	errAlreadyApplied = errors.New("migration already applied")
+)
+
+// Step changes the database. Steps run in a transaction together with the
+// record of the migration and must leave the database unchanged when they
+// run again.
+type Step func(ctx context.Context, db *mongo.Database) error
+
+// Migration changes the schema of the stored documents. Migrations are
+// applied in the order of their versions. A migration without Down cannot be
+// rolled back.
+type Migration struct {
+	Version     int
+	Description string
+	Up          Step
+	Down        Step
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%d %s", m.Version, m.Description)
+}
+
+// Source provides the migrations of the collections it owns.
+type Source interface {
+	Migrations() []Migration
+}
+
+// State is a migration and when it was applied. AppliedAt is zero for pending
+// migrations.
+type State struct {
+	Migration
+	AppliedAt time.Time
+}
+
+func (s State) Applied() bool {
+	return !s.AppliedAt.IsZero()
+}
+
+type record struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"appliedAt"`
+}
+
+// Migrator applies and rolls back migrations and records them in
+// CollectionName.
+type Migrator struct {
+	db         *mongo.Database
+	collection *mongo.Collection
+	migrations []Migration
+	dryRun     bool
+	logger     *slog.Logger
+}
+
+type Option func(*Migrator)
+
+// DryRun only logs the migrations which would be applied or rolled back.
+func DryRun() Option {
+	return func(m *Migrator) {
+		m.dryRun = true
+	}
+}
+
+func Logger(logger *slog.Logger) Option {
+	return func(m *Migrator) {
+		m.logger = logger
+	}
+}
+
+func New(db *mongo.Database, migrations []Migration, opts ...Option) (*Migrator, error) {
+	sorted := slices.Clone(migrations)
+	slices.SortFunc(sorted, func(a, b Migration) int { return a.Version - b.Version })
+
+	for i, migration := range sorted {
+		switch {
+		case migration.Version < 1:
+			return nil, fmt.Errorf("%s: %w", migration, ErrInvalidVersion)
+		case migration.Up == nil:
+			return nil, fmt.Errorf("%s: %w", migration, ErrMissingUp)
+		case i > 0 && sorted[i-1].Version == migration.Version:
+			return nil, fmt.Errorf("%s: %w", migration, ErrDuplicateVersion)
+		}
+	}
+
+	migrator := &Migrator{
+		db:         db,
+		collection: db.Collection(CollectionName),
+		migrations: sorted,
+		dryRun:     false,
+		logger:     slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(migrator)
+	}
+
+	return migrator, nil
+}
+
+// Up applies the pending migrations and returns them.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := pending(m.migrations, applied)
+
+	for _, migration := range migrations {
+		if err := m.apply(ctx, migration); err != nil {
+			return nil, err
+		}
+	}
+
+	return migrations, nil
+}
+
+// Down rolls back the last steps applied migrations and returns them.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := rollbacks(m.migrations, applied, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range migrations {
+		if err := m.rollback(ctx, migration); err != nil {
+			return nil, err
+		}
+	}
+
+	return migrations, nil
+}
+
+// Status returns the state of every known migration in order.
+func (m *Migrator) Status(ctx context.Context) ([]State, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]State, len(m.migrations))
+	for i, migration := range m.migrations {
+		states[i] = State{Migration: migration, AppliedAt: applied[migration.Version].AppliedAt}
+	}
+
+	return states, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, migration Migration) error {
+	if m.dryRun {
+		m.log("would apply migration", migration)
+
+		return nil
+	}
+
+	err := mongodb.WithTransaction(ctx, m.db.Client(), func(txCtx mongo.SessionContext) error {
+		if err := migration.Up(txCtx, m.db); err != nil {
+			return err
+		}
+
+		_, err := m.collection.InsertOne(txCtx, record{
+			Version:     migration.Version,
+			Description: migration.Description,
+			AppliedAt:   time.Now(),
+		})
+		if mongo.IsDuplicateKeyError(err) {
+			return errAlreadyApplied
+		}
+
+		return err //nolint:wrapcheck // wrapped below
+	})
+
+	// another instance applied the migration in the meantime
+	if errors.Is(err, errAlreadyApplied) {
+		m.log("migration already applied", migration)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("applying migration %s: %w", migration, err)
+	}
+
+	m.log("applied migration", migration)
+
+	return nil
+}
+
+func (m *Migrator) rollback(ctx context.Context, migration Migration) error {
+	if m.dryRun {
+		m.log("would roll back migration", migration)
+
+		return nil
+	}
+
+	if err := mongodb.WithTransaction(ctx, m.db.Client(), func(txCtx mongo.SessionContext) error {
+		if err := migration.Down(txCtx, m.db); err != nil {
+			return err
+		}
+
+		_, err := m.collection.DeleteOne(txCtx, bson.M{"_id": migration.Version})
+
+		return err //nolint:wrapcheck // wrapped below
+	}); err != nil {
+		return fmt.Errorf("rolling back migration %s: %w", migration, err)
+	}
+
+	m.log("rolled back migration", migration)
+
+	return nil
+}
+
+func (m *Migrator) log(msg string, migration Migration) {
+	m.logger.Info(msg, slog.Int("version", migration.Version), slog.String("description", migration.Description))
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]record, error) {
+	cursor, err := m.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("finding applied migrations: %w", err)
+	}
+
+	var records []record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("iterating over applied migrations: %w", err)
+	}
+
+	applied := make(map[int]record, len(records))
+	for _, r := range records {
+		applied[r.Version] = r
+	}
+
+	return applied, nil
+}
+
+// pending returns the migrations not applied yet in the order to apply them.
+func pending(migrations []Migration, applied map[int]record) []Migration {
+	return slices.DeleteFunc(slices.Clone(migrations), func(migration Migration) bool {
+		_, ok := applied[migration.Version]
+
+		return ok
+	})
+}
+
+// rollbacks returns the last steps applied migrations in the order to roll
+// them back. It fails before anything is rolled back if one of them is unknown
+// or irreversible.
+func rollbacks(migrations []Migration, applied map[int]record, steps int) ([]Migration, error) {
+	if steps < 1 {
+		return nil, ErrInvalidSteps
+	}
+
+	versions := make([]int, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+
+	slices.Sort(versions)
+	slices.Reverse(versions)
+
+	result := make([]Migration, 0, steps)
+
+	for _, version := range versions[:min(steps, len(versions))] {
+		index := slices.IndexFunc(migrations, func(migration Migration) bool { return migration.Version == version })
+		if index < 0 {
+			return nil, fmt.Errorf("%d %s: %w", version, applied[version].Description, ErrUnknownVersion)
+		}
+
+		if migrations[index].Down == nil {
+			return nil, fmt.Errorf("%s: %w", migrations[index], ErrIrreversible)
+		}
+
+		result = append(result, migrations[index])
+	}
+
+	return result, nil
+}
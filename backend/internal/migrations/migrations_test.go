@@ -0,0 +1,165 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+)
+
+func noop(context.Context, *mongo.Database) error {
+	return nil
+}
+
+func versions(migrations []Migration) []int {
+	result := make([]int, len(migrations))
+	for i, migration := range migrations {
+		result[i] = migration.Version
+	}
+
+	return result
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name       string
+		migrations []Migration
+		err        error
+	}{
+		{
+			name:       "rejects version below one",
+			migrations: []Migration{{Version: 0, Up: noop}},
+			err:        ErrInvalidVersion,
+		},
+		{
+			name:       "rejects duplicate version",
+			migrations: []Migration{{Version: 2, Up: noop}, {Version: 1, Up: noop}, {Version: 2, Up: noop}},
+			err:        ErrDuplicateVersion,
+		},
+		{
+			name:       "rejects migration without up",
+			migrations: []Migration{{Version: 1, Down: noop}},
+			err:        ErrMissingUp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(&mongo.Database{}, tt.migrations)
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestPending(t *testing.T) {
+	migrations := []Migration{{Version: 1}, {Version: 2}, {Version: 3}}
+	applied := map[int]record{2: {Version: 2}}
+
+	assert.Equal(t, []int{1, 3}, versions(pending(migrations, applied)))
+}
+
+func TestRollbacks(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Up: noop, Down: nil},
+		{Version: 2, Up: noop, Down: noop},
+		{Version: 3, Up: noop, Down: noop},
+	}
+
+	t.Run("returns last applied migrations newest first", func(t *testing.T) {
+		result, err := rollbacks(migrations, map[int]record{1: {}, 2: {}, 3: {}}, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 2}, versions(result))
+	})
+
+	t.Run("stops at first migration", func(t *testing.T) {
+		result, err := rollbacks(migrations, map[int]record{2: {}}, 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{2}, versions(result))
+	})
+
+	t.Run("rejects irreversible migration", func(t *testing.T) {
+		_, err := rollbacks(migrations, map[int]record{1: {}, 2: {}}, 2)
+
+		assert.ErrorIs(t, err, ErrIrreversible)
+	})
+
+	t.Run("rejects unknown migration", func(t *testing.T) {
+		_, err := rollbacks(migrations, map[int]record{4: {}}, 1)
+
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+	})
+
+	t.Run("rejects steps below one", func(t *testing.T) {
+		_, err := rollbacks(migrations, map[int]record{2: {}}, 0)
+
+		assert.ErrorIs(t, err, ErrInvalidSteps)
+	})
+}
+
+func TestRenameFields(t *testing.T) {
+	migration := RenameFields(1, "users", map[string]string{"nickname": "nickName", "firstname": "firstName"})
+
+	assert.Equal(t, "rename fields of users: firstname -> firstName, nickname -> nickName", migration.Description)
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	db := mongotest.NewDatabase(t)
+	users := db.Collection("users")
+
+	_, err := users.InsertOne(ctx, bson.M{"_id": "1", "nickname": "Max"})
+	require.NoError(t, err)
+
+	migrations := []Migration{RenameFields(1, "users", map[string]string{"nickname": "nickName"})}
+
+	dryRun, err := New(db, migrations, DryRun())
+	require.NoError(t, err)
+
+	planned, err := dryRun.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, versions(planned))
+	assertUser(t, users, bson.M{"_id": "1", "nickname": "Max"})
+
+	migrator, err := New(db, migrations)
+	require.NoError(t, err)
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, versions(applied))
+	assertUser(t, users, bson.M{"_id": "1", "nickName": "Max"})
+
+	again, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+
+	states, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.WithinDuration(t, time.Now(), states[0].AppliedAt, time.Minute)
+
+	rolledBack, err := migrator.Down(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, versions(rolledBack))
+	assertUser(t, users, bson.M{"_id": "1", "nickname": "Max"})
+
+	states, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.False(t, states[0].Applied())
+}
+
+func assertUser(t *testing.T, users *mongo.Collection, expected bson.M) {
+	t.Helper()
+
+	var user bson.M
+	require.NoError(t, users.FindOne(context.Background(), bson.M{"_id": expected["_id"]}).Decode(&user))
+	assert.Equal(t, expected, user)
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RenameFields is a migration which renames the fields of the documents in
+// collectionName by fields, which maps old to new names. Rolling it back
+// renames them the other way round.
+func RenameFields(version int, collectionName string, fields map[string]string) Migration {
+	inverse := make(map[string]string, len(fields))
+	for oldName, newName := range fields {
+		inverse[newName] = oldName
+	}
+
+	return Migration{
+		Version:     version,
+		Description: fmt.Sprintf("rename fields of %s: %s", collectionName, describe(fields)),
+		Up:          rename(collectionName, fields),
+		Down:        rename(collectionName, inverse),
+	}
+}
+
+// rename only touches documents which still have one of the old fields, so
+// running it again changes nothing.
+func rename(collectionName string, fields map[string]string) Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		exists := make(bson.A, 0, len(fields))
+		for oldName := range fields {
+			exists = append(exists, bson.M{oldName: bson.M{"$exists": true}})
+		}
+
+		update := bson.M{"$rename": fields}
+
+		if _, err := db.Collection(collectionName).UpdateMany(ctx, bson.M{"$or": exists}, update); err != nil {
+			return fmt.Errorf("renaming fields of %s: %w", collectionName, err)
+		}
+
+		return nil
+	}
+}
+
+func describe(fields map[string]string) string {
+	renames := make([]string, 0, len(fields))
+	for oldName, newName := range fields {
+		renames = append(renames, oldName+" -> "+newName)
+	}
+
+	slices.Sort(renames)
+
+	return strings.Join(renames, ", ")
+}
@@ -2,7 +2,7 @@ package mongodb
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
@@ -11,21 +11,18 @@ import (
 
 const timeout = 10 * time.Second
 
-func ConnectMongoDB(uri string, databaseName string) *mongo.Database {
+func ConnectMongoDB(uri string, databaseName string, opts ...*options.ClientOptions) (*mongo.Database, error) {
 	ctx, cancelCtx := context.WithTimeout(context.Background(), timeout)
 	defer cancelCtx()
 
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	client, err := mongo.Connect(ctx, append([]*options.ClientOptions{options.Client().ApplyURI(uri)}, opts...)...)
 	if err != nil {
-		log.Panic(err)
+		return nil, fmt.Errorf("connecting to mongodb: %w", err)
 	}
 
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		log.Panic(err)
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, fmt.Errorf("pinging mongodb: %w", err)
 	}
 
-	log.Println("Connected to MongoDB")
-
-	return client.Database(databaseName)
+	return client.Database(databaseName), nil
 }
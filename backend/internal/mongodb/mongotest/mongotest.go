@@ -0,0 +1,47 @@
+package mongotest
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb"
+)
+
+// URIEnv names the mongodb the tests of the mongodb adapters run against. It
+// has to be a replica set, because the repositories write in transactions.
+const URIEnv = "MONGODB_TEST_URI"
+
+const cleanupTimeout = 10 * time.Second
+
+// NewDatabase returns an empty database which is dropped once the test
+// finished. The test is skipped if URIEnv is not set.
+func NewDatabase(t *testing.T) *mongo.Database {
+	t.Helper()
+
+	uri := os.Getenv(URIEnv)
+	if uri == "" {
+		t.Skipf("%s is not set", URIEnv)
+	}
+
+	name := "kickapp_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")[:16]
+
+	database, err := mongodb.ConnectMongoDB(uri, name)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+		defer cancel()
+
+		_ = database.Drop(ctx)
+		_ = database.Client().Disconnect(ctx)
+	})
+
+	return database
+}
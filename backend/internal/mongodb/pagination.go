@@ -0,0 +1,178 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+var errUnsupportedSortValue = errors.New("unsupported sort value")
+
+var operators = map[pagination.Operator]string{
+	pagination.Equal:          "$eq",
+	pagination.NotEqual:       "$ne",
+	pagination.Less:           "$lt",
+	pagination.LessOrEqual:    "$lte",
+	pagination.Greater:        "$gt",
+	pagination.GreaterOrEqual: "$gte",
+}
+
+// FindPage finds the page of the documents matching filter. The documents are
+// sorted by the requested field and their id, so a cursor points at exactly
+// one document. keys maps the fields of the request to the keys of the
+// documents. Fields without key are used as key themselves.
+func FindPage[D any](
+	ctx context.Context,
+	collection *mongo.Collection,
+	filter bson.M,
+	request pagination.Request,
+	keys map[string]string,
+) (pagination.Page[*D], error) {
+	keyOf := func(field string) string {
+		if key, ok := keys[field]; ok {
+			return key
+		}
+
+		return field
+	}
+
+	conditions := bson.A{filter}
+	for _, f := range request.Filters {
+		conditions = append(conditions, filterCondition(keyOf(f.Field), f))
+	}
+
+	sortKey := keyOf(request.Sort.Field)
+	ascending := !request.Sort.Descending
+
+	if request.Cursor != nil {
+		// a backward page is read in reverse order and turned around afterwards
+		if request.Cursor.Backward {
+			ascending = !ascending
+		}
+
+		conditions = append(conditions, cursorCondition(sortKey, request.Cursor, ascending))
+	}
+
+	order := 1
+	if !ascending {
+		order = -1
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: sortKey, Value: order}, {Key: "_id", Value: order}}).
+		SetLimit(int64(request.Limit + 1))
+
+	cursor, err := collection.Find(ctx, bson.M{"$and": conditions}, opts)
+	if err != nil {
+		return pagination.Page[*D]{}, fmt.Errorf("finding page: %w", err)
+	}
+
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var raws []bson.Raw
+	if err := cursor.All(ctx, &raws); err != nil {
+		return pagination.Page[*D]{}, fmt.Errorf("iterating over page: %w", err)
+	}
+
+	hasMore := len(raws) > request.Limit
+	if hasMore {
+		raws = raws[:request.Limit]
+	}
+
+	if request.Cursor != nil && request.Cursor.Backward {
+		slices.Reverse(raws)
+	}
+
+	return toPage[D](raws, sortKey, request, hasMore)
+}
+
+func toPage[D any](
+	raws []bson.Raw,
+	sortKey string,
+	request pagination.Request,
+	hasMore bool,
+) (pagination.Page[*D], error) {
+	page := pagination.Page[*D]{Items: make([]*D, len(raws))}
+
+	for i, raw := range raws {
+		var doc D
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return pagination.Page[*D]{}, fmt.Errorf("decoding document: %w", err)
+		}
+
+		page.Items[i] = &doc
+	}
+
+	if len(raws) == 0 {
+		return page, nil
+	}
+
+	first, err := positionOf(raws[0], sortKey)
+	if err != nil {
+		return pagination.Page[*D]{}, err
+	}
+
+	last, err := positionOf(raws[len(raws)-1], sortKey)
+	if err != nil {
+		return pagination.Page[*D]{}, err
+	}
+
+	return pagination.Link(page, request, hasMore, first, last), nil
+}
+
+func positionOf(raw bson.Raw, sortKey string) (pagination.Position, error) {
+	id, ok := raw.Lookup("_id").StringValueOK()
+	if !ok {
+		return pagination.Position{}, fmt.Errorf("reading id of document: %w", errUnsupportedSortValue)
+	}
+
+	value, err := sortValue(raw.Lookup(strings.Split(sortKey, ".")...))
+	if err != nil {
+		return pagination.Position{}, fmt.Errorf("reading %s of document %s: %w", sortKey, id, err)
+	}
+
+	return pagination.Position{Value: value, ID: id}, nil
+}
+
+func sortValue(value bson.RawValue) (any, error) {
+	switch value.Type {
+	case bson.TypeString:
+		return value.StringValue(), nil
+	case bson.TypeDateTime:
+		return value.Time(), nil
+	case bson.TypeBoolean:
+		return value.Boolean(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedSortValue, value.Type)
+	}
+}
+
+func filterCondition(key string, filter pagination.Filter) bson.M {
+	if filter.Operator == pagination.Prefix {
+		return bson.M{key: bson.M{"$regex": "^" + regexp.QuoteMeta(fmt.Sprint(filter.Value))}}
+	}
+
+	return bson.M{key: bson.M{operators[filter.Operator]: filter.Value}}
+}
+
+// cursorCondition matches the documents behind the cursor in the order read.
+func cursorCondition(sortKey string, cursor *pagination.Cursor, ascending bool) bson.M {
+	operator := "$gt"
+	if !ascending {
+		operator = "$lt"
+	}
+
+	return bson.M{"$or": bson.A{
+		bson.M{sortKey: bson.M{operator: cursor.Value}},
+		bson.M{sortKey: cursor.Value, "_id": bson.M{operator: cursor.ID}},
+	}}
+}
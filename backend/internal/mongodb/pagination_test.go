@@ -0,0 +1,95 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+type testDocument struct {
+	ID   string `bson:"_id"`
+	Name string `bson:"name"`
+}
+
+func rawDocuments(t *testing.T, ids ...string) []bson.Raw {
+	t.Helper()
+
+	raws := make([]bson.Raw, len(ids))
+
+	for i, id := range ids {
+		raw, err := bson.Marshal(testDocument{ID: id, Name: "name-" + id})
+		require.NoError(t, err)
+
+		raws[i] = raw
+	}
+
+	return raws
+}
+
+func TestToPage(t *testing.T) {
+	sort := pagination.Sort{Field: "name"}
+	after := &pagination.Cursor{Sort: sort, Value: "name-0", ID: "0"}
+	before := &pagination.Cursor{Sort: sort, Value: "name-9", ID: "9", Backward: true}
+
+	tests := []struct {
+		name     string
+		cursor   *pagination.Cursor
+		hasMore  bool
+		wantNext bool
+		wantPrev bool
+	}{
+		{name: "only page", cursor: nil, hasMore: false, wantNext: false, wantPrev: false},
+		{name: "first page", cursor: nil, hasMore: true, wantNext: true, wantPrev: false},
+		{name: "middle page", cursor: after, hasMore: true, wantNext: true, wantPrev: true},
+		{name: "last page", cursor: after, hasMore: false, wantNext: false, wantPrev: true},
+		{name: "page before", cursor: before, hasMore: true, wantNext: true, wantPrev: true},
+		{name: "first page read backward", cursor: before, hasMore: false, wantNext: true, wantPrev: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := pagination.Request{Limit: 2, Sort: sort, Cursor: tt.cursor}
+
+			page, err := toPage[testDocument](rawDocuments(t, "1", "2"), "name", request, tt.hasMore)
+			require.NoError(t, err)
+
+			assert.Equal(t, []*testDocument{{ID: "1", Name: "name-1"}, {ID: "2", Name: "name-2"}}, page.Items)
+
+			if tt.wantNext {
+				assert.Equal(t, &pagination.Cursor{Sort: sort, Value: "name-2", ID: "2"}, page.Next)
+			} else {
+				assert.Nil(t, page.Next)
+			}
+
+			if tt.wantPrev {
+				assert.Equal(t, &pagination.Cursor{Sort: sort, Value: "name-1", ID: "1", Backward: true}, page.Prev)
+			} else {
+				assert.Nil(t, page.Prev)
+			}
+		})
+	}
+}
+
+func TestToPage_WithoutItems(t *testing.T) {
+	request := pagination.Request{Limit: 2, Sort: pagination.Sort{Field: "name"}}
+
+	page, err := toPage[testDocument](nil, "name", request, false)
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Items)
+	assert.Nil(t, page.Next)
+	assert.Nil(t, page.Prev)
+}
+
+func TestCursorCondition(t *testing.T) {
+	cursor := &pagination.Cursor{Value: "name-1", ID: "1"}
+
+	assert.Equal(t, bson.M{"$or": bson.A{
+		bson.M{"name": bson.M{"$lt": "name-1"}},
+		bson.M{"name": "name-1", "_id": bson.M{"$lt": "1"}},
+	}}, cursorCondition("name", cursor, false))
+}
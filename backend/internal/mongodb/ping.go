@@ -0,0 +1,20 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// Ping checks that the primary of the database can be reached.
+func Ping(database *mongo.Database) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if err := database.Client().Ping(ctx, readpref.Primary()); err != nil {
+			return fmt.Errorf("pinging mongodb: %w", err)
+		}
+
+		return nil
+	}
+}
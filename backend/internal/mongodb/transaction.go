@@ -0,0 +1,26 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type TransactionFunc func(ctx mongo.SessionContext) error
+
+func WithTransaction(ctx context.Context, client *mongo.Client, fn TransactionFunc) error {
+	session, err := client.StartSession()
+	if err != nil {
+		return fmt.Errorf("starting session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	if _, err := session.WithTransaction(ctx, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionContext)
+	}); err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return nil
+}
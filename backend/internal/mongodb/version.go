@@ -0,0 +1,14 @@
+package mongodb
+
+import "go.mongodb.org/mongo-driver/bson"
+
+// VersionFilter matches the document of an aggregate only as long as it still
+// has the version the aggregate was loaded with. Documents written before the
+// version was stored count as version 0.
+func VersionFilter(id string, version int) bson.M {
+	if version == 0 {
+		return bson.M{"_id": id, "version": bson.M{"$in": bson.A{0, nil}}}
+	}
+
+	return bson.M{"_id": id, "version": version}
+}
@@ -1,12 +1,19 @@
 package monolith
 
 import (
+	"log/slog"
+
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
 
 	"github.com/FSpruhs/kick-app/backend/internal/config"
 	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/health"
+	"github.com/FSpruhs/kick-app/backend/internal/idempotency"
+	"github.com/FSpruhs/kick-app/backend/internal/metrics"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
 	"github.com/FSpruhs/kick-app/backend/internal/waiter"
 )
 
@@ -15,7 +22,14 @@ type Monolith interface {
 	DB() *mongo.Database
 	Router() *gin.Engine
 	EventDispatcher() *ddd.EventDispatcher[ddd.AggregateEvent]
+	DeadLetters() ddd.DeadLetterStore
+	Registry() registry.Registry
 	RPC() *grpc.Server
+	TokenVerifier() *ginconfig.TokenVerifier
+	Health() *health.Checker
+	Idempotency() *idempotency.Keys
+	Metrics() *metrics.Metrics
+	Logger() *slog.Logger
 	Waiter() waiter.Waiter
 }
 
@@ -0,0 +1,237 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+)
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+type Redeliverer interface {
+	Redeliver(ctx context.Context, subscription string, event ddd.AggregateEvent) error
+}
+
+// DeadLetter is a stored ddd.DeadLetter. ReplayRequestedAt is set once a
+// replay was requested and ReplayedAt once it was handed to its subscription
+// again. A dead letter without a subscription is an event the relay could not
+// publish at all.
+type DeadLetter struct {
+	ddd.DeadLetter
+	ID                string
+	ReplayRequestedAt *time.Time
+	ReplayedAt        *time.Time
+}
+
+type deadLetterDocument struct {
+	ID                string            `bson:"_id"`
+	Subscription      string            `bson:"subscription"`
+	EventID           string            `bson:"eventId"`
+	Name              string            `bson:"name"`
+	AggregateID       string            `bson:"aggregateId"`
+	AggregateName     string            `bson:"aggregateName"`
+	AggregateVersion  int               `bson:"aggregateVersion"`
+	Payload           bson.Raw          `bson:"payload"`
+	Metadata          map[string]string `bson:"metadata,omitempty"`
+	OccurredAt        time.Time         `bson:"occurredAt"`
+	Attempts          int               `bson:"attempts"`
+	Error             string            `bson:"error"`
+	FailedAt          time.Time         `bson:"failedAt"`
+	ReplayRequestedAt *time.Time        `bson:"replayRequestedAt,omitempty"`
+	ReplayedAt        *time.Time        `bson:"replayedAt"`
+}
+
+type DeadLetterStore struct {
+	collection *mongo.Collection
+	registry   registry.Registry
+}
+
+var _ ddd.DeadLetterStore = (*DeadLetterStore)(nil)
+
+func NewDeadLetterStore(database *mongo.Database, collectionName string, reg registry.Registry) *DeadLetterStore {
+	return &DeadLetterStore{
+		collection: database.Collection(collectionName),
+		registry:   reg,
+	}
+}
+
+func (s *DeadLetterStore) Save(ctx context.Context, letter ddd.DeadLetter) error {
+	payload, err := s.registry.Serialize(letter.Event.EventName(), letter.Event.Payload())
+	if err != nil {
+		return fmt.Errorf("serializing dead letter %s: %w", letter.Event.EventName(), err)
+	}
+
+	doc := deadLetterDocument{
+		ID:           uuid.New().String(),
+		Subscription: letter.Subscription,
+		EventID:      letter.Event.ID(),
+		Name:         letter.Event.EventName(),
+		Payload:      payload,
+		Metadata:     letter.Event.Metadata(),
+		OccurredAt:   letter.Event.OccurredAt(),
+		Attempts:     letter.Attempts,
+		Error:        letter.Err.Error(),
+		FailedAt:     letter.FailedAt,
+		ReplayedAt:   nil,
+	}
+
+	if event, ok := letter.Event.(ddd.AggregateEvent); ok {
+		doc.AggregateID = event.AggregateID()
+		doc.AggregateName = event.AggregateName()
+		doc.AggregateVersion = event.AggregateVersion()
+	}
+
+	if _, err := s.collection.InsertOne(ctx, doc); err != nil {
+		return fmt.Errorf("inserting dead letter: %w", err)
+	}
+
+	return nil
+}
+
+// FindPending returns the dead letters which have not been replayed yet,
+// oldest first.
+func (s *DeadLetterStore) FindPending(ctx context.Context) ([]*DeadLetter, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "failedAt", Value: 1}})
+
+	cursor, err := s.collection.Find(ctx, bson.M{"replayedAt": nil}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding dead letters: %w", err)
+	}
+
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var docs []*deadLetterDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("iterating over dead letters: %w", err)
+	}
+
+	letters := make([]*DeadLetter, len(docs))
+
+	for i, doc := range docs {
+		letter, err := s.toDeadLetter(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		letters[i] = letter
+	}
+
+	return letters, nil
+}
+
+// RequestReplay marks a dead letter which has not been replayed yet to be
+// replayed by ReplayRequested, e.g. from a process without subscriptions.
+func (s *DeadLetterStore) RequestReplay(ctx context.Context, letterID string) error {
+	filter := bson.M{"_id": letterID, "replayedAt": nil}
+
+	result, err := s.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"replayRequestedAt": time.Now()}})
+	if err != nil {
+		return fmt.Errorf("requesting replay of dead letter %s: %w", letterID, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("requesting replay of %s: %w", letterID, ErrDeadLetterNotFound)
+	}
+
+	return nil
+}
+
+// ReplayRequested replays the dead letters a replay was requested for. A
+// dead letter failing to replay stays requested.
+func (s *DeadLetterStore) ReplayRequested(ctx context.Context, redeliverer Redeliverer) error {
+	filter := bson.M{"replayRequestedAt": bson.M{"$ne": nil}, "replayedAt": nil}
+	opts := options.Find().SetSort(bson.D{{Key: "failedAt", Value: 1}}).SetProjection(bson.M{"_id": 1})
+
+	cursor, err := s.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return fmt.Errorf("finding dead letters to replay: %w", err)
+	}
+
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var docs []*deadLetterDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return fmt.Errorf("iterating over dead letters to replay: %w", err)
+	}
+
+	var errs []error
+
+	for _, doc := range docs {
+		if err := s.Replay(ctx, doc.ID, redeliverer); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Replay hands the event of a dead letter to the subscription which failed
+// to handle it and marks the dead letter as replayed.
+func (s *DeadLetterStore) Replay(ctx context.Context, letterID string, redeliverer Redeliverer) error {
+	var doc deadLetterDocument
+	if err := s.collection.FindOne(ctx, bson.M{"_id": letterID}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("replaying %s: %w", letterID, ErrDeadLetterNotFound)
+		}
+
+		return fmt.Errorf("finding dead letter %s: %w", letterID, err)
+	}
+
+	letter, err := s.toDeadLetter(&doc)
+	if err != nil {
+		return err
+	}
+
+	event, ok := letter.Event.(ddd.AggregateEvent)
+	if !ok {
+		return fmt.Errorf("replaying %s: %w", letterID, ddd.ErrInvalidEventPayload)
+	}
+
+	if err := redeliverer.Redeliver(ctx, letter.Subscription, event); err != nil {
+		return fmt.Errorf("replaying %s: %w", letterID, err)
+	}
+
+	if _, err := s.collection.UpdateByID(ctx, letterID, bson.M{"$set": bson.M{"replayedAt": time.Now()}}); err != nil {
+		return fmt.Errorf("marking dead letter %s as replayed: %w", letterID, err)
+	}
+
+	return nil
+}
+
+func (s *DeadLetterStore) toDeadLetter(doc *deadLetterDocument) (*DeadLetter, error) {
+	payload, err := s.registry.Deserialize(doc.Name, doc.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("deserializing dead letter %s: %w", doc.ID, err)
+	}
+
+	return &DeadLetter{
+		DeadLetter: ddd.DeadLetter{
+			Subscription: doc.Subscription,
+			Event: ddd.RestoreAggregateEvent(
+				doc.EventID,
+				doc.Name,
+				payload,
+				doc.Metadata,
+				doc.OccurredAt,
+				doc.AggregateID,
+				doc.AggregateName,
+				doc.AggregateVersion,
+			),
+			Attempts: doc.Attempts,
+			Err:      errors.New(doc.Error),
+			FailedAt: doc.FailedAt,
+		},
+		ID:                doc.ID,
+		ReplayRequestedAt: doc.ReplayRequestedAt,
+		ReplayedAt:        doc.ReplayedAt,
+	}, nil
+}
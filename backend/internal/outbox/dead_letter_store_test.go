@@ -0,0 +1,171 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+)
+
+type testPayload struct {
+	Name string `bson:"name"`
+}
+
+type fakeRedeliverer struct {
+	err           error
+	subscriptions []string
+	events        []ddd.AggregateEvent
+}
+
+func (r *fakeRedeliverer) Redeliver(_ context.Context, subscription string, event ddd.AggregateEvent) error {
+	r.subscriptions = append(r.subscriptions, subscription)
+	r.events = append(r.events, event)
+
+	return r.err
+}
+
+func newDeadLetterStore(t *testing.T) *DeadLetterStore {
+	t.Helper()
+
+	reg := registry.New()
+	require.NoError(t, reg.Register("test.Event", testPayload{}))
+
+	return NewDeadLetterStore(mongotest.NewDatabase(t), "events.deadletters", reg)
+}
+
+func saveDeadLetter(t *testing.T, store *DeadLetterStore, subscription string, failedAt time.Time) *DeadLetter {
+	t.Helper()
+
+	event := ddd.RestoreAggregateEvent(
+		"event-"+subscription,
+		"test.Event",
+		testPayload{Name: subscription},
+		map[string]string{"requestId": "request-id"},
+		time.Now().UTC().Truncate(time.Millisecond),
+		"aggregate-id",
+		"test.Aggregate",
+		3,
+	)
+	letter := ddd.DeadLetter{
+		Subscription: subscription,
+		Event:        event,
+		Attempts:     2,
+		Err:          errors.New("some error"),
+		FailedAt:     failedAt,
+	}
+
+	ctx := context.Background()
+	require.NoError(t, store.Save(ctx, letter))
+
+	letters, err := store.FindPending(ctx)
+	require.NoError(t, err)
+
+	for _, saved := range letters {
+		if saved.Event.ID() == event.ID() {
+			return saved
+		}
+	}
+
+	t.Fatalf("dead letter of %s was not saved", subscription)
+
+	return nil
+}
+
+func TestDeadLetterStore_SaveAndFindPending(t *testing.T) {
+	store := newDeadLetterStore(t)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	saveDeadLetter(t, store, "second", now)
+	saveDeadLetter(t, store, "first", now.Add(-time.Minute))
+
+	letters, err := store.FindPending(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, letters, 2)
+	assert.Equal(t, "first", letters[0].Subscription)
+	assert.Equal(t, "second", letters[1].Subscription)
+
+	letter := letters[0]
+	event, ok := letter.Event.(ddd.AggregateEvent)
+	require.True(t, ok)
+	assert.NotEmpty(t, letter.ID)
+	assert.Equal(t, "event-first", event.ID())
+	assert.Equal(t, testPayload{Name: "first"}, event.Payload())
+	assert.Equal(t, "request-id", event.Metadata()["requestId"])
+	assert.Equal(t, "aggregate-id", event.AggregateID())
+	assert.Equal(t, "test.Aggregate", event.AggregateName())
+	assert.Equal(t, 3, event.AggregateVersion())
+	assert.Equal(t, 2, letter.Attempts)
+	assert.EqualError(t, letter.Err, "some error")
+	assert.True(t, now.Add(-time.Minute).Equal(letter.FailedAt))
+	assert.Nil(t, letter.ReplayRequestedAt)
+	assert.Nil(t, letter.ReplayedAt)
+}
+
+func TestDeadLetterStore_Replay(t *testing.T) {
+	store := newDeadLetterStore(t)
+	letter := saveDeadLetter(t, store, "subscription", time.Now())
+	redeliverer := &fakeRedeliverer{}
+
+	err := store.Replay(context.Background(), letter.ID, redeliverer)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"subscription"}, redeliverer.subscriptions)
+	require.Len(t, redeliverer.events, 1)
+	assert.Equal(t, "event-subscription", redeliverer.events[0].ID())
+	assert.Equal(t, testPayload{Name: "subscription"}, redeliverer.events[0].Payload())
+
+	letters, err := store.FindPending(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, letters)
+}
+
+func TestDeadLetterStore_ReplayKeepsDeadLetterWhenRedeliveryFails(t *testing.T) {
+	store := newDeadLetterStore(t)
+	letter := saveDeadLetter(t, store, "subscription", time.Now())
+	redeliverer := &fakeRedeliverer{err: errors.New("some error")}
+
+	err := store.Replay(context.Background(), letter.ID, redeliverer)
+
+	require.Error(t, err)
+
+	letters, err := store.FindPending(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, letters, 1)
+}
+
+func TestDeadLetterStore_ReplayUnknownDeadLetter(t *testing.T) {
+	store := newDeadLetterStore(t)
+
+	err := store.Replay(context.Background(), "unknown", &fakeRedeliverer{})
+
+	assert.ErrorIs(t, err, ErrDeadLetterNotFound)
+}
+
+func TestDeadLetterStore_ReplayRequested(t *testing.T) {
+	store := newDeadLetterStore(t)
+	requested := saveDeadLetter(t, store, "requested", time.Now())
+	saveDeadLetter(t, store, "other", time.Now())
+	redeliverer := &fakeRedeliverer{}
+	ctx := context.Background()
+
+	require.NoError(t, store.RequestReplay(ctx, requested.ID))
+	require.NoError(t, store.ReplayRequested(ctx, redeliverer))
+
+	assert.Equal(t, []string{"requested"}, redeliverer.subscriptions)
+
+	letters, err := store.FindPending(ctx)
+	require.NoError(t, err)
+	require.Len(t, letters, 1)
+	assert.Equal(t, "other", letters[0].Subscription)
+
+	// a replayed dead letter cannot be requested again
+	assert.ErrorIs(t, store.RequestReplay(ctx, requested.ID), ErrDeadLetterNotFound)
+}
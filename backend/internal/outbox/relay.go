@@ -0,0 +1,251 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 50
+	defaultMaxAttempts  = 5
+	defaultStuckAfter   = time.Minute
+)
+
+// LagObserver is told after every relay run how long the oldest event of an
+// outbox has been pending. The lag is zero if no event is pending.
+type LagObserver interface {
+	OutboxLag(outbox string, lag time.Duration)
+}
+
+type RelayOption func(r *Relay)
+
+func PollInterval(interval time.Duration) RelayOption {
+	return func(r *Relay) {
+		r.pollInterval = interval
+	}
+}
+
+func BatchSize(size int) RelayOption {
+	return func(r *Relay) {
+		r.batchSize = size
+	}
+}
+
+// MaxAttempts is how often the relay tries to publish an event before it
+// parks the event in the dead letters.
+func MaxAttempts(attempts int) RelayOption {
+	return func(r *Relay) {
+		r.maxAttempts = attempts
+	}
+}
+
+// DeadLetters stores the events the relay gives up on. Without it a failing
+// event is retried forever.
+func DeadLetters(store ddd.DeadLetterStore) RelayOption {
+	return func(r *Relay) {
+		r.deadLetters = store
+	}
+}
+
+// StuckAfter is how long an event may be pending before the relay warns
+// about it.
+func StuckAfter(after time.Duration) RelayOption {
+	return func(r *Relay) {
+		r.stuckAfter = after
+	}
+}
+
+// ObserveLag reports the lag of the outbox under the given name.
+func ObserveLag(name string, observer LagObserver) RelayOption {
+	return func(r *Relay) {
+		r.name = name
+		r.lagObserver = observer
+	}
+}
+
+func Logger(logger *slog.Logger) RelayOption {
+	return func(r *Relay) {
+		r.logger = logger
+	}
+}
+
+// Relay delivers the events stored in the outbox to the subscribers of the
+// publisher. An event is only marked as published after every subscriber
+// handled it, so delivery is at least once. The events of an aggregate are
+// delivered in order, so a failing event holds back the later events of its
+// aggregate until it is published or parked in the dead letters. A failing
+// event does not make the monolith unready, the relay reports how long its
+// oldest event is pending instead.
+type Relay struct {
+	store        MessageStore
+	publisher    ddd.EventPublisher[ddd.AggregateEvent]
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	stuckAfter   time.Duration
+	deadLetters  ddd.DeadLetterStore
+	name         string
+	lagObserver  LagObserver
+	notify       chan struct{}
+	logger       *slog.Logger
+}
+
+var _ ddd.EventPublisher[ddd.AggregateEvent] = (*Relay)(nil)
+
+func NewRelay(
+	store MessageStore,
+	publisher ddd.EventPublisher[ddd.AggregateEvent],
+	options ...RelayOption,
+) *Relay {
+	relay := &Relay{
+		store:        store,
+		publisher:    publisher,
+		pollInterval: defaultPollInterval,
+		batchSize:    defaultBatchSize,
+		maxAttempts:  defaultMaxAttempts,
+		stuckAfter:   defaultStuckAfter,
+		notify:       make(chan struct{}, 1),
+		logger:       slog.Default(),
+	}
+
+	for _, option := range options {
+		option(relay)
+	}
+
+	return relay
+}
+
+// Publish does not dispatch the events itself. They are already stored in the
+// outbox together with the aggregate, so the relay is only woken up to deliver
+// them without waiting for the next poll.
+func (r *Relay) Publish(_ context.Context, _ ...ddd.AggregateEvent) error {
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (r *Relay) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-r.notify:
+		}
+
+		oldest, err := r.relayPending(ctx)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "relaying outbox events failed", slog.Any("error", err))
+		}
+
+		r.observeLag(ctx, oldest)
+	}
+}
+
+// relayPending relays a batch of pending events. It returns when the oldest
+// event which is still pending afterwards occurred, or the zero time if none
+// is.
+func (r *Relay) relayPending(ctx context.Context) (time.Time, error) {
+	events, err := r.store.FindPending(ctx, r.batchSize)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("finding pending events: %w", err)
+	}
+
+	var (
+		errs    []error
+		oldest  time.Time
+		blocked = make(map[string]bool)
+	)
+
+	for _, event := range events {
+		// skip the later events of an aggregate to keep them in order
+		if !blocked[event.AggregateID()] {
+			err := r.relay(ctx, event)
+			if err == nil {
+				continue
+			}
+
+			blocked[event.AggregateID()] = true
+			errs = append(errs, err)
+		}
+
+		if oldest.IsZero() || event.OccurredAt().Before(oldest) {
+			oldest = event.OccurredAt()
+		}
+	}
+
+	return oldest, errors.Join(errs...)
+}
+
+func (r *Relay) observeLag(ctx context.Context, oldest time.Time) {
+	var lag time.Duration
+	if !oldest.IsZero() {
+		lag = time.Since(oldest)
+	}
+
+	if r.lagObserver != nil {
+		r.lagObserver.OutboxLag(r.name, lag)
+	}
+
+	if lag > r.stuckAfter {
+		r.logger.WarnContext(ctx, "outbox events are stuck", slog.Duration("lag", lag))
+	}
+}
+
+// relay publishes a single event. It returns an error if the event is still
+// pending afterwards.
+func (r *Relay) relay(ctx context.Context, event ddd.AggregateEvent) error {
+	err := r.publisher.Publish(ctx, event)
+	if err == nil {
+		if err := r.store.MarkPublished(ctx, event.ID()); err != nil {
+			return fmt.Errorf("marking event %s as published: %w", event.ID(), err)
+		}
+
+		return nil
+	}
+
+	attempts, markErr := r.store.MarkFailed(ctx, event.ID(), err)
+	if markErr != nil {
+		return fmt.Errorf("publishing event %s: %w", event.ID(), markErr)
+	}
+
+	if attempts < r.maxAttempts || r.deadLetters == nil {
+		return fmt.Errorf("publishing event %s: %w", event.ID(), err)
+	}
+
+	// the dead letter is meant for every subscription of the event
+	if err := r.deadLetters.Save(ctx, ddd.DeadLetter{
+		Subscription: "",
+		Event:        event,
+		Attempts:     attempts,
+		Err:          err,
+		FailedAt:     time.Now(),
+	}); err != nil {
+		return fmt.Errorf("parking event %s: %w", event.ID(), err)
+	}
+
+	if err := r.store.MarkDeadLettered(ctx, event.ID()); err != nil {
+		return fmt.Errorf("marking event %s as dead lettered: %w", event.ID(), err)
+	}
+
+	r.logger.ErrorContext(ctx, "parked outbox event in dead letters",
+		slog.String("event_id", event.ID()),
+		slog.String("event", event.EventName()),
+		slog.Int("attempts", attempts),
+		slog.Any("error", err),
+	)
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+type fakeStore struct {
+	pending      []ddd.AggregateEvent
+	published    []string
+	failed       []string
+	deadLettered []string
+}
+
+func (s *fakeStore) FindPending(_ context.Context, limit int) ([]ddd.AggregateEvent, error) {
+	if len(s.pending) < limit {
+		return s.pending, nil
+	}
+
+	return s.pending[:limit], nil
+}
+
+func (s *fakeStore) MarkPublished(_ context.Context, eventID string) error {
+	s.published = append(s.published, eventID)
+	s.remove(eventID)
+
+	return nil
+}
+
+func (s *fakeStore) MarkFailed(_ context.Context, eventID string, _ error) (int, error) {
+	s.failed = append(s.failed, eventID)
+
+	attempts := 0
+
+	for _, id := range s.failed {
+		if id == eventID {
+			attempts++
+		}
+	}
+
+	return attempts, nil
+}
+
+func (s *fakeStore) MarkDeadLettered(_ context.Context, eventID string) error {
+	s.deadLettered = append(s.deadLettered, eventID)
+	s.remove(eventID)
+
+	return nil
+}
+
+func (s *fakeStore) remove(eventID string) {
+	for i, event := range s.pending {
+		if event.ID() == eventID {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+
+			break
+		}
+	}
+}
+
+type fakeDeadLetters []ddd.DeadLetter
+
+func (l *fakeDeadLetters) Save(_ context.Context, letter ddd.DeadLetter) error {
+	*l = append(*l, letter)
+
+	return nil
+}
+
+func newMessage(id string) ddd.AggregateEvent {
+	return newAggregateMessage(id, "aggregate-id")
+}
+
+func newAggregateMessage(id, aggregateID string) ddd.AggregateEvent {
+	return ddd.RestoreAggregateEvent(id, "test.Event", nil, nil, time.Now(), aggregateID, "test.Aggregate", 1)
+}
+
+func TestRelay_RelayPending(t *testing.T) {
+	first, second := newMessage("1"), newMessage("2")
+	store := &fakeStore{pending: []ddd.AggregateEvent{first, second}}
+	publisher := new(ddd.MockEventPublisher)
+	publisher.On("Publish", mock.Anything, []ddd.AggregateEvent{first}).Return(nil)
+	publisher.On("Publish", mock.Anything, []ddd.AggregateEvent{second}).Return(nil)
+
+	relay := NewRelay(store, publisher)
+
+	oldest, err := relay.relayPending(context.Background())
+
+	assert.NoError(t, err)
+	assert.True(t, oldest.IsZero())
+	assert.Equal(t, []string{"1", "2"}, store.published)
+	assert.Empty(t, store.pending)
+	publisher.AssertExpectations(t)
+}
+
+func TestRelay_RelayPendingHoldsBackAggregateOfFailure(t *testing.T) {
+	first, second := newAggregateMessage("1", "aggregate-1"), newAggregateMessage("2", "aggregate-1")
+	other := newAggregateMessage("3", "aggregate-2")
+	store := &fakeStore{pending: []ddd.AggregateEvent{first, second, other}}
+	publisher := new(ddd.MockEventPublisher)
+	publisher.On("Publish", mock.Anything, []ddd.AggregateEvent{first}).Return(errors.New("some error"))
+	publisher.On("Publish", mock.Anything, []ddd.AggregateEvent{other}).Return(nil)
+
+	relay := NewRelay(store, publisher)
+
+	oldest, err := relay.relayPending(context.Background())
+
+	assert.Error(t, err)
+	assert.Equal(t, first.OccurredAt(), oldest)
+	assert.Equal(t, []string{"3"}, store.published)
+	assert.Equal(t, []string{"1"}, store.failed)
+	assert.Len(t, store.pending, 2)
+	publisher.AssertNotCalled(t, "Publish", mock.Anything, []ddd.AggregateEvent{second})
+}
+
+func TestRelay_RelayPendingParksEventAfterMaxAttempts(t *testing.T) {
+	first, second := newMessage("1"), newMessage("2")
+	store := &fakeStore{pending: []ddd.AggregateEvent{first, second}}
+	publisher := new(ddd.MockEventPublisher)
+	publisher.On("Publish", mock.Anything, []ddd.AggregateEvent{first}).Return(errors.New("some error"))
+	publisher.On("Publish", mock.Anything, []ddd.AggregateEvent{second}).Return(nil)
+
+	deadLetters := &fakeDeadLetters{}
+	relay := NewRelay(store, publisher, MaxAttempts(2), DeadLetters(deadLetters))
+
+	_, err := relay.relayPending(context.Background())
+	assert.Error(t, err)
+	assert.Empty(t, *deadLetters)
+
+	_, err = relay.relayPending(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, store.deadLettered)
+	assert.Equal(t, []string{"2"}, store.published)
+	assert.Empty(t, store.pending)
+
+	require.Len(t, *deadLetters, 1)
+	assert.Equal(t, "1", (*deadLetters)[0].Event.ID())
+	assert.Empty(t, (*deadLetters)[0].Subscription)
+	assert.Equal(t, 2, (*deadLetters)[0].Attempts)
+}
+
+func TestRelay_PublishDoesNotBlock(t *testing.T) {
+	relay := NewRelay(&fakeStore{}, new(ddd.MockEventPublisher))
+
+	assert.NoError(t, relay.Publish(context.Background()))
+	assert.NoError(t, relay.Publish(context.Background()))
+	assert.Len(t, relay.notify, 1)
+}
+
+type observedLag struct {
+	outbox string
+	lag    time.Duration
+}
+
+type lagRecorder chan observedLag
+
+func (r lagRecorder) OutboxLag(outbox string, lag time.Duration) {
+	select {
+	case r <- observedLag{outbox: outbox, lag: lag}:
+	default:
+	}
+}
+
+func TestRelay_StartObservesLagOfFailingEvent(t *testing.T) {
+	event := ddd.RestoreAggregateEvent(
+		"1", "test.Event", nil, nil, time.Now().Add(-time.Hour), "aggregate-id", "test.Aggregate", 1,
+	)
+	store := &fakeStore{pending: []ddd.AggregateEvent{event}}
+	publisher := new(ddd.MockEventPublisher)
+	publisher.On("Publish", mock.Anything, mock.Anything).Return(errors.New("some error"))
+	observer := make(lagRecorder, 1)
+
+	relay := NewRelay(store, publisher, PollInterval(time.Millisecond), ObserveLag("group", observer))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		_ = relay.Start(ctx)
+
+		close(done)
+	}()
+
+	select {
+	case observed := <-observer:
+		assert.Equal(t, "group", observed.outbox)
+		assert.GreaterOrEqual(t, observed.lag, time.Hour)
+	case <-time.After(time.Second):
+		t.Fatal("lag was not observed")
+	}
+
+	cancel()
+	<-done
+}
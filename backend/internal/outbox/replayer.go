@@ -0,0 +1,65 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const defaultReplayInterval = 10 * time.Second
+
+type ReplayerOption func(r *Replayer)
+
+func ReplayInterval(interval time.Duration) ReplayerOption {
+	return func(r *Replayer) {
+		r.interval = interval
+	}
+}
+
+func ReplayLogger(logger *slog.Logger) ReplayerOption {
+	return func(r *Replayer) {
+		r.logger = logger
+	}
+}
+
+// Replayer replays the dead letters a replay was requested for, e.g. with
+// kickapp deadletters replay. It runs next to the subscriptions, because only
+// they can handle the events again.
+type Replayer struct {
+	store       *DeadLetterStore
+	redeliverer Redeliverer
+	interval    time.Duration
+	logger      *slog.Logger
+}
+
+func NewReplayer(store *DeadLetterStore, redeliverer Redeliverer, options ...ReplayerOption) *Replayer {
+	replayer := &Replayer{
+		store:       store,
+		redeliverer: redeliverer,
+		interval:    defaultReplayInterval,
+		logger:      slog.Default(),
+	}
+
+	for _, option := range options {
+		option(replayer)
+	}
+
+	return replayer
+}
+
+func (r *Replayer) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := r.store.ReplayRequested(ctx, r.redeliverer); err != nil {
+			r.logger.ErrorContext(ctx, "replaying dead letters failed", slog.Any("error", err))
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+	"github.com/FSpruhs/kick-app/backend/internal/requestid"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
+)
+
+type MessageStore interface {
+	FindPending(ctx context.Context, limit int) ([]ddd.AggregateEvent, error)
+	MarkPublished(ctx context.Context, eventID string) error
+	// MarkFailed returns how often publishing the event failed so far.
+	MarkFailed(ctx context.Context, eventID string, cause error) (int, error)
+	// MarkDeadLettered takes an event out of the pending ones after it was
+	// stored as dead letter.
+	MarkDeadLettered(ctx context.Context, eventID string) error
+}
+
+type messageDocument struct {
+	ID               string            `bson:"_id"`
+	Name             string            `bson:"name"`
+	AggregateID      string            `bson:"aggregateId"`
+	AggregateName    string            `bson:"aggregateName"`
+	AggregateVersion int               `bson:"aggregateVersion"`
+	Payload          bson.Raw          `bson:"payload"`
+	Metadata         map[string]string `bson:"metadata,omitempty"`
+	OccurredAt       time.Time         `bson:"occurredAt"`
+	PublishedAt      *time.Time        `bson:"publishedAt"`
+	DeadLetteredAt   *time.Time        `bson:"deadLetteredAt,omitempty"`
+	Attempts         int               `bson:"attempts"`
+	LastError        string            `bson:"lastError,omitempty"`
+}
+
+type Store struct {
+	collection *mongo.Collection
+	registry   registry.Registry
+}
+
+var _ MessageStore = (*Store)(nil)
+
+// Indexes serve the relay, which polls the pending messages oldest first.
+func Indexes(collectionName string) []indexes.Index {
+	return []indexes.Index{
+		{Collection: collectionName, Keys: bson.D{{Key: "publishedAt", Value: 1}, {Key: "occurredAt", Value: 1}}},
+	}
+}
+
+func NewStore(database *mongo.Database, collectionName string, reg registry.Registry) *Store {
+	return &Store{
+		collection: database.Collection(collectionName),
+		registry:   reg,
+	}
+}
+
+// Save writes the events into the outbox. It is meant to be called with the
+// session context of the transaction which also persists the aggregate. The
+// trace context of ctx is stored along with the events, so their handlers
+// continue the trace.
+func (s *Store) Save(ctx context.Context, events ...ddd.AggregateEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(events))
+
+	for i, event := range events {
+		payload, err := s.registry.Serialize(event.EventName(), event.Payload())
+		if err != nil {
+			return fmt.Errorf("serializing outbox event %s: %w", event.EventName(), err)
+		}
+
+		tracing.Inject(ctx, event.Metadata())
+		requestid.Inject(ctx, event.Metadata())
+
+		docs[i] = messageDocument{
+			ID:               event.ID(),
+			Name:             event.EventName(),
+			AggregateID:      event.AggregateID(),
+			AggregateName:    event.AggregateName(),
+			AggregateVersion: event.AggregateVersion(),
+			Payload:          payload,
+			Metadata:         event.Metadata(),
+			OccurredAt:       event.OccurredAt(),
+			PublishedAt:      nil,
+			Attempts:         0,
+		}
+	}
+
+	if _, err := s.collection.InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("inserting outbox events: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) FindPending(ctx context.Context, limit int) ([]ddd.AggregateEvent, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "occurredAt", Value: 1}}).SetLimit(int64(limit))
+
+	cursor, err := s.collection.Find(ctx, bson.M{"publishedAt": nil, "deadLetteredAt": nil}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding pending outbox events: %w", err)
+	}
+
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var docs []*messageDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("iterating over pending outbox events: %w", err)
+	}
+
+	events := make([]ddd.AggregateEvent, len(docs))
+
+	for i, doc := range docs {
+		payload, err := s.registry.Deserialize(doc.Name, doc.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("deserializing outbox event %s: %w", doc.ID, err)
+		}
+
+		events[i] = ddd.RestoreAggregateEvent(
+			doc.ID,
+			doc.Name,
+			payload,
+			doc.Metadata,
+			doc.OccurredAt,
+			doc.AggregateID,
+			doc.AggregateName,
+			doc.AggregateVersion,
+		)
+	}
+
+	return events, nil
+}
+
+func (s *Store) MarkPublished(ctx context.Context, eventID string) error {
+	update := bson.M{"$set": bson.M{"publishedAt": time.Now()}, "$inc": bson.M{"attempts": 1}}
+
+	if _, err := s.collection.UpdateByID(ctx, eventID, update); err != nil {
+		return fmt.Errorf("marking outbox event %s as published: %w", eventID, err)
+	}
+
+	return nil
+}
+
+func (s *Store) MarkFailed(ctx context.Context, eventID string, cause error) (int, error) {
+	update := bson.M{"$set": bson.M{"lastError": cause.Error()}, "$inc": bson.M{"attempts": 1}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After).SetProjection(bson.M{"attempts": 1})
+
+	var doc messageDocument
+	if err := s.collection.FindOneAndUpdate(ctx, bson.M{"_id": eventID}, update, opts).Decode(&doc); err != nil {
+		return 0, fmt.Errorf("marking outbox event %s as failed: %w", eventID, err)
+	}
+
+	return doc.Attempts, nil
+}
+
+func (s *Store) MarkDeadLettered(ctx context.Context, eventID string) error {
+	update := bson.M{"$set": bson.M{"deadLetteredAt": time.Now()}}
+
+	if _, err := s.collection.UpdateByID(ctx, eventID, update); err != nil {
+		return fmt.Errorf("marking outbox event %s as dead lettered: %w", eventID, err)
+	}
+
+	return nil
+}
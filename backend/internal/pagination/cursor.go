@@ -0,0 +1,60 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// cursorToken is the content of an encoded cursor. Clients only pass it on,
+// so its keys are kept short.
+type cursorToken struct {
+	Sort     string `json:"s"`
+	Value    string `json:"v"`
+	ID       string `json:"i"`
+	Backward bool   `json:"b,omitempty"`
+}
+
+// Encode returns the cursor as opaque token for a client.
+func (c *Cursor) Encode() string {
+	token, _ := json.Marshal(cursorToken{
+		Sort:     c.Sort.String(),
+		Value:    formatValue(c.Value),
+		ID:       c.ID,
+		Backward: c.Backward,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+func (s Schema) decodeCursor(encoded string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	var token cursorToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	name, descending := strings.CutPrefix(token.Sort, "-")
+
+	field, ok := s.Fields[name]
+	if !ok || !field.Sortable || token.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	value, err := parseValue(field.Type, token.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	return &Cursor{
+		Sort:     Sort{Field: name, Descending: descending},
+		Value:    value,
+		ID:       token.ID,
+		Backward: token.Backward,
+	}, nil
+}
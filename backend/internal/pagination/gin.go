@@ -0,0 +1,69 @@
+package pagination
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+)
+
+const (
+	LimitParam  = "limit"
+	SortParam   = "sort"
+	FilterParam = "filter"
+	CursorParam = "cursor"
+)
+
+var fields = ginconfig.FieldMapping{
+	ErrInvalidLimit:  LimitParam,
+	ErrInvalidSort:   SortParam,
+	ErrInvalidFilter: FilterParam,
+	ErrInvalidCursor: CursorParam,
+}
+
+// FromQuery parses the query parameters limit, sort, filter and cursor of the
+// request. Filter may be repeated. On failure the request is aborted and ok
+// is false.
+func FromQuery(c *gin.Context, schema Schema) (request Request, ok bool) {
+	request, err := schema.Parse(Query{
+		Limit:   c.Query(LimitParam),
+		Sort:    c.Query(SortParam),
+		Filters: c.QueryArray(FilterParam),
+		Cursor:  c.Query(CursorParam),
+	})
+	if err != nil {
+		ginconfig.AbortWithProblem(c, fields.Map(err))
+
+		return Request{}, false
+	}
+
+	return request, true
+}
+
+// Response is a page as sent to a client. Next and Prev link the neighbouring
+// pages with the same limit, sort and filters.
+type Response[T any] struct {
+	Items []T    `json:"items"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+}
+
+// NewResponse returns items, the converted items of page, with the links of
+// page relative to the requested URL.
+func NewResponse[T, I any](c *gin.Context, page Page[I], items []T) Response[T] {
+	return Response[T]{
+		Items: items,
+		Next:  link(c, page.Next),
+		Prev:  link(c, page.Prev),
+	}
+}
+
+func link(c *gin.Context, cursor *Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	query := c.Request.URL.Query()
+	query.Set(CursorParam, cursor.Encode())
+
+	return c.Request.URL.Path + "?" + query.Encode()
+}
@@ -0,0 +1,156 @@
+package pagination
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Position is the value of the sorted field and the id of an item.
+type Position struct {
+	Value any
+	ID    string
+}
+
+// Link points Next and Prev of page at its last and first item, as far as
+// there are items beyond them. hasMore tells if further items followed the
+// page in the order it was read, which is reversed for backward cursors.
+func Link[T any](page Page[T], request Request, hasMore bool, first, last Position) Page[T] {
+	next := &Cursor{Sort: request.Sort, Value: last.Value, ID: last.ID, Backward: false}
+	prev := &Cursor{Sort: request.Sort, Value: first.Value, ID: first.ID, Backward: true}
+
+	switch {
+	case request.Cursor == nil:
+		if hasMore {
+			page.Next = next
+		}
+	case request.Cursor.Backward:
+		page.Next = next
+		if hasMore {
+			page.Prev = prev
+		}
+	default:
+		page.Prev = prev
+		if hasMore {
+			page.Next = next
+		}
+	}
+
+	return page
+}
+
+// Slice reads the page of request from items held in memory the same way a
+// database does. id returns the id of an item, value the value of a field of
+// the schema.
+func Slice[T any](items []T, request Request, id func(T) string, value func(T, string) any) Page[T] {
+	matching := slices.DeleteFunc(slices.Clone(items), func(item T) bool {
+		for _, filter := range request.Filters {
+			if !filter.matches(value(item, filter.Field)) {
+				return true
+			}
+		}
+
+		return false
+	})
+
+	position := func(item T) Position {
+		return Position{Value: value(item, request.Sort.Field), ID: id(item)}
+	}
+
+	// a backward page is read in reverse order and turned around afterwards
+	backward := request.Cursor != nil && request.Cursor.Backward
+	ascending := request.Sort.Descending == backward
+
+	order := func(a, b Position) int {
+		if ascending {
+			return comparePositions(a, b)
+		}
+
+		return comparePositions(b, a)
+	}
+
+	slices.SortFunc(matching, func(a, b T) int {
+		return order(position(a), position(b))
+	})
+
+	if request.Cursor != nil {
+		cursor := Position{Value: request.Cursor.Value, ID: request.Cursor.ID}
+		matching = slices.DeleteFunc(matching, func(item T) bool {
+			return order(position(item), cursor) <= 0
+		})
+	}
+
+	hasMore := len(matching) > request.Limit
+	if hasMore {
+		matching = matching[:request.Limit]
+	}
+
+	if backward {
+		slices.Reverse(matching)
+	}
+
+	page := Page[T]{Items: matching, Next: nil, Prev: nil}
+	if len(matching) == 0 {
+		return page
+	}
+
+	return Link(page, request, hasMore, position(matching[0]), position(matching[len(matching)-1]))
+}
+
+func (f Filter) matches(value any) bool {
+	if f.Operator == Prefix {
+		text, ok := value.(string)
+
+		return ok && strings.HasPrefix(text, fmt.Sprint(f.Value))
+	}
+
+	result := compareValues(value, f.Value)
+
+	switch f.Operator {
+	case Equal:
+		return result == 0
+	case NotEqual:
+		return result != 0
+	case Less:
+		return result < 0
+	case LessOrEqual:
+		return result <= 0
+	case Greater:
+		return result > 0
+	case GreaterOrEqual:
+		return result >= 0
+	default:
+		return false
+	}
+}
+
+func comparePositions(a, b Position) int {
+	if result := compareValues(a.Value, b.Value); result != 0 {
+		return result
+	}
+
+	return strings.Compare(a.ID, b.ID)
+}
+
+func compareValues(a, b any) int {
+	switch typed := a.(type) {
+	case time.Time:
+		if other, ok := b.(time.Time); ok {
+			return typed.Compare(other)
+		}
+	case bool:
+		if other, ok := b.(bool); ok {
+			switch {
+			case typed == other:
+				return 0
+			case other:
+				return -1
+			default:
+				return 1
+			}
+		}
+	}
+
+	return strings.Compare(formatValue(a), formatValue(b))
+}
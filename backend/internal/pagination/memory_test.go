@@ -0,0 +1,89 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	id   string
+	name string
+	read bool
+}
+
+func sliceItems(items []testItem, request Request) Page[testItem] {
+	return Slice(items, request, func(item testItem) string { return item.id }, func(item testItem, field string) any {
+		if field == "read" {
+			return item.read
+		}
+
+		return item.name
+	})
+}
+
+func ids(page Page[testItem]) []string {
+	result := make([]string, len(page.Items))
+	for i, item := range page.Items {
+		result[i] = item.id
+	}
+
+	return result
+}
+
+func TestSlice(t *testing.T) {
+	items := []testItem{
+		{id: "4", name: "d", read: true},
+		{id: "1", name: "a", read: false},
+		{id: "3", name: "b", read: false},
+		{id: "2", name: "b", read: true},
+		{id: "5", name: "e", read: false},
+	}
+
+	t.Run("walks forward and back", func(t *testing.T) {
+		request := Request{Limit: 2, Sort: Sort{Field: "name"}}
+
+		first := sliceItems(items, request)
+		assert.Equal(t, []string{"1", "2"}, ids(first))
+		assert.Nil(t, first.Prev)
+		require.NotNil(t, first.Next)
+
+		request.Cursor = first.Next
+		second := sliceItems(items, request)
+		assert.Equal(t, []string{"3", "4"}, ids(second))
+		require.NotNil(t, second.Next)
+
+		request.Cursor = second.Next
+		last := sliceItems(items, request)
+		assert.Equal(t, []string{"5"}, ids(last))
+		assert.Nil(t, last.Next)
+
+		request.Cursor = last.Prev
+		back := sliceItems(items, request)
+		assert.Equal(t, []string{"3", "4"}, ids(back))
+
+		request.Cursor = back.Prev
+		start := sliceItems(items, request)
+		assert.Equal(t, []string{"1", "2"}, ids(start))
+		assert.Nil(t, start.Prev)
+		assert.NotNil(t, start.Next)
+	})
+
+	t.Run("sorts descending", func(t *testing.T) {
+		page := sliceItems(items, Request{Limit: 3, Sort: Sort{Field: "name", Descending: true}})
+
+		assert.Equal(t, []string{"5", "4", "3"}, ids(page))
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		page := sliceItems(items, Request{
+			Limit:   10,
+			Sort:    Sort{Field: "name"},
+			Filters: []Filter{{Field: "read", Operator: Equal, Value: false}, {Field: "name", Operator: Greater, Value: "a"}},
+		})
+
+		assert.Equal(t, []string{"3", "5"}, ids(page))
+		assert.Nil(t, page.Next)
+	})
+}
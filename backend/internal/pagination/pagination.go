@@ -0,0 +1,252 @@
+package pagination
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var (
+	ErrInvalidLimit  = ddd.ValidationError("pagination.invalid_limit", "limit must be between 1 and 100")
+	ErrInvalidSort   = ddd.ValidationError("pagination.invalid_sort", "list can not be sorted by this field")
+	ErrInvalidFilter = ddd.ValidationError(
+		"pagination.invalid_filter",
+		"filter must have the form field:operator:value with a filterable field",
+	)
+	ErrInvalidCursor = ddd.ValidationError("pagination.invalid_cursor", "cursor is invalid")
+)
+
+// Type is the type of the values of a field.
+type Type int
+
+const (
+	String Type = iota
+	Time
+	Bool
+)
+
+type Operator string
+
+const (
+	Equal          Operator = "eq"
+	NotEqual       Operator = "ne"
+	Less           Operator = "lt"
+	LessOrEqual    Operator = "lte"
+	Greater        Operator = "gt"
+	GreaterOrEqual Operator = "gte"
+	Prefix         Operator = "prefix"
+)
+
+// Field is a field of the items of a list. Operators are the operators the
+// field may be filtered by.
+type Field struct {
+	Type      Type
+	Sortable  bool
+	Operators []Operator
+}
+
+// Schema describes by which fields a list may be sorted and filtered.
+type Schema struct {
+	Fields      map[string]Field
+	DefaultSort Sort
+}
+
+type Sort struct {
+	Field      string
+	Descending bool
+}
+
+// String is the sort as sent by a client, e.g. "-occurredAt".
+func (s Sort) String() string {
+	if s.Descending {
+		return "-" + s.Field
+	}
+
+	return s.Field
+}
+
+type Filter struct {
+	Field    string
+	Operator Operator
+	Value    any
+}
+
+// Cursor points at an item of a list by the value of the sorted field and
+// its id. A backward cursor reads the items before the item, any other
+// cursor the items after it.
+type Cursor struct {
+	Sort     Sort
+	Value    any
+	ID       string
+	Backward bool
+}
+
+// Request asks for the items of a list following or preceding Cursor. The
+// first page is requested without cursor.
+type Request struct {
+	Limit   int
+	Sort    Sort
+	Filters []Filter
+	Cursor  *Cursor
+}
+
+// Page is a part of a list. Next and Prev are nil at the end and at the start
+// of the list.
+type Page[T any] struct {
+	Items []T
+	Next  *Cursor
+	Prev  *Cursor
+}
+
+// WithItems returns page holding items instead of its own, e.g. the converted
+// items of page.
+func WithItems[T, U any](page Page[T], items []U) Page[U] {
+	return Page[U]{
+		Items: items,
+		Next:  page.Next,
+		Prev:  page.Prev,
+	}
+}
+
+// Query is a request as sent by a client.
+type Query struct {
+	Limit   string
+	Sort    string
+	Filters []string
+	Cursor  string
+}
+
+// Parse checks query against the schema. All invalid parts of query are
+// reported at once.
+func (s Schema) Parse(query Query) (Request, error) {
+	request := Request{
+		Limit:   DefaultLimit,
+		Sort:    s.DefaultSort,
+		Filters: nil,
+		Cursor:  nil,
+	}
+
+	var errs []error
+
+	if query.Limit != "" {
+		limit, err := strconv.Atoi(query.Limit)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			errs = append(errs, ErrInvalidLimit)
+		} else {
+			request.Limit = limit
+		}
+	}
+
+	if query.Sort != "" {
+		sort, err := s.parseSort(query.Sort)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			request.Sort = sort
+		}
+	}
+
+	for _, expression := range query.Filters {
+		filter, err := s.parseFilter(expression)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		request.Filters = append(request.Filters, filter)
+	}
+
+	if query.Cursor != "" {
+		cursor, err := s.decodeCursor(query.Cursor)
+		if err == nil && cursor.Sort != request.Sort {
+			err = fmt.Errorf("%w: cursor was issued for sort %s", ErrInvalidCursor, cursor.Sort)
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			request.Cursor = cursor
+		}
+	}
+
+	return request, errors.Join(errs...)
+}
+
+func (s Schema) parseSort(value string) (Sort, error) {
+	name, descending := strings.CutPrefix(value, "-")
+
+	field, ok := s.Fields[name]
+	if !ok || !field.Sortable {
+		return Sort{}, fmt.Errorf("%w: %s", ErrInvalidSort, name)
+	}
+
+	return Sort{Field: name, Descending: descending}, nil
+}
+
+// parseFilter parses a filter expression like "read:eq:false". The value may
+// contain colons itself.
+func (s Schema) parseFilter(expression string) (Filter, error) {
+	parts := strings.SplitN(expression, ":", 3)
+	if len(parts) != 3 {
+		return Filter{}, fmt.Errorf("%w: %s", ErrInvalidFilter, expression)
+	}
+
+	name, operator := parts[0], Operator(parts[1])
+
+	field, ok := s.Fields[name]
+	if !ok || !slices.Contains(field.Operators, operator) {
+		return Filter{}, fmt.Errorf("%w: %s", ErrInvalidFilter, expression)
+	}
+
+	value, err := parseValue(field.Type, parts[2])
+	if err != nil {
+		return Filter{}, fmt.Errorf("%w: %s: %w", ErrInvalidFilter, expression, err)
+	}
+
+	return Filter{Field: name, Operator: operator, Value: value}, nil
+}
+
+func parseValue(fieldType Type, value string) (any, error) {
+	switch fieldType {
+	case Time:
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing time: %w", err)
+		}
+
+		return parsed, nil
+	case Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing bool: %w", err)
+		}
+
+		return parsed, nil
+	default:
+		return value, nil
+	}
+}
+
+func formatValue(value any) string {
+	switch typed := value.(type) {
+	case time.Time:
+		return typed.UTC().Format(time.RFC3339Nano)
+	case bool:
+		return strconv.FormatBool(typed)
+	case string:
+		return typed
+	default:
+		return fmt.Sprint(typed)
+	}
+}
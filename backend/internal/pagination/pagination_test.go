@@ -0,0 +1,92 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = Schema{
+	Fields: map[string]Field{
+		"name":       {Type: String, Sortable: true, Operators: []Operator{Equal, Prefix}},
+		"occurredAt": {Type: Time, Sortable: true, Operators: []Operator{Less, Greater}},
+		"read":       {Type: Bool, Sortable: false, Operators: []Operator{Equal}},
+	},
+	DefaultSort: Sort{Field: "name", Descending: false},
+}
+
+func TestSchema_Parse(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		request, err := testSchema.Parse(Query{})
+
+		require.NoError(t, err)
+		assert.Equal(t, Request{Limit: DefaultLimit, Sort: Sort{Field: "name"}}, request)
+	})
+
+	t.Run("limit, sort and filters", func(t *testing.T) {
+		request, err := testSchema.Parse(Query{
+			Limit:   "5",
+			Sort:    "-occurredAt",
+			Filters: []string{"read:eq:false", "occurredAt:gt:2024-05-01T18:00:00Z", "name:prefix:a:b"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, Request{
+			Limit: 5,
+			Sort:  Sort{Field: "occurredAt", Descending: true},
+			Filters: []Filter{
+				{Field: "read", Operator: Equal, Value: false},
+				{Field: "occurredAt", Operator: Greater, Value: time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)},
+				{Field: "name", Operator: Prefix, Value: "a:b"},
+			},
+		}, request)
+	})
+
+	t.Run("reports every invalid part", func(t *testing.T) {
+		_, err := testSchema.Parse(Query{
+			Limit:   "101",
+			Sort:    "read",
+			Filters: []string{"read", "read:gt:true", "unknown:eq:1", "read:eq:maybe"},
+			Cursor:  "not a cursor",
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidLimit)
+		assert.ErrorIs(t, err, ErrInvalidSort)
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+		assert.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 7)
+	})
+}
+
+func TestCursor(t *testing.T) {
+	sort := Sort{Field: "occurredAt", Descending: true}
+	cursor := &Cursor{
+		Sort:     sort,
+		Value:    time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC),
+		ID:       "message-1",
+		Backward: true,
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		request, err := testSchema.Parse(Query{Sort: "-occurredAt", Cursor: cursor.Encode()})
+
+		require.NoError(t, err)
+		assert.Equal(t, cursor, request.Cursor)
+	})
+
+	t.Run("issued for another sort", func(t *testing.T) {
+		_, err := testSchema.Parse(Query{Sort: "occurredAt", Cursor: cursor.Encode()})
+
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("of a field which can not be sorted", func(t *testing.T) {
+		unsortable := &Cursor{Sort: Sort{Field: "read"}, Value: true, ID: "message-1"}
+
+		_, err := testSchema.Parse(Query{Sort: "read", Cursor: unsortable.Encode()})
+
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
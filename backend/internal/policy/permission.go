@@ -0,0 +1,85 @@
+package policy
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+type Permission string
+
+const (
+	PermissionInvite              Permission = "invite"
+	PermissionCreateMatches       Permission = "create_matches"
+	PermissionManageRegistrations Permission = "manage_registrations"
+	PermissionRemovePlayers       Permission = "remove_players"
+	PermissionEditSettings        Permission = "edit_settings"
+)
+
+// Permissions lists all permissions a role can be granted.
+var Permissions = []Permission{
+	PermissionInvite,
+	PermissionCreateMatches,
+	PermissionManageRegistrations,
+	PermissionRemovePlayers,
+	PermissionEditSettings,
+}
+
+var ErrUnknownPermission = ddd.ValidationError("policy.unknown_permission", "unknown permission")
+
+type UnknownPermissionError struct {
+	permission string
+}
+
+func (e UnknownPermissionError) Error() string {
+	return "unknown permission: " + e.permission
+}
+
+func (e UnknownPermissionError) Unwrap() error {
+	return ErrUnknownPermission
+}
+
+func ToPermission(permission string) (Permission, error) {
+	if !slices.Contains(Permissions, Permission(permission)) {
+		return "", UnknownPermissionError{permission}
+	}
+
+	return Permission(permission), nil
+}
+
+func ToPermissions(permissions []string) ([]Permission, error) {
+	result := make([]Permission, len(permissions))
+
+	for i, p := range permissions {
+		permission, err := ToPermission(p)
+		if err != nil {
+			return nil, fmt.Errorf("converting permissions: %w", err)
+		}
+
+		result[i] = permission
+	}
+
+	return result, nil
+}
+
+// DefaultPermissions are the permissions the built-in roles had when the
+// role alone decided what a player may do. Only roles reaching the invite
+// level of the group may invite.
+func DefaultPermissions(role, inviteLevel Role) []Permission {
+	permissions := []Permission{PermissionCreateMatches}
+
+	if role >= inviteLevel {
+		permissions = append(permissions, PermissionInvite)
+	}
+
+	if role >= RoleAdmin {
+		permissions = append(permissions, PermissionManageRegistrations, PermissionRemovePlayers)
+	}
+
+	if role == RoleMaster {
+		permissions = append(permissions, PermissionEditSettings)
+	}
+
+	return permissions
+}
@@ -0,0 +1,212 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	ErrNoRule      = errors.New("no rule for action")
+	ErrUnknownRole = errors.New("unknown role")
+)
+
+type Role int
+
+const (
+	RoleMember Role = iota
+	RoleAdmin
+	RoleMaster
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleMember:
+		return "member"
+	case RoleAdmin:
+		return "admin"
+	case RoleMaster:
+		return "master"
+	default:
+		return "unknown"
+	}
+}
+
+// ToRole reads a role from its name, e.g. as another module reported it.
+func ToRole(name string) (Role, error) {
+	for _, role := range []Role{RoleMember, RoleAdmin, RoleMaster} {
+		if role.String() == name {
+			return role, nil
+		}
+	}
+
+	return RoleMember, fmt.Errorf("%s: %w", name, ErrUnknownRole)
+}
+
+type Action string
+
+const (
+	InviteUser          Action = "group.invite_user"
+	ChangeRole          Action = "group.change_role"
+	ChangeStatus        Action = "group.change_status"
+	RemovePlayer        Action = "group.remove_player"
+	CreateMatch         Action = "match.create"
+	RespondToInvitation Action = "match.respond_to_invitation"
+	ManageRegistrations Action = "match.manage_registrations"
+	ViewMatches         Action = "match.view"
+	ManageMatch         Action = "match.manage"
+	EditSettings        Action = "group.edit_settings"
+	ViewHistory         Action = "group.view_history"
+)
+
+// Subject is a user acting or being acted upon in the context of a group.
+// Role ranks the users of a group, Permissions are granted by the roles a
+// user holds.
+type Subject struct {
+	UserID      string
+	Role        Role
+	Active      bool
+	Permissions []Permission
+}
+
+func (s Subject) Has(permission Permission) bool {
+	return slices.Contains(s.Permissions, permission)
+}
+
+const (
+	GroupResource = "group"
+	MatchResource = "match"
+)
+
+type Resource struct {
+	Kind string
+	ID   string
+}
+
+// Request asks whether Actor may perform Action on Resource. Target and
+// NewRole are only evaluated by the rules of the actions using them.
+type Request struct {
+	Actor    Subject
+	Action   Action
+	Resource Resource
+	Target   Subject
+	NewRole  Role
+}
+
+// Decision is the outcome of a request. Condition names the condition which
+// denied the request.
+type Decision struct {
+	Request   Request
+	Allowed   bool
+	Condition string
+	Err       error
+	DecidedAt time.Time
+}
+
+type Option func(e *Engine)
+
+// Audit replaces the default audit, which writes every decision to the
+// default slog logger with the request and trace of the context.
+func Audit(audit func(ctx context.Context, decision Decision)) Option {
+	return func(e *Engine) {
+		e.audit = audit
+	}
+}
+
+// Engine decides requests by the conditions of the rule for their action.
+type Engine struct {
+	rules map[Action][]Condition
+	audit func(ctx context.Context, decision Decision)
+}
+
+var defaultEngine atomic.Pointer[Engine]
+
+func init() {
+	defaultEngine.Store(New(DefaultRules))
+}
+
+func New(rules []Rule, options ...Option) *Engine {
+	engine := &Engine{
+		rules: make(map[Action][]Condition, len(rules)),
+		audit: logDecision,
+	}
+
+	for _, rule := range rules {
+		engine.rules[rule.Action] = append(engine.rules[rule.Action], rule.Require...)
+	}
+
+	for _, option := range options {
+		option(engine)
+	}
+
+	return engine
+}
+
+// Authorize returns nil if the request is allowed, otherwise the error of the
+// first condition which does not hold.
+func (e *Engine) Authorize(ctx context.Context, req Request) error {
+	decision := e.decide(req)
+	e.audit(ctx, decision)
+
+	return decision.Err
+}
+
+func (e *Engine) decide(req Request) Decision {
+	decision := Decision{Request: req, DecidedAt: time.Now()}
+
+	conditions, exists := e.rules[req.Action]
+	if !exists {
+		decision.Err = ErrNoRule
+
+		return decision
+	}
+
+	for _, condition := range conditions {
+		if !condition.Holds(req) {
+			decision.Condition = condition.Name
+			decision.Err = condition.Err
+
+			return decision
+		}
+	}
+
+	decision.Allowed = true
+
+	return decision
+}
+
+// Default returns the engine used by Authorize.
+func Default() *Engine {
+	return defaultEngine.Load()
+}
+
+func SetDefault(engine *Engine) {
+	defaultEngine.Store(engine)
+}
+
+// Authorize decides the request with the default engine.
+func Authorize(ctx context.Context, req Request) error {
+	return Default().Authorize(ctx, req)
+}
+
+func logDecision(ctx context.Context, d Decision) {
+	attrs := []any{
+		slog.String("action", string(d.Request.Action)),
+		slog.String("resource", d.Request.Resource.Kind),
+		slog.String("resource_id", d.Request.Resource.ID),
+		slog.String("user_id", d.Request.Actor.UserID),
+	}
+
+	if d.Allowed {
+		slog.InfoContext(ctx, "policy allowed request", attrs...)
+
+		return
+	}
+
+	attrs = append(attrs, slog.String("condition", d.Condition), slog.Any("error", d.Err))
+	slog.WarnContext(ctx, "policy denied request", attrs...)
+}
@@ -0,0 +1,243 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func member(userID string) Subject {
+	return Subject{UserID: userID, Role: RoleMember, Active: true, Permissions: DefaultPermissions(RoleMember, RoleAdmin)}
+}
+
+func admin(userID string) Subject {
+	return Subject{UserID: userID, Role: RoleAdmin, Active: true, Permissions: DefaultPermissions(RoleAdmin, RoleAdmin)}
+}
+
+func master(userID string) Subject {
+	return Subject{UserID: userID, Role: RoleMaster, Active: true, Permissions: DefaultPermissions(RoleMaster, RoleAdmin)}
+}
+
+func organizer(userID string) Subject {
+	subject := member(userID)
+	subject.Permissions = append(subject.Permissions, PermissionRemovePlayers)
+
+	return subject
+}
+
+func TestEngine_Authorize(t *testing.T) {
+	engine := New(DefaultRules, Audit(func(context.Context, Decision) {}))
+	group := Resource{Kind: GroupResource, ID: "group-1"}
+
+	tests := []struct {
+		name    string
+		req     Request
+		wantErr error
+	}{
+		{
+			name: "admin invites",
+			req:  Request{Actor: admin("1"), Action: InviteUser, Resource: group},
+		},
+		{
+			name:    "member invites",
+			req:     Request{Actor: member("1"), Action: InviteUser, Resource: group},
+			wantErr: ErrBelowInviteLevel,
+		},
+		{
+			name: "admin promotes member to admin",
+			req:  Request{Actor: admin("1"), Action: ChangeRole, Resource: group, Target: member("2"), NewRole: RoleAdmin},
+		},
+		{
+			name:    "member changes role",
+			req:     Request{Actor: member("1"), Action: ChangeRole, Resource: group, Target: member("1"), NewRole: RoleAdmin},
+			wantErr: ErrInsufficientRole,
+		},
+		{
+			name:    "admin changes own role",
+			req:     Request{Actor: admin("1"), Action: ChangeRole, Resource: group, Target: admin("1"), NewRole: RoleMember},
+			wantErr: ErrSelfRoleChange,
+		},
+		{
+			name:    "admin downgrades admin to member",
+			req:     Request{Actor: admin("1"), Action: ChangeRole, Resource: group, Target: admin("2"), NewRole: RoleMember},
+			wantErr: ErrOnlyMasterCanDowngradeToMember,
+		},
+		{
+			name:    "admin promotes to master",
+			req:     Request{Actor: admin("1"), Action: ChangeRole, Resource: group, Target: member("2"), NewRole: RoleMaster},
+			wantErr: ErrOnlyMasterCanPromoteToMaster,
+		},
+		{
+			name:    "admin downgrades master",
+			req:     Request{Actor: admin("1"), Action: ChangeRole, Resource: group, Target: master("2"), NewRole: RoleAdmin},
+			wantErr: ErrOnlyMasterCanChangeMaster,
+		},
+		{
+			name: "master promotes to master",
+			req:  Request{Actor: master("1"), Action: ChangeRole, Resource: group, Target: admin("2"), NewRole: RoleMaster},
+		},
+		{
+			name: "member changes own status",
+			req:  Request{Actor: member("1"), Action: ChangeStatus, Resource: group, Target: member("1")},
+		},
+		{
+			name:    "member changes status of other",
+			req:     Request{Actor: member("1"), Action: ChangeStatus, Resource: group, Target: member("2")},
+			wantErr: ErrInsufficientRole,
+		},
+		{
+			name:    "admin removes admin",
+			req:     Request{Actor: admin("1"), Action: RemovePlayer, Resource: group, Target: admin("2")},
+			wantErr: ErrInsufficientRole,
+		},
+		{
+			name: "master removes admin",
+			req:  Request{Actor: master("1"), Action: RemovePlayer, Resource: group, Target: admin("2")},
+		},
+		{
+			name:    "member removes member",
+			req:     Request{Actor: member("1"), Action: RemovePlayer, Resource: group, Target: member("2")},
+			wantErr: ErrInsufficientRole,
+		},
+		{
+			name: "member with custom role removes member",
+			req:  Request{Actor: organizer("1"), Action: RemovePlayer, Resource: group, Target: member("2")},
+		},
+		{
+			name:    "member with custom role removes admin",
+			req:     Request{Actor: organizer("1"), Action: RemovePlayer, Resource: group, Target: admin("2")},
+			wantErr: ErrInsufficientRole,
+		},
+		{
+			name:    "inactive member creates match",
+			req:     Request{Actor: Subject{UserID: "1"}, Action: CreateMatch, Resource: group},
+			wantErr: ErrActorNotActive,
+		},
+		{
+			name:    "member responds for other",
+			req:     Request{Actor: member("1"), Action: RespondToInvitation, Target: member("2")},
+			wantErr: ErrActingForOther,
+		},
+		{
+			name:    "member manages registrations",
+			req:     Request{Actor: member("1"), Action: ManageRegistrations, Target: member("2")},
+			wantErr: ErrInsufficientRole,
+		},
+		{
+			name: "member with permission manages registrations",
+			req: Request{
+				Actor:  Subject{UserID: "1", Role: RoleMember, Permissions: []Permission{PermissionManageRegistrations}},
+				Action: ManageRegistrations,
+				Target: member("2"),
+			},
+		},
+		{
+			name: "member views matches",
+			req:  Request{Actor: member("1"), Action: ViewMatches, Resource: group},
+		},
+		{
+			name:    "inactive member views matches",
+			req:     Request{Actor: Subject{UserID: "1"}, Action: ViewMatches, Resource: group},
+			wantErr: ErrActorNotActive,
+		},
+		{
+			name: "member views history",
+			req:  Request{Actor: member("1"), Action: ViewHistory, Resource: group},
+		},
+		{
+			name:    "non member views history",
+			req:     Request{Actor: Subject{UserID: "1"}, Action: ViewHistory, Resource: group},
+			wantErr: ErrActorNotActive,
+		},
+		{
+			name: "admin manages match",
+			req:  Request{Actor: admin("1"), Action: ManageMatch, Resource: group},
+		},
+		{
+			name:    "member manages match",
+			req:     Request{Actor: member("1"), Action: ManageMatch, Resource: group},
+			wantErr: ErrInsufficientRole,
+		},
+		{
+			name:    "admin edits settings",
+			req:     Request{Actor: admin("1"), Action: EditSettings, Resource: group},
+			wantErr: ErrMissingPermission,
+		},
+		{
+			name: "master edits settings",
+			req:  Request{Actor: master("1"), Action: EditSettings, Resource: group},
+		},
+		{
+			name:    "unknown action",
+			req:     Request{Actor: master("1"), Action: "unknown"},
+			wantErr: ErrNoRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := engine.Authorize(context.Background(), tt.req)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+type contextKey struct{}
+
+func TestEngine_AuthorizeAuditsDecisions(t *testing.T) {
+	var (
+		decisions []Decision
+		contexts  []context.Context
+	)
+
+	engine := New(DefaultRules, Audit(func(ctx context.Context, d Decision) {
+		contexts = append(contexts, ctx)
+		decisions = append(decisions, d)
+	}))
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "request-id")
+	_ = engine.Authorize(ctx, Request{Actor: admin("1"), Action: InviteUser})
+	_ = engine.Authorize(ctx, Request{Actor: member("2"), Action: InviteUser})
+
+	// the audit logs the decisions with the request they were made for
+	assert.Len(t, contexts, 2)
+	assert.Equal(t, "request-id", contexts[1].Value(contextKey{}))
+	assert.Len(t, decisions, 2)
+	assert.True(t, decisions[0].Allowed)
+	assert.Equal(t, "1", decisions[0].Request.Actor.UserID)
+	assert.False(t, decisions[1].Allowed)
+	assert.Equal(t, "actor has invite", decisions[1].Condition)
+	assert.Equal(t, ErrBelowInviteLevel, decisions[1].Err)
+}
+
+func TestDefaultPermissions(t *testing.T) {
+	assert.Equal(t, []Permission{PermissionCreateMatches}, DefaultPermissions(RoleMember, RoleAdmin))
+	assert.Equal(t, []Permission{PermissionCreateMatches, PermissionInvite}, DefaultPermissions(RoleMember, RoleMember))
+	assert.ElementsMatch(t, Permissions, DefaultPermissions(RoleMaster, RoleAdmin))
+}
+
+func TestToPermission(t *testing.T) {
+	permission, err := ToPermission("invite")
+
+	assert.NoError(t, err)
+	assert.Equal(t, PermissionInvite, permission)
+
+	_, err = ToPermission("unknown")
+
+	assert.Equal(t, UnknownPermissionError{"unknown"}, err)
+}
+
+func TestToRole(t *testing.T) {
+	for _, role := range []Role{RoleMember, RoleAdmin, RoleMaster} {
+		parsed, err := ToRole(role.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, role, parsed)
+	}
+
+	_, err := ToRole("owner")
+
+	assert.ErrorIs(t, err, ErrUnknownRole)
+}
@@ -0,0 +1,144 @@
+package policy
+
+import "github.com/FSpruhs/kick-app/backend/internal/ddd"
+
+var (
+	ErrActorNotActive                 = ddd.ForbiddenError("policy.actor_not_active", "acting user is not active in the group")
+	ErrActingForOther                 = ddd.ForbiddenError("policy.acting_for_other", "user can only act for themselves")
+	ErrInsufficientRole               = ddd.ForbiddenError("policy.insufficient_role", "role of acting user is too low")
+	ErrBelowInviteLevel               = ddd.ForbiddenError("policy.below_invite_level", "inviting player role is too low")
+	ErrMissingPermission              = ddd.ForbiddenError("policy.missing_permission", "acting user misses permission")
+	ErrSelfRoleChange                 = ddd.ForbiddenError("policy.self_role_change", "user can not change own role")
+	ErrOnlyMasterCanDowngradeToMember = ddd.ForbiddenError("policy.only_master_can_downgrade_to_member", "only master can downgrade role to member")
+	ErrOnlyMasterCanPromoteToMaster   = ddd.ForbiddenError("policy.only_master_can_promote_to_master", "only master can update to master")
+	ErrOnlyMasterCanChangeMaster      = ddd.ForbiddenError("policy.only_master_can_change_master", "only master can change the role of a master")
+)
+
+// Condition must hold for a request to be allowed. Err is returned to the
+// caller if it does not.
+type Condition struct {
+	Name  string
+	Holds func(req Request) bool
+	Err   error
+}
+
+// Rule allows an action if all of its conditions hold. They are evaluated in
+// order, so the first failing condition determines the error.
+type Rule struct {
+	Action  Action
+	Require []Condition
+}
+
+var DefaultRules = []Rule{
+	{Action: InviteUser, Require: []Condition{ActorHas(PermissionInvite, ErrBelowInviteLevel)}},
+	{Action: ChangeRole, Require: []Condition{
+		ActorAtLeast(RoleAdmin),
+		NotSelf,
+		OnlyMasterGrants(RoleMember, ErrOnlyMasterCanDowngradeToMember),
+		OnlyMasterGrants(RoleMaster, ErrOnlyMasterCanPromoteToMaster),
+		OnlyMasterChangesMaster,
+	}},
+	{Action: ChangeStatus, Require: []Condition{SelfOrActorAtLeast(RoleAdmin)}},
+	{Action: RemovePlayer, Require: []Condition{
+		ActorHas(PermissionRemovePlayers, ErrInsufficientRole),
+		ActorOutranksTargetOrTargetIsMember,
+	}},
+	{Action: CreateMatch, Require: []Condition{
+		ActorIsActive,
+		ActorHas(PermissionCreateMatches, ErrMissingPermission),
+	}},
+	{Action: RespondToInvitation, Require: []Condition{Self, ActorIsActive}},
+	{Action: ManageRegistrations, Require: []Condition{
+		ActorHas(PermissionManageRegistrations, ErrInsufficientRole),
+	}},
+	{Action: ViewMatches, Require: []Condition{ActorIsActive}},
+	// cancelling, rescheduling and finishing a match is up to whoever
+	// manages its registrations
+	{Action: ManageMatch, Require: []Condition{
+		ActorIsActive,
+		ActorHas(PermissionManageRegistrations, ErrInsufficientRole),
+	}},
+	{Action: EditSettings, Require: []Condition{ActorHas(PermissionEditSettings, ErrMissingPermission)}},
+	// the history names every player with their roles and statuses
+	{Action: ViewHistory, Require: []Condition{ActorIsActive}},
+}
+
+var (
+	ActorIsActive = Condition{
+		Name:  "actor is active",
+		Holds: func(req Request) bool { return req.Actor.Active },
+		Err:   ErrActorNotActive,
+	}
+
+	ActorOutranksTarget = Condition{
+		Name:  "actor outranks target",
+		Holds: func(req Request) bool { return req.Actor.Role > req.Target.Role },
+		Err:   ErrInsufficientRole,
+	}
+
+	// ActorOutranksTargetOrTargetIsMember lets members holding a custom role
+	// act on other members while admins and masters stay protected.
+	ActorOutranksTargetOrTargetIsMember = Condition{
+		Name: "actor outranks target or target is member",
+		Holds: func(req Request) bool {
+			return req.Actor.Role > req.Target.Role ||
+				(req.Target.Role == RoleMember && req.Actor.UserID != req.Target.UserID)
+		},
+		Err: ErrInsufficientRole,
+	}
+
+	Self = Condition{
+		Name:  "target is the actor",
+		Holds: func(req Request) bool { return req.Actor.UserID == req.Target.UserID },
+		Err:   ErrActingForOther,
+	}
+
+	NotSelf = Condition{
+		Name:  "target is not the actor",
+		Holds: func(req Request) bool { return req.Actor.UserID != req.Target.UserID },
+		Err:   ErrSelfRoleChange,
+	}
+
+	OnlyMasterChangesMaster = Condition{
+		Name:  "only master changes master",
+		Holds: func(req Request) bool { return req.Target.Role != RoleMaster || req.Actor.Role == RoleMaster },
+		Err:   ErrOnlyMasterCanChangeMaster,
+	}
+)
+
+func ActorAtLeast(role Role) Condition {
+	return Condition{
+		Name:  "actor is at least " + role.String(),
+		Holds: func(req Request) bool { return req.Actor.Role >= role },
+		Err:   ErrInsufficientRole,
+	}
+}
+
+// ActorHas requires a permission of the actor. err is returned if the actor
+// misses it, so callers keep the errors they had before permissions existed.
+func ActorHas(permission Permission, err error) Condition {
+	return Condition{
+		Name:  "actor has " + string(permission),
+		Holds: func(req Request) bool { return req.Actor.Has(permission) },
+		Err:   err,
+	}
+}
+
+func SelfOrActorAtLeast(role Role) Condition {
+	return Condition{
+		Name: "target is the actor or actor is at least " + role.String(),
+		Holds: func(req Request) bool {
+			return req.Actor.UserID == req.Target.UserID || req.Actor.Role >= role
+		},
+		Err: ErrInsufficientRole,
+	}
+}
+
+// OnlyMasterGrants lets only a master change the role of a target to role.
+func OnlyMasterGrants(role Role, err error) Condition {
+	return Condition{
+		Name:  "only master grants " + role.String(),
+		Holds: func(req Request) bool { return req.NewRole != role || req.Actor.Role == RoleMaster },
+		Err:   err,
+	}
+}
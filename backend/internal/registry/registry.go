@@ -0,0 +1,86 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	ErrAlreadyRegistered = errors.New("type is already registered")
+	ErrNotRegistered     = errors.New("type is not registered")
+)
+
+type Registry interface {
+	Register(name string, v any) error
+	Serialize(name string, v any) ([]byte, error)
+	Deserialize(name string, data []byte) (any, error)
+}
+
+type registry struct {
+	types map[string]reflect.Type
+	mu    sync.RWMutex
+}
+
+var _ Registry = (*registry)(nil)
+
+func New() Registry {
+	return &registry{
+		types: make(map[string]reflect.Type),
+	}
+}
+
+func (r *registry) Register(name string, v any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.types[name]; exists {
+		return fmt.Errorf("register %s: %w", name, ErrAlreadyRegistered)
+	}
+
+	r.types[name] = reflect.TypeOf(v)
+
+	return nil
+}
+
+func (r *registry) Serialize(name string, v any) ([]byte, error) {
+	if _, err := r.typeOf(name); err != nil {
+		return nil, err
+	}
+
+	data, err := bson.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("serialize %s: %w", name, err)
+	}
+
+	return data, nil
+}
+
+func (r *registry) Deserialize(name string, data []byte) (any, error) {
+	typ, err := r.typeOf(name)
+	if err != nil {
+		return nil, err
+	}
+
+	value := reflect.New(typ)
+	if err := bson.Unmarshal(data, value.Interface()); err != nil {
+		return nil, fmt.Errorf("deserialize %s: %w", name, err)
+	}
+
+	return value.Elem().Interface(), nil
+}
+
+func (r *registry) typeOf(name string) (reflect.Type, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	typ, exists := r.types[name]
+	if !exists {
+		return nil, fmt.Errorf("%s: %w", name, ErrNotRegistered)
+	}
+
+	return typ, nil
+}
@@ -0,0 +1,43 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testPayload struct {
+	ID    string
+	Names []string
+}
+
+func TestRegistry_SerializeDeserialize(t *testing.T) {
+	reg := New()
+	assert.NoError(t, reg.Register("test.Payload", testPayload{}))
+
+	data, err := reg.Serialize("test.Payload", testPayload{ID: "1", Names: []string{"a", "b"}})
+	assert.NoError(t, err)
+
+	result, err := reg.Deserialize("test.Payload", data)
+	assert.NoError(t, err)
+	assert.Equal(t, testPayload{ID: "1", Names: []string{"a", "b"}}, result)
+}
+
+func TestRegistry_RegisterTwice(t *testing.T) {
+	reg := New()
+	assert.NoError(t, reg.Register("test.Payload", testPayload{}))
+
+	err := reg.Register("test.Payload", testPayload{})
+
+	assert.ErrorIs(t, err, ErrAlreadyRegistered)
+}
+
+func TestRegistry_NotRegistered(t *testing.T) {
+	reg := New()
+
+	_, err := reg.Serialize("unknown", testPayload{})
+	assert.ErrorIs(t, err, ErrNotRegistered)
+
+	_, err = reg.Deserialize("unknown", []byte{})
+	assert.ErrorIs(t, err, ErrNotRegistered)
+}
@@ -0,0 +1,21 @@
+package requestid
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// GinMiddleware takes the request id from the header of the request or
+// creates a new one and adds it to the context of the request.
+func GinMiddleware() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		requestID := context.GetHeader(Header)
+		if !valid(requestID) {
+			requestID = newID()
+		}
+
+		context.Request = context.Request.WithContext(NewContext(context.Request.Context(), requestID))
+		context.Header(Header, requestID)
+
+		context.Next()
+	}
+}
@@ -0,0 +1,50 @@
+package requestid
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataKey carries the request id in the gRPC metadata.
+const metadataKey = "x-request-id"
+
+// UnaryServerInterceptor adds the request id sent by the client to the
+// context of the call.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(metadataKey); len(values) > 0 && valid(values[0]) {
+				ctx = NewContext(ctx, values[0])
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// UnaryClientInterceptor sends the request id of the context along with the
+// call.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		fullMethod string,
+		req, reply any,
+		conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if requestID := FromContext(ctx); requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, metadataKey, requestID)
+		}
+
+		return invoker(ctx, fullMethod, req, reply, conn, opts...)
+	}
+}
+
+// DialOption passes the request id on to the server for every call of a
+// client.
+func DialOption() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(UnaryClientInterceptor())
+}
@@ -0,0 +1,69 @@
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+// Header is the HTTP header a caller may set the request id with. It is also
+// sent back in the response.
+const Header = "X-Request-ID"
+
+const (
+	// maxLength keeps callers from filling the logs with arbitrary input.
+	maxLength = 128
+	// metadataRequestID is the key of the request id in the metadata of an
+	// event.
+	metadataRequestID = "requestId"
+)
+
+type contextKey struct{}
+
+var _ ddd.ContextRestorer = RestoreContext
+
+func NewContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+// FromContext returns the request id of the context or an empty string if
+// there is none.
+func FromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKey{}).(string)
+
+	return requestID
+}
+
+// Inject stores the request id of the context in the metadata of an event, so
+// the handlers of the event log it as well.
+func Inject(ctx context.Context, metadata ddd.Metadata) {
+	if requestID := FromContext(ctx); requestID != "" {
+		metadata[metadataRequestID] = requestID
+	}
+}
+
+// Extract returns a context carrying the request id stored in the metadata of
+// an event.
+func Extract(ctx context.Context, metadata ddd.Metadata) context.Context {
+	if requestID := metadata[metadataRequestID]; requestID != "" {
+		return NewContext(ctx, requestID)
+	}
+
+	return ctx
+}
+
+// RestoreContext lets the handlers of an event log the request id of the
+// request which caused it.
+func RestoreContext(ctx context.Context, event ddd.Event) context.Context {
+	return Extract(ctx, event.Metadata())
+}
+
+func newID() string {
+	return uuid.New().String()
+}
+
+func valid(requestID string) bool {
+	return requestID != "" && len(requestID) <= maxLength
+}
@@ -0,0 +1,112 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+func TestGinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		header string
+		want   func(t *testing.T, requestID string)
+	}{
+		{
+			name:   "keeps request id of caller",
+			header: "caller-id",
+			want: func(t *testing.T, requestID string) {
+				assert.Equal(t, "caller-id", requestID)
+			},
+		},
+		{
+			name:   "creates missing request id",
+			header: "",
+			want: func(t *testing.T, requestID string) {
+				assert.Len(t, requestID, 36)
+			},
+		},
+		{
+			name:   "replaces too long request id",
+			header: strings.Repeat("a", maxLength+1),
+			want: func(t *testing.T, requestID string) {
+				assert.Len(t, requestID, 36)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fromContext string
+
+			router := gin.New()
+			router.Use(GinMiddleware())
+			router.GET("/", func(c *gin.Context) {
+				fromContext = FromContext(c.Request.Context())
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				request.Header.Set(Header, tt.header)
+			}
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			tt.want(t, fromContext)
+			assert.Equal(t, fromContext, recorder.Header().Get(Header))
+		})
+	}
+}
+
+func TestInterceptors_PassRequestIDToServer(t *testing.T) {
+	var sent metadata.MD
+
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		sent, _ = metadata.FromOutgoingContext(ctx)
+
+		return nil
+	}
+
+	ctx := NewContext(context.Background(), "request-id")
+	err := UnaryClientInterceptor()(ctx, "/grouppb.GroupService/IsActivePlayer", nil, nil, nil, invoker)
+	assert.NoError(t, err)
+
+	var received string
+
+	_, err = UnaryServerInterceptor()(
+		metadata.NewIncomingContext(context.Background(), sent),
+		nil,
+		&grpc.UnaryServerInfo{},
+		func(ctx context.Context, _ any) (any, error) {
+			received = FromContext(ctx)
+
+			return nil, nil
+		},
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "request-id", received)
+}
+
+func TestInjectExtract(t *testing.T) {
+	metadata := make(ddd.Metadata)
+
+	Inject(context.Background(), metadata)
+	assert.Empty(t, metadata)
+
+	Inject(NewContext(context.Background(), "request-id"), metadata)
+	assert.Equal(t, "request-id", metadata[metadataRequestID])
+	assert.Equal(t, "request-id", FromContext(Extract(context.Background(), metadata)))
+}
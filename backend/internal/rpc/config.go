@@ -1,10 +1,16 @@
 package rpc
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type Config struct {
 	Host string `default:"localhost"`
 	Port string `default:"8085"`
+	// ShutdownTimeout is how long in-flight calls may take to finish before
+	// the server is stopped forcefully.
+	ShutdownTimeout time.Duration
 }
 
 func (c Config) Address() string {
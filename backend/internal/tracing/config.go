@@ -0,0 +1,20 @@
+package tracing
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+type Config struct {
+	// Exporter is one of none, stdout or otlp. Spans are recorded
+	// and propagated in any case, none only drops them at the end.
+	Exporter string
+	// Endpoint is the URL of the OTLP collector, e.g. http://localhost:4318.
+	// The OTEL_EXPORTER_OTLP_* variables are used if it is empty.
+	Endpoint    string
+	ServiceName string
+	// SampleRatio is the share of new traces which are recorded. Traces
+	// started by a caller follow its decision.
+	SampleRatio float64
+}
@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+// Inject stores the trace context of ctx in the metadata of an event, so the
+// handlers of the event continue the trace which caused it.
+func Inject(ctx context.Context, metadata ddd.Metadata) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(metadata))
+}
+
+// Extract returns a context carrying the trace context stored in the metadata
+// of an event.
+func Extract(ctx context.Context, metadata ddd.Metadata) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(metadata))
+}
+
+// RestoreContext lets the handlers of an event continue the trace which
+// caused it.
+func RestoreContext(ctx context.Context, event ddd.Event) context.Context {
+	return Extract(ctx, event.Metadata())
+}
+
+// EventTracer records a span for every time a subscription of the event
+// dispatcher handles an event.
+type EventTracer struct{}
+
+var (
+	_ ddd.DispatchTracer  = EventTracer{}
+	_ ddd.ContextRestorer = RestoreContext
+)
+
+func (EventTracer) StartHandling(
+	ctx context.Context,
+	subscription string,
+	event ddd.Event,
+) (context.Context, func(err error)) {
+	attributes := []attribute.KeyValue{
+		attribute.String("event.id", event.ID()),
+		attribute.String("event.name", event.EventName()),
+		attribute.String("event.subscription", subscription),
+	}
+
+	if aggregateEvent, ok := event.(ddd.AggregateEvent); ok {
+		attributes = append(attributes,
+			attribute.String("event.aggregate_id", aggregateEvent.AggregateID()),
+			attribute.String("event.aggregate_name", aggregateEvent.AggregateName()),
+		)
+	}
+
+	ctx, span := otel.Tracer(instrumentationName).Start(
+		ctx,
+		"handle "+event.EventName(),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attributes...),
+	)
+
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		span.End()
+	}
+}
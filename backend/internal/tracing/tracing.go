@@ -0,0 +1,120 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/FSpruhs/kick-app/backend"
+	shutdownTimeout     = 5 * time.Second
+)
+
+var ErrUnknownExporter = errors.New("unknown trace exporter")
+
+// Provider records the spans of the monolith and hands them to the configured
+// exporter. It is registered globally, so the instrumentation of gin, gRPC,
+// Mongo and the event dispatcher picks it up without passing it around.
+type Provider struct {
+	provider *sdktrace.TracerProvider
+	exporter sdktrace.SpanExporter
+}
+
+type Option func(p *Provider)
+
+// ExportTo hands every span to exporter as soon as it ends instead of the
+// exporter of the config, e.g. to look at the spans in tests.
+func ExportTo(exporter sdktrace.SpanExporter) Option {
+	return func(p *Provider) {
+		p.exporter = exporter
+	}
+}
+
+func New(ctx context.Context, cfg Config, opts ...Option) (*Provider, error) {
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating trace resource: %w", err)
+	}
+
+	provider := &Provider{}
+	for _, opt := range opts {
+		opt(provider)
+	}
+
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+
+	switch {
+	case provider.exporter != nil:
+		options = append(options, sdktrace.WithSyncer(provider.exporter))
+	case cfg.Exporter == ExporterNone, cfg.Exporter == "":
+	case cfg.Exporter == ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("creating stdout trace exporter: %w", err)
+		}
+
+		options = append(options, sdktrace.WithBatcher(exporter))
+	case cfg.Exporter == ExporterOTLP:
+		var otlpOptions []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			otlpOptions = append(otlpOptions, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+		}
+
+		exporter, err := otlptracehttp.New(ctx, otlpOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("creating otlp trace exporter: %w", err)
+		}
+
+		options = append(options, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("%s: %w", cfg.Exporter, ErrUnknownExporter)
+	}
+
+	provider.provider = sdktrace.NewTracerProvider(options...)
+
+	otel.SetTracerProvider(provider.provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider, nil
+}
+
+// Start waits until the context is done and then flushes the spans which
+// were not exported yet.
+func (p *Provider) Start(ctx context.Context) error {
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := p.provider.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutting down tracer provider: %w", err)
+	}
+
+	return nil
+}
+
+// StartSpan starts a span as child of the span in the context, e.g. for a
+// repository call. The span has to be ended by the caller.
+func StartSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name)
+}
@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+func newMemoryExporter(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+
+	_, err := New(context.Background(), Config{ServiceName: "test", SampleRatio: 1}, ExportTo(exporter))
+	require.NoError(t, err)
+
+	return exporter
+}
+
+func TestNew_UnknownExporter(t *testing.T) {
+	_, err := New(context.Background(), Config{Exporter: "zipkin"})
+
+	assert.ErrorIs(t, err, ErrUnknownExporter)
+}
+
+func TestInjectExtract(t *testing.T) {
+	newMemoryExporter(t)
+
+	ctx, span := StartSpan(context.Background(), "origin")
+	defer span.End()
+
+	metadata := make(ddd.Metadata)
+	Inject(ctx, metadata)
+
+	assert.Contains(t, metadata, "traceparent")
+
+	extracted := trace.SpanContextFromContext(Extract(context.Background(), metadata))
+	assert.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+}
+
+func TestEventTracer_ContinuesTraceOfEvent(t *testing.T) {
+	exporter := newMemoryExporter(t)
+
+	ctx, origin := StartSpan(context.Background(), "origin")
+	event := ddd.RestoreAggregateEvent("event-id", "group.GroupCreated", nil, nil, time.Now(), "group-id", "group.Group", 1)
+	Inject(ctx, event.Metadata())
+	origin.End()
+
+	dispatcher := ddd.NewEventDispatcher[ddd.AggregateEvent](ddd.Trace(EventTracer{}), ddd.RestoreContext(RestoreContext))
+	dispatcher.Subscribe("group.GroupCreated", ddd.EventHandlerFunc[ddd.AggregateEvent](
+		func(context.Context, ddd.AggregateEvent) error {
+			return errors.New("some error")
+		},
+	))
+
+	assert.Error(t, dispatcher.Publish(context.Background(), event))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	handled := spans[1]
+	assert.Equal(t, "handle group.GroupCreated", handled.Name)
+	assert.Equal(t, trace.SpanKindConsumer, handled.SpanKind)
+	assert.Equal(t, origin.SpanContext().TraceID(), handled.SpanContext.TraceID())
+	assert.Equal(t, origin.SpanContext().SpanID(), handled.Parent.SpanID())
+	assert.Equal(t, codes.Error, handled.Status.Code)
+}
+
+func TestGinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	exporter := newMemoryExporter(t)
+	router := gin.New()
+	router.Use(GinMiddleware("test"))
+	router.GET("/group/:groupId", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+	router.GET("/healthz", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	request := httptest.NewRequest(http.MethodGet, "/group/1", nil)
+	request.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+	router.ServeHTTP(httptest.NewRecorder(), request)
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "/group/:groupId", spans[0].Name)
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", spans[0].SpanContext.TraceID().String())
+}
@@ -1,8 +1,12 @@
 package application
 
 import (
+	"context"
+
 	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/queries"
 	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
 )
 
@@ -12,13 +16,23 @@ type App interface {
 }
 
 type Commands interface {
-	CreateMatch(cmd *commands.CreateMatch) (*domain.Match, error)
-	RespondToInvitation(cmd *commands.RespondToInvitation) error
-	AddRegistration(cmd *commands.AddRegistration) error
-	RemoveRegistration(cmd *commands.RemoveRegistration) error
+	CreateMatch(ctx context.Context, cmd *commands.CreateMatch) (*domain.Match, error)
+	RespondToInvitation(ctx context.Context, cmd *commands.RespondToInvitation) error
+	AddRegistration(ctx context.Context, cmd *commands.AddRegistration) error
+	RemoveRegistration(ctx context.Context, cmd *commands.RemoveRegistration) error
+	CancelMatch(ctx context.Context, cmd *commands.CancelMatch) error
+	RescheduleMatch(ctx context.Context, cmd *commands.RescheduleMatch) error
+	RelocateMatch(ctx context.Context, cmd *commands.RelocateMatch) error
+	FinishMatch(ctx context.Context, cmd *commands.FinishMatch) error
+	EnterResult(ctx context.Context, cmd *commands.EnterResult) error
+	SelectPlayers(ctx context.Context, cmd *commands.SelectPlayers) error
 }
 
-type Queries interface{}
+type Queries interface {
+	GetMatch(ctx context.Context, cmd *queries.GetMatch) (*domain.MatchDetails, error)
+	GetMatchesByGroup(ctx context.Context, cmd *queries.GetMatchesByGroup) (pagination.Page[*domain.Match], error)
+	GetUpcomingMatches(ctx context.Context, cmd *queries.GetUpcomingMatches) (pagination.Page[*domain.Match], error)
+}
 
 type Application struct {
 	appCommands
@@ -30,24 +44,45 @@ type appCommands struct {
 	commands.RespondToInvitationHandler
 	commands.AddRegistrationHandler
 	commands.RemoveRegistrationHandler
+	commands.CancelMatchHandler
+	commands.RescheduleMatchHandler
+	commands.RelocateMatchHandler
+	commands.FinishMatchHandler
+	commands.EnterResultHandler
+	commands.SelectPlayersHandler
 }
 
-type appQueries struct{}
+type appQueries struct {
+	queries.GetMatchHandler
+	queries.GetMatchesByGroupHandler
+	queries.GetUpcomingMatchesHandler
+}
 
 var _ App = (*Application)(nil)
 
 func New(
 	matches domain.MatchRepository,
 	groups domain.GroupRepository,
+	users domain.UserRepository,
 	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
 ) *Application {
 	return &Application{
 		appCommands: appCommands{
 			CreateMatchHandler:         commands.NewCreateMatchHandler(matches, groups, eventPublisher),
-			RespondToInvitationHandler: commands.NewRespondToInvitationHandler(matches, groups),
+			RespondToInvitationHandler: commands.NewRespondToInvitationHandler(matches, groups, eventPublisher),
 			AddRegistrationHandler:     commands.NewAddRegistrationHandler(matches, groups),
-			RemoveRegistrationHandler:  commands.NewRemoveRegistrationHandler(matches, groups),
+			RemoveRegistrationHandler:  commands.NewRemoveRegistrationHandler(matches, groups, eventPublisher),
+			CancelMatchHandler:         commands.NewCancelMatchHandler(matches, groups, eventPublisher),
+			RescheduleMatchHandler:     commands.NewRescheduleMatchHandler(matches, groups, eventPublisher),
+			RelocateMatchHandler:       commands.NewRelocateMatchHandler(matches, groups, eventPublisher),
+			FinishMatchHandler:         commands.NewFinishMatchHandler(matches, groups, eventPublisher),
+			EnterResultHandler:         commands.NewEnterResultHandler(matches, groups, eventPublisher),
+			SelectPlayersHandler:       commands.NewSelectPlayersHandler(matches, eventPublisher),
+		},
+		appQueries: appQueries{
+			GetMatchHandler:           queries.NewGetMatchHandler(matches, groups, users),
+			GetMatchesByGroupHandler:  queries.NewGetMatchesByGroupHandler(matches, groups),
+			GetUpcomingMatchesHandler: queries.NewGetUpcomingMatchesHandler(matches, groups),
 		},
-		appQueries: appQueries{},
 	}
 }
@@ -1,8 +1,11 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
 	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
 )
 
@@ -24,29 +27,33 @@ func NewAddRegistrationHandler(
 	return AddRegistrationHandler{matches, groups}
 }
 
-func (h AddRegistrationHandler) AddRegistration(cmd *AddRegistration) error {
-
-	match, err := h.MatchRepository.FindByID(cmd.MatchID)
-	if err != nil {
-		return fmt.Errorf("finding match: %w", err)
-	}
-
-	result, err := h.HasPlayerAdminRole(cmd.AddingUserID, match.GroupID())
-	if err != nil {
-		return fmt.Errorf("checking if player has admin role: %w", err)
-	}
-
-	if !result {
-		return fmt.Errorf("player does not have admin role")
-	}
-
-	if err := match.AddRegistration(cmd.UserID); err != nil {
-		return fmt.Errorf("adding registration: %w", err)
-	}
-
-	if err := h.MatchRepository.Save(match); err != nil {
-		return fmt.Errorf("saving match: %w", err)
-	}
-
-	return nil
+func (h AddRegistrationHandler) AddRegistration(ctx context.Context, cmd *AddRegistration) error {
+	return ddd.RetryOnConflict(conflictAttempts, func() error {
+		match, err := h.MatchRepository.FindByID(ctx, cmd.MatchID)
+		if err != nil {
+			return fmt.Errorf("finding match: %w", err)
+		}
+
+		if err := authorize(
+			ctx,
+			h.GroupRepository,
+			policy.ManageRegistrations,
+			cmd.AddingUserID,
+			cmd.UserID,
+			match.GroupID(),
+			policy.Resource{Kind: policy.MatchResource, ID: match.ID()},
+		); err != nil {
+			return err
+		}
+
+		if err := match.AddRegistration(cmd.UserID); err != nil {
+			return fmt.Errorf("adding registration: %w", err)
+		}
+
+		if err := h.MatchRepository.Save(ctx, match); err != nil {
+			return fmt.Errorf("saving match: %w", err)
+		}
+
+		return nil
+	})
 }
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+// subject resolves the user in the group with one call to the group module.
+// What the user may do is decided by the permissions of the roles the group
+// gave them.
+func subject(ctx context.Context, groups domain.GroupRepository, userID, groupID string) (policy.Subject, error) {
+	player, err := groups.GetPlayer(ctx, userID, groupID)
+	if err != nil {
+		return policy.Subject{}, fmt.Errorf("getting player: %w", err)
+	}
+
+	role, err := policy.ToRole(player.Role)
+	if err != nil {
+		return policy.Subject{}, fmt.Errorf("reading player role: %w", err)
+	}
+
+	permissions, err := policy.ToPermissions(player.Permissions)
+	if err != nil {
+		return policy.Subject{}, fmt.Errorf("reading player permissions: %w", err)
+	}
+
+	return policy.Subject{UserID: userID, Role: role, Active: player.Active, Permissions: permissions}, nil
+}
+
+func authorize(
+	ctx context.Context,
+	groups domain.GroupRepository,
+	action policy.Action,
+	actorID, targetID, groupID string,
+	resource policy.Resource,
+) error {
+	actor, err := subject(ctx, groups, actorID, groupID)
+	if err != nil {
+		return err
+	}
+
+	if err := policy.Authorize(ctx, policy.Request{
+		Actor:    actor,
+		Action:   action,
+		Resource: resource,
+		Target:   policy.Subject{UserID: targetID},
+	}); err != nil {
+		return fmt.Errorf("authorizing %s: %w", action, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type fakeGroups struct {
+	domain.GroupRepository
+	players map[string]*domain.GroupPlayer
+	calls   int
+}
+
+func (f *fakeGroups) GetPlayer(_ context.Context, userID, _ string) (*domain.GroupPlayer, error) {
+	f.calls++
+
+	if player, ok := f.players[userID]; ok {
+		return player, nil
+	}
+
+	return &domain.GroupPlayer{Role: policy.RoleMember.String()}, nil
+}
+
+func TestSubject(t *testing.T) {
+	groups := &fakeGroups{players: map[string]*domain.GroupPlayer{
+		"user-1": {Active: true, Role: "master", Permissions: []string{string(policy.PermissionManageRegistrations)}},
+	}}
+
+	actor, err := subject(context.Background(), groups, "user-1", "group-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, groups.calls)
+	assert.Equal(t, policy.Subject{
+		UserID:      "user-1",
+		Role:        policy.RoleMaster,
+		Active:      true,
+		Permissions: []policy.Permission{policy.PermissionManageRegistrations},
+	}, actor)
+}
+
+func TestSubject_UnknownRole(t *testing.T) {
+	groups := &fakeGroups{players: map[string]*domain.GroupPlayer{"user-1": {Active: true, Role: "owner"}}}
+
+	_, err := subject(context.Background(), groups, "user-1", "group-1")
+
+	assert.ErrorIs(t, err, policy.ErrUnknownRole)
+}
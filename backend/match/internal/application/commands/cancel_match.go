@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type CancelMatch struct {
+	UserID  string
+	MatchID string
+}
+
+type CancelMatchHandler struct {
+	domain.MatchRepository
+	domain.GroupRepository
+	ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewCancelMatchHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) CancelMatchHandler {
+	return CancelMatchHandler{matches, groups, eventPublisher}
+}
+
+func (h CancelMatchHandler) CancelMatch(ctx context.Context, cmd *CancelMatch) error {
+	return manageMatch(
+		ctx,
+		h.MatchRepository,
+		h.GroupRepository,
+		h.EventPublisher,
+		cmd.UserID,
+		cmd.MatchID,
+		func(match *domain.Match) error {
+			if err := match.Cancel(); err != nil {
+				return fmt.Errorf("cancelling match: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
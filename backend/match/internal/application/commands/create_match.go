@@ -1,19 +1,25 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
 	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
 )
 
+// CreateMatch selects the players by the priority of the group unless a
+// Priority is given.
 type CreateMatch struct {
 	UserID      string
 	GroupID     string
 	Begin       time.Time
 	Location    *domain.Location
 	PlayerCount *domain.PlayerCount
+	Priority    string
+	Cutoff      time.Duration
 }
 
 type CreateMatchHandler struct {
@@ -30,24 +36,60 @@ func NewCreateMatchHandler(
 	return CreateMatchHandler{matches, groups, eventPublisher}
 }
 
-func (h CreateMatchHandler) CreateMatch(cmd *CreateMatch) (*domain.Match, error) {
-	isPlayerActive, err := h.IsPlayerActive(cmd.UserID, cmd.GroupID)
-	if err != nil || !isPlayerActive {
-		return nil, fmt.Errorf("checking if player is active: %w", err)
+func (h CreateMatchHandler) CreateMatch(ctx context.Context, cmd *CreateMatch) (*domain.Match, error) {
+	if err := authorize(
+		ctx,
+		h.GroupRepository,
+		policy.CreateMatch,
+		cmd.UserID,
+		"",
+		cmd.GroupID,
+		policy.Resource{Kind: policy.GroupResource, ID: cmd.GroupID},
+	); err != nil {
+		return nil, err
 	}
 
-	match, err := domain.CreateNewMatch(cmd.Begin, cmd.Location, cmd.PlayerCount, cmd.GroupID)
+	selection, err := h.selection(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := domain.CreateNewMatch(cmd.Begin, cmd.Location, cmd.PlayerCount, selection, cmd.GroupID)
 	if err != nil {
 		return nil, fmt.Errorf("creating match: %w", err)
 	}
 
-	if err := h.MatchRepository.Save(match); err != nil {
+	if err := h.MatchRepository.Save(ctx, match); err != nil {
 		return nil, fmt.Errorf("saving match: %w", err)
 	}
 
-	if err := h.EventPublisher.Publish(match.Events()...); err != nil {
+	if err := h.EventPublisher.Publish(ctx, match.Events()...); err != nil {
 		return nil, fmt.Errorf("publishing match created event: %w", err)
 	}
 
 	return match, nil
 }
+
+func (h CreateMatchHandler) selection(ctx context.Context, cmd *CreateMatch) (*domain.Selection, error) {
+	name := cmd.Priority
+	if name == "" {
+		groupPriority, err := h.GroupRepository.GetMatchPriority(ctx, cmd.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("getting match priority of group: %w", err)
+		}
+
+		name = groupPriority
+	}
+
+	priority, err := domain.ToPriority(name)
+	if err != nil {
+		return nil, fmt.Errorf("creating priority %s: %w", name, err)
+	}
+
+	selection, err := domain.NewSelection(priority, cmd.Cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("creating selection: %w", err)
+	}
+
+	return selection, nil
+}
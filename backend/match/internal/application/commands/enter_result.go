@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type EnterResult struct {
+	UserID  string
+	MatchID string
+	Result  *domain.Result
+}
+
+type EnterResultHandler struct {
+	domain.MatchRepository
+	domain.GroupRepository
+	ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewEnterResultHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) EnterResultHandler {
+	return EnterResultHandler{matches, groups, eventPublisher}
+}
+
+func (h EnterResultHandler) EnterResult(ctx context.Context, cmd *EnterResult) error {
+	return manageMatch(
+		ctx,
+		h.MatchRepository,
+		h.GroupRepository,
+		h.EventPublisher,
+		cmd.UserID,
+		cmd.MatchID,
+		func(match *domain.Match) error {
+			if err := match.EnterResult(cmd.Result); err != nil {
+				return fmt.Errorf("entering result of match: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type FinishMatch struct {
+	UserID  string
+	MatchID string
+}
+
+type FinishMatchHandler struct {
+	domain.MatchRepository
+	domain.GroupRepository
+	ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewFinishMatchHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) FinishMatchHandler {
+	return FinishMatchHandler{matches, groups, eventPublisher}
+}
+
+// FinishMatch marks the match as played.
+func (h FinishMatchHandler) FinishMatch(ctx context.Context, cmd *FinishMatch) error {
+	return manageMatch(
+		ctx,
+		h.MatchRepository,
+		h.GroupRepository,
+		h.EventPublisher,
+		cmd.UserID,
+		cmd.MatchID,
+		func(match *domain.Match) error {
+			if err := match.Finish(); err != nil {
+				return fmt.Errorf("finishing match: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+// manageMatch changes the lifecycle of a match on behalf of the user and
+// publishes the events, so the registered players get notified.
+func manageMatch(
+	ctx context.Context,
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
+	userID, matchID string,
+	change func(match *domain.Match) error,
+) error {
+	var match *domain.Match
+
+	if err := ddd.RetryOnConflict(conflictAttempts, func() error {
+		var err error
+
+		match, err = matches.FindByID(ctx, matchID)
+		if err != nil {
+			return fmt.Errorf("getting match: %w", err)
+		}
+
+		if err := authorize(
+			ctx,
+			groups,
+			policy.ManageMatch,
+			userID,
+			"",
+			match.GroupID(),
+			policy.Resource{Kind: policy.MatchResource, ID: match.ID()},
+		); err != nil {
+			return err
+		}
+
+		if err := change(match); err != nil {
+			return err
+		}
+
+		if err := matches.Save(ctx, match); err != nil {
+			return fmt.Errorf("saving match: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := eventPublisher.Publish(ctx, match.Events()...); err != nil {
+		return fmt.Errorf("publishing match events: %w", err)
+	}
+
+	return nil
+}
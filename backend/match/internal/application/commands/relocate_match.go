@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type RelocateMatch struct {
+	UserID   string
+	MatchID  string
+	Location *domain.Location
+}
+
+type RelocateMatchHandler struct {
+	domain.MatchRepository
+	domain.GroupRepository
+	ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewRelocateMatchHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) RelocateMatchHandler {
+	return RelocateMatchHandler{matches, groups, eventPublisher}
+}
+
+func (h RelocateMatchHandler) RelocateMatch(ctx context.Context, cmd *RelocateMatch) error {
+	return manageMatch(
+		ctx,
+		h.MatchRepository,
+		h.GroupRepository,
+		h.EventPublisher,
+		cmd.UserID,
+		cmd.MatchID,
+		func(match *domain.Match) error {
+			if err := match.Relocate(cmd.Location); err != nil {
+				return fmt.Errorf("relocating match: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
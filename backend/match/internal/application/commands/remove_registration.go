@@ -1,8 +1,11 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
 	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
 )
 
@@ -13,33 +16,58 @@ type RemoveRegistration struct {
 }
 
 type RemoveRegistrationHandler struct {
-	matches domain.MatchRepository
-	groups  domain.GroupRepository
+	matches        domain.MatchRepository
+	groups         domain.GroupRepository
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent]
 }
 
-func NewRemoveRegistrationHandler(matches domain.MatchRepository, groups domain.GroupRepository) RemoveRegistrationHandler {
-	return RemoveRegistrationHandler{matches, groups}
+func NewRemoveRegistrationHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) RemoveRegistrationHandler {
+	return RemoveRegistrationHandler{matches, groups, eventPublisher}
 }
 
-func (h RemoveRegistrationHandler) RemoveRegistration(cmd *RemoveRegistration) error {
-	match, err := h.matches.FindByID(cmd.MatchID)
-	if err != nil {
-		return fmt.Errorf("getting match: %w", err)
-	}
+func (h RemoveRegistrationHandler) RemoveRegistration(ctx context.Context, cmd *RemoveRegistration) error {
+	var match *domain.Match
 
-	result, err := h.groups.HasPlayerAdminRole(cmd.RemovingUserID, match.GroupID())
-	if err != nil {
-		return fmt.Errorf("checking if player has admin role: %w", err)
-	}
+	if err := ddd.RetryOnConflict(conflictAttempts, func() error {
+		var err error
 
-	if !result {
-		return fmt.Errorf("player does not have admin role")
-	}
+		match, err = h.matches.FindByID(ctx, cmd.MatchID)
+		if err != nil {
+			return fmt.Errorf("getting match: %w", err)
+		}
+
+		if err := authorize(
+			ctx,
+			h.groups,
+			policy.ManageRegistrations,
+			cmd.RemovingUserID,
+			cmd.UserID,
+			match.GroupID(),
+			policy.Resource{Kind: policy.MatchResource, ID: match.ID()},
+		); err != nil {
+			return err
+		}
 
-	match.RemoveRegistration(cmd.UserID)
+		if err := match.RemoveRegistration(cmd.UserID); err != nil {
+			return fmt.Errorf("removing registration: %w", err)
+		}
+
+		if err := h.matches.Save(ctx, match); err != nil {
+			return fmt.Errorf("saving match: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
 
-	if err := h.matches.Save(match); err != nil {
-		return fmt.Errorf("saving match: %w", err)
+	// the removed player may make room for one from the bench
+	if err := h.eventPublisher.Publish(ctx, match.Events()...); err != nil {
+		return fmt.Errorf("publishing match events: %w", err)
 	}
 
 	return nil
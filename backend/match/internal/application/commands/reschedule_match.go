@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type RescheduleMatch struct {
+	UserID  string
+	MatchID string
+	Begin   time.Time
+}
+
+type RescheduleMatchHandler struct {
+	domain.MatchRepository
+	domain.GroupRepository
+	ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewRescheduleMatchHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) RescheduleMatchHandler {
+	return RescheduleMatchHandler{matches, groups, eventPublisher}
+}
+
+func (h RescheduleMatchHandler) RescheduleMatch(ctx context.Context, cmd *RescheduleMatch) error {
+	return manageMatch(
+		ctx,
+		h.MatchRepository,
+		h.GroupRepository,
+		h.EventPublisher,
+		cmd.UserID,
+		cmd.MatchID,
+		func(match *domain.Match) error {
+			if err := match.Reschedule(cmd.Begin); err != nil {
+				return fmt.Errorf("rescheduling match: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
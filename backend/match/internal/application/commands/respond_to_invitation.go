@@ -1,9 +1,11 @@
 package commands
 
 import (
-	"errors"
+	"context"
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
 	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
 )
 
@@ -17,35 +19,56 @@ type RespondToInvitation struct {
 type RespondToInvitationHandler struct {
 	domain.MatchRepository
 	domain.GroupRepository
+	ddd.EventPublisher[ddd.AggregateEvent]
 }
 
-func NewRespondToInvitationHandler(matches domain.MatchRepository, groups domain.GroupRepository) RespondToInvitationHandler {
-	return RespondToInvitationHandler{matches, groups}
+func NewRespondToInvitationHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) RespondToInvitationHandler {
+	return RespondToInvitationHandler{matches, groups, eventPublisher}
 }
 
-func (h RespondToInvitationHandler) RespondToInvitation(cmd *RespondToInvitation) error {
-	if cmd.PlayerID != cmd.RespondingPlayerID {
-		return errors.New("player is not responding to the invitation")
-	}
+func (h RespondToInvitationHandler) RespondToInvitation(ctx context.Context, cmd *RespondToInvitation) error {
+	var match *domain.Match
 
-	match, err := h.MatchRepository.FindByID(cmd.MatchID)
-	if err != nil {
-		return fmt.Errorf("failed to find match: %w", err)
-	}
+	if err := ddd.RetryOnConflict(conflictAttempts, func() error {
+		var err error
 
-	result, err := h.GroupRepository.IsPlayerActive(cmd.PlayerID, match.GroupID())
-	if err != nil {
-		return fmt.Errorf("failed to check if player is active: %w", err)
-	}
+		match, err = h.MatchRepository.FindByID(ctx, cmd.MatchID)
+		if err != nil {
+			return fmt.Errorf("failed to find match: %w", err)
+		}
 
-	if !result {
-		return errors.New("player is not active")
-	}
+		if err := authorize(
+			ctx,
+			h.GroupRepository,
+			policy.RespondToInvitation,
+			cmd.RespondingPlayerID,
+			cmd.PlayerID,
+			match.GroupID(),
+			policy.Resource{Kind: policy.MatchResource, ID: match.ID()},
+		); err != nil {
+			return err
+		}
 
-	match.RespondToInvitation(cmd.PlayerID, cmd.Accept)
+		if err := match.RespondToInvitation(cmd.PlayerID, cmd.Accept); err != nil {
+			return fmt.Errorf("responding to invitation: %w", err)
+		}
+
+		if err := h.MatchRepository.Save(ctx, match); err != nil {
+			return fmt.Errorf("saving match after respond to invitation: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
 
-	if err := h.MatchRepository.Save(match); err != nil {
-		return fmt.Errorf("saving match after respond to invitation: %w", err)
+	// a player leaving may promote one from the bench
+	if err := h.EventPublisher.Publish(ctx, match.Events()...); err != nil {
+		return fmt.Errorf("publishing match events: %w", err)
 	}
 
 	return nil
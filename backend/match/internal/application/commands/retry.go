@@ -0,0 +1,5 @@
+package commands
+
+// conflictAttempts bounds how often a command is retried when the match was
+// modified concurrently.
+const conflictAttempts = 3
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+// historySize bounds how many of the previous matches of a group make up the
+// history of its players.
+const historySize = 10
+
+// SelectPlayers is issued by the system once the cutoff of a match passed, so
+// it is not authorized on behalf of a user.
+type SelectPlayers struct {
+	MatchID string
+}
+
+type SelectPlayersHandler struct {
+	domain.MatchRepository
+	ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewSelectPlayersHandler(
+	matches domain.MatchRepository,
+	eventPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) SelectPlayersHandler {
+	return SelectPlayersHandler{matches, eventPublisher}
+}
+
+func (h SelectPlayersHandler) SelectPlayers(ctx context.Context, cmd *SelectPlayers) error {
+	var match *domain.Match
+
+	if err := ddd.RetryOnConflict(conflictAttempts, func() error {
+		var err error
+
+		match, err = h.MatchRepository.FindByID(ctx, cmd.MatchID)
+		if err != nil {
+			return fmt.Errorf("getting match: %w", err)
+		}
+
+		history, err := h.history(ctx, match)
+		if err != nil {
+			return err
+		}
+
+		if err := match.SelectPlayers(history); err != nil {
+			return err
+		}
+
+		if err := h.MatchRepository.Save(ctx, match); err != nil {
+			return fmt.Errorf("saving match: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := h.EventPublisher.Publish(ctx, match.Events()...); err != nil {
+		return fmt.Errorf("publishing players selected event: %w", err)
+	}
+
+	return nil
+}
+
+// history is made of the latest matches of the group which took place before
+// the match.
+func (h SelectPlayersHandler) history(ctx context.Context, match *domain.Match) (*domain.PlayerHistory, error) {
+	previous, err := h.MatchRepository.FindAllByGroupIDs(ctx, []string{match.GroupID()}, pagination.Request{
+		Limit: historySize,
+		Sort:  pagination.Sort{Field: "begin", Descending: true},
+		Filters: []pagination.Filter{
+			{Field: "begin", Operator: pagination.Less, Value: match.Begin()},
+			{Field: "status", Operator: pagination.NotEqual, Value: string(domain.Planned)},
+			{Field: "status", Operator: pagination.NotEqual, Value: string(domain.Cancelled)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting previous matches: %w", err)
+	}
+
+	return domain.NewPlayerHistory(previous.Items), nil
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/match/internal/memory"
+)
+
+type noopPublisher struct{}
+
+func (noopPublisher) Publish(context.Context, ...ddd.AggregateEvent) error {
+	return nil
+}
+
+type failingPublisher struct {
+	calls int
+}
+
+func (p *failingPublisher) Publish(context.Context, ...ddd.AggregateEvent) error {
+	p.calls++
+
+	return errors.New("some error")
+}
+
+func saveMatch(
+	t *testing.T,
+	matches domain.MatchRepository,
+	begin time.Time,
+	status domain.MatchStatus,
+	registrations ...*domain.Registration,
+) *domain.Match {
+	t.Helper()
+
+	location, err := domain.NewLocation("Stadium")
+	require.NoError(t, err)
+
+	playerCount, err := domain.NewPlayerCount(1, 2)
+	require.NoError(t, err)
+
+	selection, err := domain.NewSelection(domain.AttendanceBased, 2*time.Hour)
+	require.NoError(t, err)
+
+	match := domain.NewMatch(
+		uuid.New().String(),
+		"group-1",
+		begin,
+		location,
+		playerCount,
+		selection,
+		false,
+		nil,
+		status,
+		nil,
+		registrations,
+	)
+	require.NoError(t, matches.Save(context.Background(), match))
+
+	return match
+}
+
+func TestSelectPlayersHandler_SelectPlayers(t *testing.T) {
+	ctx := context.Background()
+	matches := memory.NewMatchRepository()
+	now := time.Now()
+
+	played := func(userIDs ...string) []*domain.Registration {
+		registrations := make([]*domain.Registration, len(userIDs))
+		for i, userID := range userIDs {
+			registrations[i] = domain.NewRegistration(userID, domain.Registered, now)
+		}
+
+		return registrations
+	}
+
+	// user-3 is the only regular, matches not played do not count
+	saveMatch(t, matches, now.Add(-48*time.Hour), domain.Finished, played("user-3")...)
+	saveMatch(t, matches, now.Add(-24*time.Hour), domain.ResultEntered, played("user-3")...)
+	saveMatch(t, matches, now.Add(-12*time.Hour), domain.Cancelled, played("user-1", "user-2")...)
+	match := saveMatch(t, matches, now.Add(time.Hour), domain.Planned,
+		domain.NewRegistration("user-1", domain.Registered, now),
+		domain.NewRegistration("user-2", domain.Registered, now.Add(time.Second)),
+		domain.NewRegistration("user-3", domain.Benched, now.Add(2*time.Second)),
+	)
+
+	handler := NewSelectPlayersHandler(matches, noopPublisher{})
+
+	require.NoError(t, handler.SelectPlayers(ctx, &SelectPlayers{MatchID: match.ID()}))
+
+	selected, err := matches.FindByID(ctx, match.ID())
+	require.NoError(t, err)
+	assert.True(t, selected.Selected())
+	assert.Equal(t, []string{"user-2"}, selected.Bench())
+
+	err = handler.SelectPlayers(ctx, &SelectPlayers{MatchID: match.ID()})
+	assert.ErrorIs(t, err, domain.ErrAlreadySelected)
+}
+
+func TestSelectPlayersHandler_SelectPlayersPublishesOnceSaved(t *testing.T) {
+	ctx := context.Background()
+	matches := memory.NewMatchRepository()
+	match := saveMatch(t, matches, time.Now().Add(time.Hour), domain.Planned,
+		domain.NewRegistration("user-1", domain.Registered, time.Now()),
+	)
+	publisher := &failingPublisher{}
+
+	err := NewSelectPlayersHandler(matches, publisher).SelectPlayers(ctx, &SelectPlayers{MatchID: match.ID()})
+
+	// a failing publish does not run the selection again
+	assert.Error(t, err)
+	assert.Equal(t, 1, publisher.calls)
+
+	selected, err := matches.FindByID(ctx, match.ID())
+	require.NoError(t, err)
+	assert.True(t, selected.Selected())
+}
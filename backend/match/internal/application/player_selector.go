@@ -0,0 +1,83 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+const defaultSelectInterval = time.Minute
+
+type PlayerSelectorOption func(s *PlayerSelector)
+
+func SelectInterval(interval time.Duration) PlayerSelectorOption {
+	return func(s *PlayerSelector) {
+		s.interval = interval
+	}
+}
+
+func SelectorLogger(logger *slog.Logger) PlayerSelectorOption {
+	return func(s *PlayerSelector) {
+		s.logger = logger
+	}
+}
+
+// PlayerSelector selects the players of the matches whose cutoff passed. A
+// match failing to select is tried again on the next run.
+type PlayerSelector struct {
+	matches  domain.MatchRepository
+	app      Commands
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewPlayerSelector(matches domain.MatchRepository, app Commands, options ...PlayerSelectorOption) *PlayerSelector {
+	selector := &PlayerSelector{
+		matches:  matches,
+		app:      app,
+		interval: defaultSelectInterval,
+		logger:   slog.Default(),
+	}
+
+	for _, option := range options {
+		option(selector)
+	}
+
+	return selector
+}
+
+func (s *PlayerSelector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		s.selectDue(ctx)
+	}
+}
+
+func (s *PlayerSelector) selectDue(ctx context.Context) {
+	ids, err := s.matches.FindDueForSelection(ctx, time.Now())
+	if err != nil {
+		s.logger.ErrorContext(ctx, "finding matches due for selection failed", slog.Any("error", err))
+
+		return
+	}
+
+	for _, id := range ids {
+		err := s.app.SelectPlayers(ctx, &commands.SelectPlayers{MatchID: id})
+		// another instance may have selected the players in the meantime
+		if err != nil && !errors.Is(err, domain.ErrAlreadySelected) {
+			s.logger.ErrorContext(ctx, "selecting players failed", slog.String("match", id), slog.Any("error", err))
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+// authorizeView lets only active players of the group see its matches.
+func authorizeView(ctx context.Context, groups domain.GroupRepository, userID, groupID string) error {
+	active, err := groups.IsPlayerActive(ctx, userID, groupID)
+	if err != nil {
+		return fmt.Errorf("checking if player is active: %w", err)
+	}
+
+	if err := policy.Authorize(ctx, policy.Request{
+		Actor:    policy.Subject{UserID: userID, Role: policy.RoleMember, Active: active},
+		Action:   policy.ViewMatches,
+		Resource: policy.Resource{Kind: policy.GroupResource, ID: groupID},
+	}); err != nil {
+		return fmt.Errorf("authorizing %s: %w", policy.ViewMatches, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type GetMatch struct {
+	MatchID string
+	UserID  string
+}
+
+type GetMatchHandler struct {
+	domain.MatchRepository
+	domain.GroupRepository
+	domain.UserRepository
+}
+
+func NewGetMatchHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+	users domain.UserRepository,
+) GetMatchHandler {
+	return GetMatchHandler{matches, groups, users}
+}
+
+func (h GetMatchHandler) GetMatch(ctx context.Context, cmd *GetMatch) (*domain.MatchDetails, error) {
+	match, err := h.MatchRepository.FindByID(ctx, cmd.MatchID)
+	if err != nil {
+		return nil, fmt.Errorf("getting match %s: %w", cmd.MatchID, err)
+	}
+
+	if err := authorizeView(ctx, h.GroupRepository, cmd.UserID, match.GroupID()); err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, len(match.Registrations()))
+	for i, registration := range match.Registrations() {
+		userIDs[i] = registration.UserID()
+	}
+
+	users, err := h.UserRepository.GetUserAll(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getting users %s: %w", userIDs, err)
+	}
+
+	return domain.NewMatchDetails(match, users), nil
+}
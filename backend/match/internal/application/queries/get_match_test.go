@@ -0,0 +1,84 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/match/internal/memory"
+)
+
+type fakeGroups struct {
+	domain.GroupRepository
+	active map[string]bool
+}
+
+func (f fakeGroups) IsPlayerActive(_ context.Context, userID, _ string) (bool, error) {
+	return f.active[userID], nil
+}
+
+type fakeUsers map[string]string
+
+func (f fakeUsers) GetUserAll(_ context.Context, userIDs []string) ([]*domain.User, error) {
+	var users []*domain.User
+
+	for _, id := range userIDs {
+		if nickName, ok := f[id]; ok {
+			users = append(users, domain.NewUser(id, nickName))
+		}
+	}
+
+	return users, nil
+}
+
+func TestGetMatchHandler_GetMatch(t *testing.T) {
+	ctx := context.Background()
+	matches := memory.NewMatchRepository()
+
+	location, err := domain.NewLocation("Stadium")
+	require.NoError(t, err)
+
+	playerCount, err := domain.NewPlayerCount(4, 10)
+	require.NoError(t, err)
+
+	selection, err := domain.NewSelection(domain.FirstComeFirstServe, domain.DefaultCutoff)
+	require.NoError(t, err)
+
+	match, err := domain.CreateNewMatch(time.Now().Add(time.Hour), location, playerCount, selection, "group-1")
+	require.NoError(t, err)
+	require.NoError(t, match.RespondToInvitation("user-1", true))
+	require.NoError(t, match.RespondToInvitation("user-2", false))
+	require.NoError(t, matches.Save(ctx, match))
+
+	handler := NewGetMatchHandler(
+		matches,
+		fakeGroups{active: map[string]bool{"user-1": true}},
+		fakeUsers{"user-1": "Max"},
+	)
+
+	t.Run("resolves nicknames of registered users", func(t *testing.T) {
+		details, err := handler.GetMatch(ctx, &GetMatch{MatchID: match.ID(), UserID: "user-1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.Planned, details.Status())
+		assert.Equal(t, "Max", details.NickName("user-1"))
+		assert.Empty(t, details.NickName("user-2"))
+	})
+
+	t.Run("rejects user not active in group", func(t *testing.T) {
+		_, err := handler.GetMatch(ctx, &GetMatch{MatchID: match.ID(), UserID: "user-2"})
+
+		assert.ErrorIs(t, err, policy.ErrActorNotActive)
+	})
+
+	t.Run("does not find unknown match", func(t *testing.T) {
+		_, err := handler.GetMatch(ctx, &GetMatch{MatchID: "unknown", UserID: "user-1"})
+
+		assert.ErrorIs(t, err, domain.ErrMatchNotFound)
+	})
+}
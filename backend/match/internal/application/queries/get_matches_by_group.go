@@ -0,0 +1,43 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type GetMatchesByGroup struct {
+	GroupID string
+	UserID  string
+	Page    pagination.Request
+}
+
+type GetMatchesByGroupHandler struct {
+	domain.MatchRepository
+	domain.GroupRepository
+}
+
+func NewGetMatchesByGroupHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+) GetMatchesByGroupHandler {
+	return GetMatchesByGroupHandler{matches, groups}
+}
+
+func (h GetMatchesByGroupHandler) GetMatchesByGroup(
+	ctx context.Context,
+	cmd *GetMatchesByGroup,
+) (pagination.Page[*domain.Match], error) {
+	if err := authorizeView(ctx, h.GroupRepository, cmd.UserID, cmd.GroupID); err != nil {
+		return pagination.Page[*domain.Match]{}, err
+	}
+
+	matches, err := h.MatchRepository.FindAllByGroupIDs(ctx, []string{cmd.GroupID}, cmd.Page)
+	if err != nil {
+		return pagination.Page[*domain.Match]{}, fmt.Errorf("getting matches of group %s: %w", cmd.GroupID, err)
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,53 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type GetUpcomingMatches struct {
+	UserID string
+	Page   pagination.Request
+}
+
+type GetUpcomingMatchesHandler struct {
+	domain.MatchRepository
+	domain.GroupRepository
+}
+
+func NewGetUpcomingMatchesHandler(
+	matches domain.MatchRepository,
+	groups domain.GroupRepository,
+) GetUpcomingMatchesHandler {
+	return GetUpcomingMatchesHandler{matches, groups}
+}
+
+// GetUpcomingMatches finds the planned matches which have not begun yet of
+// all groups the user is an active player of.
+func (h GetUpcomingMatchesHandler) GetUpcomingMatches(
+	ctx context.Context,
+	cmd *GetUpcomingMatches,
+) (pagination.Page[*domain.Match], error) {
+	groupIDs, err := h.GroupRepository.GetActiveGroupIDs(ctx, cmd.UserID)
+	if err != nil {
+		return pagination.Page[*domain.Match]{}, fmt.Errorf("getting groups of user %s: %w", cmd.UserID, err)
+	}
+
+	page := cmd.Page
+	page.Filters = append(slices.Clone(page.Filters),
+		pagination.Filter{Field: "status", Operator: pagination.Equal, Value: string(domain.Planned)},
+		pagination.Filter{Field: "begin", Operator: pagination.Greater, Value: time.Now()},
+	)
+
+	matches, err := h.MatchRepository.FindAllByGroupIDs(ctx, groupIDs, page)
+	if err != nil {
+		return pagination.Page[*domain.Match]{}, fmt.Errorf("getting upcoming matches of user %s: %w", cmd.UserID, err)
+	}
+
+	return matches, nil
+}
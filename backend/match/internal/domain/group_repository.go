@@ -1,6 +1,23 @@
 package domain
 
+import "context"
+
+// GroupPlayer tells how a user acts in a group. Role is member, admin or
+// master and Permissions are granted by the roles the group gave the user.
+type GroupPlayer struct {
+	Active      bool
+	Role        string
+	Permissions []string
+}
+
 type GroupRepository interface {
-	IsPlayerActive(userID, groupID string) (bool, error)
-	HasPlayerAdminRole(userID, groupID string) (bool, error)
+	IsPlayerActive(ctx context.Context, userID, groupID string) (bool, error)
+	// GetPlayer returns how the user acts in the group. Users who are not in
+	// the group are inactive members without permissions.
+	GetPlayer(ctx context.Context, userID, groupID string) (*GroupPlayer, error)
+	// GetActiveGroupIDs returns the groups the user is an active player of.
+	GetActiveGroupIDs(ctx context.Context, userID string) ([]string, error)
+	// GetMatchPriority returns the priority the matches of the group select
+	// their players by unless they choose another one.
+	GetMatchPriority(ctx context.Context, groupID string) (string, error)
 }
@@ -1,12 +1,12 @@
 package domain
 
-import "errors"
+import "github.com/FSpruhs/kick-app/backend/internal/ddd"
 
 type Location struct {
 	name string
 }
 
-var ErrLocationInvalid = errors.New("location is invalid")
+var ErrLocationInvalid = ddd.ValidationError("match.invalid_location", "location is invalid")
 
 func NewLocation(name string) (*Location, error) {
 	if !isLocationValid(name) {
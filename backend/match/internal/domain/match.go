@@ -1,8 +1,8 @@
 package domain
 
 import (
-	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,7 +13,15 @@ import (
 
 const MatchAggregate = "match.MatchAggregate"
 
-var ErrMatchAlreadyStarted = errors.New("match already started")
+var (
+	ErrMatchAlreadyStarted = ddd.ConflictError("match.already_started", "match already started")
+	ErrMatchNotFound       = ddd.NotFoundError("match.not_found", "match not found")
+	ErrMatchNotStarted     = ddd.ConflictError("match.not_started", "match has not started yet")
+	ErrMatchNotPlanned     = ddd.ConflictError("match.not_planned", "match is not planned anymore")
+	ErrBeginInPast         = ddd.ValidationError("match.begin_in_past", "begin must be in the future")
+	ErrAlreadySelected     = ddd.ConflictError("match.already_selected", "players are already selected")
+	ErrSelectionNotDue     = ddd.ConflictError("match.selection_not_due", "cutoff of the match not reached yet")
+)
 
 type Match struct {
 	ddd.Aggregate
@@ -21,6 +29,11 @@ type Match struct {
 	begin         time.Time
 	location      *Location
 	playerCount   *PlayerCount
+	selection     *Selection
+	selected      bool
+	bench         []string
+	status        MatchStatus
+	result        *Result
 	registrations []*Registration
 }
 
@@ -30,6 +43,11 @@ func NewMatch(
 	begin time.Time,
 	location *Location,
 	playerCount *PlayerCount,
+	selection *Selection,
+	selected bool,
+	bench []string,
+	status MatchStatus,
+	result *Result,
 	registrations []*Registration,
 ) *Match {
 	return &Match{
@@ -38,84 +56,466 @@ func NewMatch(
 		begin:         begin,
 		location:      location,
 		playerCount:   playerCount,
+		selection:     selection,
+		selected:      selected,
+		bench:         bench,
+		status:        status,
+		result:        result,
 		registrations: registrations,
 	}
-
 }
 
-func CreateNewMatch(begin time.Time, location *Location, playerCount *PlayerCount, groupID string) (*Match, error) {
-	match := &Match{
-		Aggregate:     ddd.NewAggregate(uuid.New().String(), MatchAggregate),
-		groupID:       groupID,
-		begin:         begin,
-		location:      location,
-		playerCount:   playerCount,
+// NewEmptyMatch is the starting point for restoring a match from its events.
+func NewEmptyMatch(id string) *Match {
+	return &Match{
+		Aggregate:     ddd.NewAggregate(id, MatchAggregate),
 		registrations: make([]*Registration, 0),
 	}
+}
 
+func CreateNewMatch(
+	begin time.Time,
+	location *Location,
+	playerCount *PlayerCount,
+	selection *Selection,
+	groupID string,
+) (*Match, error) {
 	if time.Now().After(begin) {
 		return nil, ErrMatchAlreadyStarted
 	}
 
-	match.AddEvent(matchpb.MatchCreatedEvent, matchpb.MatchCreated{
-		MatchID: match.ID(),
-		GroupID: match.GroupID(),
-	})
+	match := NewEmptyMatch(uuid.New().String())
+
+	if err := match.raise(matchpb.MatchCreatedEvent, matchpb.MatchCreated{
+		MatchID:   match.ID(),
+		GroupID:   groupID,
+		Begin:     begin,
+		Location:  location.Name(),
+		PlayerMin: playerCount.Min(),
+		PlayerMax: playerCount.Max(),
+		Priority:  string(selection.Priority()),
+		Cutoff:    selection.Cutoff(),
+	}); err != nil {
+		return nil, err
+	}
 
 	return match, nil
 }
 
+// RespondToInvitation registers the player while the match has a free slot
+// and puts them on the bench once it is full. Players keep their place when
+// they accept again and stay deregistered when they decline again. A player
+// leaving a slot makes room for the bench.
 func (m *Match) RespondToInvitation(playerID string, accept bool) error {
-	var status RegistrationStatus
-	if accept {
-		status = Registered
-	} else {
-		status = Deregistered
+	if err := m.requirePlanned(); err != nil {
+		return err
 	}
 
-	for _, r := range m.registrations {
-		if r.userID == playerID {
-			if r.status != Registered && r.status != Deregistered {
-				return fmt.Errorf("player %s cant change registration", playerID)
-			}
-			r.status = status
-			r.timeStamp = time.Now()
+	r := m.findRegistration(playerID)
+	if r != nil && r.status != Registered && r.status != Deregistered && r.status != Benched {
+		return fmt.Errorf("player %s cant change registration", playerID)
+	}
 
+	if !accept {
+		if r != nil && r.status == Deregistered {
 			return nil
 		}
+
+		if err := m.changeRegistration(playerID, Deregistered, time.Now()); err != nil {
+			return err
+		}
+
+		return m.promote()
+	}
+
+	if r != nil && r.status != Deregistered {
+		return nil
+	}
+
+	status := RegistrationStatus(Registered)
+	if m.isFull() {
+		status = Benched
+	}
+
+	return m.changeRegistration(playerID, status, time.Now())
+}
+
+// AddRegistration lets an admin register a player even if the match is full.
+func (m *Match) AddRegistration(playerID string) error {
+	if err := m.requirePlanned(); err != nil {
+		return err
+	}
+
+	r := m.findRegistration(playerID)
+	if r == nil {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+
+	return m.changeRegistration(playerID, Added, r.timeStamp)
+}
+
+func (m *Match) RemoveRegistration(playerID string) error {
+	if err := m.requirePlanned(); err != nil {
+		return err
+	}
+
+	r := m.findRegistration(playerID)
+	if r == nil {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+
+	if err := m.changeRegistration(playerID, Removed, r.timeStamp); err != nil {
+		return err
+	}
+
+	return m.promote()
+}
+
+// SelectPlayers lets the priority of the match decide at the cutoff which of
+// the registered and benched players get the slots left by the players an
+// admin added. Players accepting afterwards join the end of the bench.
+func (m *Match) SelectPlayers(history *PlayerHistory) error {
+	if err := m.requirePlanned(); err != nil {
+		return err
 	}
 
-	m.registrations = append(m.registrations, &Registration{
-		userID:    playerID,
-		status:    status,
-		timeStamp: time.Now(),
+	if m.selected {
+		return ErrAlreadySelected
+	}
+
+	if time.Now().Before(m.SelectAt()) {
+		return ErrSelectionNotDue
+	}
+
+	var (
+		candidates []*Registration
+		added      int
+	)
+
+	for _, r := range m.registrations {
+		switch r.status {
+		case Registered, Benched:
+			candidates = append(candidates, r)
+		case Added:
+			added++
+		}
+	}
+
+	ranked := m.selection.Priority().Strategy().Rank(candidates, history)
+	slots := min(max(m.playerCount.Max()-added, 0), len(ranked))
+
+	selected := matchpb.PlayersSelected{MatchID: m.ID(), GroupID: m.groupID, Bench: make([]string, 0)}
+
+	for i, r := range ranked {
+		switch {
+		case i < slots && r.status == Benched:
+			selected.Promoted = append(selected.Promoted, r.userID)
+		case i >= slots && r.status == Registered:
+			selected.Benched = append(selected.Benched, r.userID)
+		}
+
+		if i >= slots {
+			selected.Bench = append(selected.Bench, r.userID)
+		}
+	}
+
+	return m.raise(matchpb.PlayersSelectedEvent, selected)
+}
+
+func (m *Match) Cancel() error {
+	if err := m.checkTransition(Cancelled); err != nil {
+		return err
+	}
+
+	return m.raise(matchpb.MatchCancelledEvent, matchpb.MatchCancelled{
+		MatchID: m.ID(),
+		GroupID: m.groupID,
+		UserIDs: m.registeredUserIDs(),
 	})
+}
+
+// Reschedule moves a planned match to a new begin, which may also be done
+// after the old begin passed without the match being played. The players are
+// selected again at the cutoff before the new begin.
+func (m *Match) Reschedule(begin time.Time) error {
+	if err := m.requirePlanned(); err != nil {
+		return err
+	}
+
+	if time.Now().After(begin) {
+		return ErrBeginInPast
+	}
+
+	return m.raise(matchpb.MatchRescheduledEvent, matchpb.MatchRescheduled{
+		MatchID: m.ID(),
+		GroupID: m.groupID,
+		Begin:   begin,
+		UserIDs: m.registeredUserIDs(),
+	})
+}
+
+func (m *Match) Relocate(location *Location) error {
+	if err := m.requirePlanned(); err != nil {
+		return err
+	}
+
+	return m.raise(matchpb.MatchRelocatedEvent, matchpb.MatchRelocated{
+		MatchID:  m.ID(),
+		GroupID:  m.groupID,
+		Location: location.Name(),
+		UserIDs:  m.registeredUserIDs(),
+	})
+}
+
+// Finish marks a match as played, which it can only be once it began.
+func (m *Match) Finish() error {
+	if err := m.checkTransition(Finished); err != nil {
+		return err
+	}
+
+	if time.Now().Before(m.begin) {
+		return ErrMatchNotStarted
+	}
+
+	return m.raise(matchpb.MatchFinishedEvent, matchpb.MatchFinished{
+		MatchID: m.ID(),
+		GroupID: m.groupID,
+		UserIDs: m.registeredUserIDs(),
+	})
+}
+
+// EnterResult records the result of a finished match. An entered result can
+// be corrected by entering it again.
+func (m *Match) EnterResult(result *Result) error {
+	if err := m.checkTransition(ResultEntered); err != nil {
+		return err
+	}
+
+	return m.raise(matchpb.MatchResultEnteredEvent, matchpb.MatchResultEntered{
+		MatchID:    m.ID(),
+		GroupID:    m.groupID,
+		TeamAGoals: result.TeamAGoals(),
+		TeamBGoals: result.TeamBGoals(),
+		UserIDs:    m.registeredUserIDs(),
+	})
+}
+
+func (m *Match) checkTransition(to MatchStatus) error {
+	if !slices.Contains(transitions[m.status], to) {
+		return fmt.Errorf("%w: from %s to %s", ErrInvalidTransition, m.status, to)
+	}
 
 	return nil
 }
 
-func (m *Match) AddRegistration(playerID string) error {
+func (m *Match) requirePlanned() error {
+	if m.status != Planned {
+		return fmt.Errorf("%w: match is %s", ErrMatchNotPlanned, m.status)
+	}
+
+	return nil
+}
+
+// registeredUserIDs are the users taking part in the match.
+func (m *Match) registeredUserIDs() []string {
+	var userIDs []string
+
 	for _, r := range m.registrations {
-		if r.userID == playerID {
-			r.status = Added
+		if r.takesPart() {
+			userIDs = append(userIDs, r.userID)
+		}
+	}
+
+	return userIDs
+}
+
+func (m *Match) isFull() bool {
+	return len(m.registeredUserIDs()) >= m.playerCount.Max()
+}
 
+// promote fills the free slots with the players waiting longest on the bench.
+func (m *Match) promote() error {
+	for !m.isFull() {
+		next := m.firstBenched()
+		if next == nil {
 			return nil
 		}
+
+		if err := m.raise(matchpb.PlayerPromotedEvent, matchpb.PlayerPromoted{
+			MatchID: m.ID(),
+			GroupID: m.groupID,
+			UserID:  next.userID,
+		}); err != nil {
+			return err
+		}
 	}
 
-	return fmt.Errorf("player %s not found", playerID)
+	return nil
 }
 
-func (m *Match) RemoveRegistration(playerID string) error {
+// firstBenched follows the order of the bench once the players were
+// selected. Until then and for players benched later, the earliest
+// registration comes first.
+func (m *Match) firstBenched() *Registration {
+	for _, userID := range m.bench {
+		if r := m.findRegistration(userID); r != nil && r.status == Benched {
+			return r
+		}
+	}
+
+	var first *Registration
+
 	for _, r := range m.registrations {
-		if r.userID == playerID {
-			r.status = Removed
+		if r.status == Benched && (first == nil || r.timeStamp.Before(first.timeStamp)) {
+			first = r
+		}
+	}
+
+	return first
+}
+
+func (m *Match) ApplyEvent(event ddd.Event) error {
+	return m.apply(event.Payload())
+}
+
+func (m *Match) changeRegistration(playerID string, status RegistrationStatus, timeStamp time.Time) error {
+	return m.raise(matchpb.RegistrationChangedEvent, matchpb.RegistrationChanged{
+		MatchID:   m.ID(),
+		UserID:    playerID,
+		Status:    status.String(),
+		TimeStamp: timeStamp,
+	})
+}
+
+// raise records a new event and applies it, so the state of the match is
+// always the result of its events.
+func (m *Match) raise(name string, payload ddd.EventPayload) error {
+	if err := m.apply(payload); err != nil {
+		return err
+	}
+
+	m.AddEvent(name, payload)
+
+	return nil
+}
+
+func (m *Match) apply(payload ddd.EventPayload) error {
+	switch payload := payload.(type) {
+	case matchpb.MatchCreated:
+		return m.applyMatchCreated(payload)
+	case matchpb.RegistrationChanged:
+		status := RegistrationStatusFromString(payload.Status)
+		if status < 0 {
+			return fmt.Errorf("invalid registration status %s", payload.Status)
+		}
+
+		if r := m.findRegistration(payload.UserID); r != nil {
+			r.status = status
+			r.timeStamp = payload.TimeStamp
 
 			return nil
 		}
+
+		m.registrations = append(m.registrations, NewRegistration(payload.UserID, status, payload.TimeStamp))
+	case matchpb.PlayerPromoted:
+		r := m.findRegistration(payload.UserID)
+		if r == nil {
+			return fmt.Errorf("promoted player %s not found", payload.UserID)
+		}
+
+		r.status = Registered
+	case matchpb.PlayersSelected:
+		return m.applyPlayersSelected(payload)
+	case matchpb.MatchCancelled:
+		m.status = Cancelled
+	case matchpb.MatchRescheduled:
+		m.begin = payload.Begin
+		m.selected = false
+		m.bench = nil
+	case matchpb.MatchRelocated:
+		location, err := NewLocation(payload.Location)
+		if err != nil {
+			return fmt.Errorf("create location: %w", err)
+		}
+
+		m.location = location
+	case matchpb.MatchFinished:
+		m.status = Finished
+	case matchpb.MatchResultEntered:
+		result, err := NewResult(payload.TeamAGoals, payload.TeamBGoals)
+		if err != nil {
+			return fmt.Errorf("create result: %w", err)
+		}
+
+		m.status = ResultEntered
+		m.result = result
+	default:
+		return fmt.Errorf("%T: %w", payload, ddd.ErrInvalidEventPayload)
+	}
+
+	return nil
+}
+
+func (m *Match) applyMatchCreated(payload matchpb.MatchCreated) error {
+	location, err := NewLocation(payload.Location)
+	if err != nil {
+		return fmt.Errorf("create location: %w", err)
+	}
+
+	playerCount, err := NewPlayerCount(payload.PlayerMin, payload.PlayerMax)
+	if err != nil {
+		return fmt.Errorf("create player count: %w", err)
+	}
+
+	priority, err := ToPriority(payload.Priority)
+	if err != nil {
+		return fmt.Errorf("create priority: %w", err)
 	}
 
-	return fmt.Errorf("player %s not found", playerID)
+	selection, err := NewSelection(priority, payload.Cutoff)
+	if err != nil {
+		return fmt.Errorf("create selection: %w", err)
+	}
+
+	m.groupID = payload.GroupID
+	m.begin = payload.Begin
+	m.location = location
+	m.playerCount = playerCount
+	m.selection = selection
+	m.status = Planned
+	m.registrations = make([]*Registration, 0)
+
+	return nil
+}
+
+func (m *Match) applyPlayersSelected(payload matchpb.PlayersSelected) error {
+	changes := map[RegistrationStatus][]string{Registered: payload.Promoted, Benched: payload.Benched}
+
+	for status, userIDs := range changes {
+		for _, userID := range userIDs {
+			r := m.findRegistration(userID)
+			if r == nil {
+				return fmt.Errorf("selected player %s not found", userID)
+			}
+
+			r.status = status
+		}
+	}
+
+	m.selected = true
+	m.bench = payload.Bench
+
+	return nil
+}
+
+func (m *Match) findRegistration(playerID string) *Registration {
+	for _, r := range m.registrations {
+		if r.userID == playerID {
+			return r
+		}
+	}
+
+	return nil
 }
 
 func (m *Match) Begin() time.Time {
@@ -137,3 +537,32 @@ func (m *Match) Registrations() []*Registration {
 func (m *Match) GroupID() string {
 	return m.groupID
 }
+
+func (m *Match) Selection() *Selection {
+	return m.selection
+}
+
+// SelectAt is when the priority selects the players.
+func (m *Match) SelectAt() time.Time {
+	return m.begin.Add(-m.selection.Cutoff())
+}
+
+// Selected tells whether the players were selected for the current begin.
+func (m *Match) Selected() bool {
+	return m.selected
+}
+
+// Bench is the order the benched players are promoted in once the players
+// were selected.
+func (m *Match) Bench() []string {
+	return m.bench
+}
+
+func (m *Match) Status() MatchStatus {
+	return m.status
+}
+
+// Result is nil until the result was entered.
+func (m *Match) Result() *Result {
+	return m.result
+}
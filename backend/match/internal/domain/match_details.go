@@ -0,0 +1,23 @@
+package domain
+
+// MatchDetails is a match together with the nicknames of the users
+// registered for it.
+type MatchDetails struct {
+	*Match
+	nickNames map[string]string
+}
+
+func NewMatchDetails(match *Match, users []*User) *MatchDetails {
+	nickNames := make(map[string]string, len(users))
+	for _, user := range users {
+		nickNames[user.ID()] = user.NickName()
+	}
+
+	return &MatchDetails{Match: match, nickNames: nickNames}
+}
+
+// NickName returns the nickname of a registered user or an empty string if
+// the user was not found.
+func (d MatchDetails) NickName(userID string) string {
+	return d.nickNames[userID]
+}
@@ -1,6 +1,42 @@
 package domain
 
+import (
+	"context"
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+// MatchListSchema are the fields lists of matches can be sorted and filtered
+// by. The status is one of the MatchStatus values. The earliest matches come
+// first by default.
+var MatchListSchema = pagination.Schema{
+	Fields: map[string]pagination.Field{
+		"begin": {
+			Type:     pagination.Time,
+			Sortable: true,
+			Operators: []pagination.Operator{
+				pagination.Less,
+				pagination.LessOrEqual,
+				pagination.Greater,
+				pagination.GreaterOrEqual,
+			},
+		},
+		"status": {
+			Type:      pagination.String,
+			Sortable:  false,
+			Operators: []pagination.Operator{pagination.Equal, pagination.NotEqual},
+		},
+	},
+	DefaultSort: pagination.Sort{Field: "begin", Descending: false},
+}
+
 type MatchRepository interface {
-	Save(match *Match) error
-	FindByID(id string) (*Match, error)
+	Save(ctx context.Context, match *Match) error
+	FindByID(ctx context.Context, id string) (*Match, error)
+	// FindAllByGroupIDs finds the matches of any of the groups.
+	FindAllByGroupIDs(ctx context.Context, groupIDs []string, page pagination.Request) (pagination.Page[*Match], error)
+	// FindDueForSelection finds the ids of the planned matches whose players
+	// were not selected although their cutoff passed by now.
+	FindDueForSelection(ctx context.Context, now time.Time) ([]string, error)
 }
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+const MatchSnapshotName = "match.MatchSnapshot"
+
+type MatchSnapshot struct {
+	GroupID       string
+	Begin         time.Time
+	Location      string
+	PlayerMin     int
+	PlayerMax     int
+	Priority      string
+	Cutoff        time.Duration
+	Selected      bool
+	Bench         []string
+	Status        string
+	Result        *ResultSnapshot
+	Registrations []RegistrationSnapshot
+}
+
+type ResultSnapshot struct {
+	TeamAGoals int
+	TeamBGoals int
+}
+
+type RegistrationSnapshot struct {
+	UserID    string
+	Status    int
+	TimeStamp time.Time
+}
+
+var _ ddd.Snapshotter = (*Match)(nil)
+
+func (MatchSnapshot) SnapshotName() string {
+	return MatchSnapshotName
+}
+
+func (m *Match) ToSnapshot() ddd.Snapshot {
+	registrations := make([]RegistrationSnapshot, len(m.Registrations()))
+	for i, r := range m.Registrations() {
+		registrations[i] = RegistrationSnapshot{
+			UserID:    r.UserID(),
+			Status:    int(r.Status()),
+			TimeStamp: r.TimeStamp(),
+		}
+	}
+
+	var result *ResultSnapshot
+	if m.result != nil {
+		result = &ResultSnapshot{TeamAGoals: m.result.TeamAGoals(), TeamBGoals: m.result.TeamBGoals()}
+	}
+
+	return MatchSnapshot{
+		GroupID:       m.GroupID(),
+		Begin:         m.Begin(),
+		Location:      m.Location().Name(),
+		PlayerMin:     m.PlayerCount().Min(),
+		PlayerMax:     m.PlayerCount().Max(),
+		Priority:      string(m.selection.Priority()),
+		Cutoff:        m.selection.Cutoff(),
+		Selected:      m.selected,
+		Bench:         m.bench,
+		Status:        string(m.status),
+		Result:        result,
+		Registrations: registrations,
+	}
+}
+
+func (m *Match) ApplySnapshot(snapshot ddd.Snapshot) error {
+	matchSnapshot, ok := snapshot.(MatchSnapshot)
+	if !ok {
+		return fmt.Errorf("%T: %w", snapshot, ddd.ErrInvalidEventPayload)
+	}
+
+	location, err := NewLocation(matchSnapshot.Location)
+	if err != nil {
+		return fmt.Errorf("create location: %w", err)
+	}
+
+	playerCount, err := NewPlayerCount(matchSnapshot.PlayerMin, matchSnapshot.PlayerMax)
+	if err != nil {
+		return fmt.Errorf("create player count: %w", err)
+	}
+
+	priority, err := ToPriority(matchSnapshot.Priority)
+	if err != nil {
+		return fmt.Errorf("create priority: %w", err)
+	}
+
+	selection, err := NewSelection(priority, matchSnapshot.Cutoff)
+	if err != nil {
+		return fmt.Errorf("create selection: %w", err)
+	}
+
+	status, err := MatchStatusFromString(matchSnapshot.Status)
+	if err != nil {
+		return fmt.Errorf("create status: %w", err)
+	}
+
+	var result *Result
+	if matchSnapshot.Result != nil {
+		if result, err = NewResult(matchSnapshot.Result.TeamAGoals, matchSnapshot.Result.TeamBGoals); err != nil {
+			return fmt.Errorf("create result: %w", err)
+		}
+	}
+
+	registrations := make([]*Registration, len(matchSnapshot.Registrations))
+	for i, r := range matchSnapshot.Registrations {
+		registrations[i] = NewRegistration(r.UserID, RegistrationStatus(r.Status), r.TimeStamp)
+	}
+
+	m.groupID = matchSnapshot.GroupID
+	m.begin = matchSnapshot.Begin
+	m.location = location
+	m.playerCount = playerCount
+	m.selection = selection
+	m.selected = matchSnapshot.Selected
+	m.bench = matchSnapshot.Bench
+	m.status = status
+	m.result = result
+	m.registrations = registrations
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "github.com/FSpruhs/kick-app/backend/internal/ddd"
+
+// MatchStatus is the state of the lifecycle of a match. A planned match is
+// either cancelled or finished once it was played. The result of a finished
+// match can be entered afterwards.
+type MatchStatus string
+
+const (
+	Planned       MatchStatus = "planned"
+	Cancelled     MatchStatus = "cancelled"
+	Finished      MatchStatus = "finished"
+	ResultEntered MatchStatus = "resultEntered"
+)
+
+var (
+	ErrInvalidTransition  = ddd.ConflictError("match.invalid_transition", "match can not change to this status")
+	ErrUnknownMatchStatus = ddd.ValidationError("match.unknown_status", "unknown match status")
+)
+
+// transitions are the statuses a match can change to from its status.
+var transitions = map[MatchStatus][]MatchStatus{
+	Planned:       {Cancelled, Finished},
+	Finished:      {ResultEntered},
+	ResultEntered: {ResultEntered},
+}
+
+// MatchStatusFromString returns the status or Planned for matches stored
+// before they had a status.
+func MatchStatusFromString(s string) (MatchStatus, error) {
+	switch status := MatchStatus(s); status {
+	case "":
+		return Planned, nil
+	case Planned, Cancelled, Finished, ResultEntered:
+		return status, nil
+	default:
+		return "", ErrUnknownMatchStatus
+	}
+}
@@ -0,0 +1,356 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FSpruhs/kick-app/backend/match/matchpb"
+)
+
+func createSelection(t *testing.T, priority Priority, cutoff time.Duration) *Selection {
+	t.Helper()
+
+	selection, err := NewSelection(priority, cutoff)
+	assert.NoError(t, err)
+
+	return selection
+}
+
+func createTestMatch(t *testing.T) *Match {
+	t.Helper()
+
+	location, _ := NewLocation("test-location")
+	playerCount, _ := NewPlayerCount(2, 10)
+	selection := createSelection(t, FirstComeFirstServe, DefaultCutoff)
+
+	match, err := CreateNewMatch(time.Now().Add(time.Hour), location, playerCount, selection, "test-group")
+	assert.NoError(t, err)
+
+	return match
+}
+
+func TestMatch_ApplyEvents(t *testing.T) {
+	match := createTestMatch(t)
+	_ = match.RespondToInvitation("1", true)
+	_ = match.RespondToInvitation("2", false)
+	_ = match.AddRegistration("2")
+	_ = match.RemoveRegistration("1")
+
+	restored := NewEmptyMatch(match.ID())
+	for _, event := range match.Events() {
+		assert.NoError(t, restored.ApplyEvent(event))
+	}
+
+	assert.Equal(t, match.GroupID(), restored.GroupID())
+	assert.Equal(t, match.Begin(), restored.Begin())
+	assert.Equal(t, match.Location(), restored.Location())
+	assert.Equal(t, match.PlayerCount(), restored.PlayerCount())
+	assert.Equal(t, match.Registrations(), restored.Registrations())
+	assert.Equal(t, Planned, restored.Status())
+	assert.Equal(t, 5, match.PendingVersion())
+}
+
+func TestMatch_Snapshot(t *testing.T) {
+	match := createTestMatch(t)
+	_ = match.RespondToInvitation("1", true)
+
+	restored := NewEmptyMatch(match.ID())
+	err := restored.ApplySnapshot(match.ToSnapshot())
+
+	assert.NoError(t, err)
+	assert.Equal(t, match.GroupID(), restored.GroupID())
+	assert.Equal(t, match.PlayerCount(), restored.PlayerCount())
+	assert.Equal(t, match.Registrations(), restored.Registrations())
+}
+
+func TestMatch_RespondToInvitationAfterRemoval(t *testing.T) {
+	match := createTestMatch(t)
+	_ = match.RespondToInvitation("1", true)
+	_ = match.RemoveRegistration("1")
+
+	err := match.RespondToInvitation("1", true)
+
+	assert.Error(t, err)
+	assert.Equal(t, RegistrationStatus(Removed), match.Registrations()[0].Status())
+}
+
+func TestMatch_RespondToInvitationDeclineTwice(t *testing.T) {
+	match := createTestMatch(t)
+	assert.NoError(t, match.RespondToInvitation("1", false))
+	declinedAt := match.Registrations()[0].TimeStamp()
+	events := len(match.Events())
+
+	err := match.RespondToInvitation("1", false)
+
+	assert.NoError(t, err)
+	assert.Len(t, match.Events(), events)
+	assert.Equal(t, declinedAt, match.Registrations()[0].TimeStamp())
+	assert.Equal(t, RegistrationStatus(Deregistered), match.Registrations()[0].Status())
+}
+
+func createStartedMatch(t *testing.T) *Match {
+	t.Helper()
+
+	location, _ := NewLocation("test-location")
+	playerCount, _ := NewPlayerCount(2, 10)
+	registrations := []*Registration{
+		NewRegistration("1", Registered, time.Now()),
+		NewRegistration("2", Deregistered, time.Now()),
+		NewRegistration("3", Added, time.Now()),
+	}
+
+	begin := time.Now().Add(-time.Hour)
+
+	return NewMatch(
+		"match-1",
+		"test-group",
+		begin,
+		location,
+		playerCount,
+		createSelection(t, FirstComeFirstServe, DefaultCutoff),
+		false,
+		nil,
+		Planned,
+		nil,
+		registrations,
+	)
+}
+
+func TestMatch_Cancel(t *testing.T) {
+	match := createStartedMatch(t)
+
+	assert.NoError(t, match.Cancel())
+	assert.Equal(t, Cancelled, match.Status())
+	assert.Equal(t, []string{"1", "3"}, match.Events()[0].Payload().(matchpb.MatchCancelled).UserIDs)
+
+	assert.ErrorIs(t, match.Cancel(), ErrInvalidTransition)
+	assert.ErrorIs(t, match.Finish(), ErrInvalidTransition)
+	assert.ErrorIs(t, match.RespondToInvitation("4", true), ErrMatchNotPlanned)
+}
+
+func TestMatch_Reschedule(t *testing.T) {
+	match := createTestMatch(t)
+	begin := time.Now().Add(48 * time.Hour)
+
+	assert.ErrorIs(t, match.Reschedule(time.Now().Add(-time.Hour)), ErrBeginInPast)
+	assert.NoError(t, match.Reschedule(begin))
+	assert.Equal(t, begin, match.Begin())
+}
+
+func TestMatch_Relocate(t *testing.T) {
+	match := createTestMatch(t)
+	location, _ := NewLocation("other-location")
+
+	assert.NoError(t, match.Relocate(location))
+	assert.Equal(t, location, match.Location())
+
+	assert.NoError(t, match.Cancel())
+	assert.ErrorIs(t, match.Relocate(location), ErrMatchNotPlanned)
+}
+
+func TestMatch_Finish(t *testing.T) {
+	assert.ErrorIs(t, createTestMatch(t).Finish(), ErrMatchNotStarted)
+
+	match := createStartedMatch(t)
+
+	assert.NoError(t, match.Finish())
+	assert.Equal(t, Finished, match.Status())
+	assert.ErrorIs(t, match.Cancel(), ErrInvalidTransition)
+	assert.ErrorIs(t, match.AddRegistration("2"), ErrMatchNotPlanned)
+}
+
+func TestMatch_EnterResult(t *testing.T) {
+	match := createStartedMatch(t)
+	result, _ := NewResult(3, 2)
+	corrected, _ := NewResult(3, 3)
+
+	assert.ErrorIs(t, match.EnterResult(result), ErrInvalidTransition)
+	assert.NoError(t, match.Finish())
+	assert.NoError(t, match.EnterResult(result))
+	assert.NoError(t, match.EnterResult(corrected))
+	assert.Equal(t, ResultEntered, match.Status())
+	assert.Equal(t, corrected, match.Result())
+
+	restored := NewEmptyMatch(match.ID())
+	assert.NoError(t, restored.ApplySnapshot(match.ToSnapshot()))
+	assert.Equal(t, ResultEntered, restored.Status())
+	assert.Equal(t, corrected, restored.Result())
+}
+
+func TestNewResult(t *testing.T) {
+	_, err := NewResult(-1, 0)
+
+	assert.ErrorIs(t, err, ErrInvalidResult)
+}
+
+func TestMatchStatusFromString(t *testing.T) {
+	status, err := MatchStatusFromString("")
+	assert.NoError(t, err)
+	assert.Equal(t, Planned, status)
+
+	status, err = MatchStatusFromString("resultEntered")
+	assert.NoError(t, err)
+	assert.Equal(t, ResultEntered, status)
+
+	_, err = MatchStatusFromString("postponed")
+	assert.ErrorIs(t, err, ErrUnknownMatchStatus)
+}
+
+func TestMatch_ApplySnapshotWithUnknownStatus(t *testing.T) {
+	snapshot, ok := createTestMatch(t).ToSnapshot().(MatchSnapshot)
+	assert.True(t, ok)
+
+	snapshot.Status = "postponed"
+	err := NewEmptyMatch("match").ApplySnapshot(snapshot)
+
+	assert.ErrorIs(t, err, ErrUnknownMatchStatus)
+}
+
+func createFullMatch(t *testing.T) *Match {
+	t.Helper()
+
+	location, _ := NewLocation("test-location")
+	playerCount, _ := NewPlayerCount(1, 2)
+	selection := createSelection(t, FirstComeFirstServe, DefaultCutoff)
+
+	match, err := CreateNewMatch(time.Now().Add(time.Hour), location, playerCount, selection, "test-group")
+	assert.NoError(t, err)
+
+	for _, playerID := range []string{"1", "2", "3", "4"} {
+		assert.NoError(t, match.RespondToInvitation(playerID, true))
+	}
+
+	return match
+}
+
+func registrationStatus(match *Match, playerID string) RegistrationStatus {
+	return match.findRegistration(playerID).Status()
+}
+
+func TestMatch_RespondToInvitationBenchesWhenFull(t *testing.T) {
+	match := createFullMatch(t)
+
+	assert.Equal(t, RegistrationStatus(Registered), registrationStatus(match, "2"))
+	assert.Equal(t, RegistrationStatus(Benched), registrationStatus(match, "3"))
+	assert.Equal(t, RegistrationStatus(Benched), registrationStatus(match, "4"))
+
+	// accepting again keeps the place on the bench
+	version := match.PendingVersion()
+	assert.NoError(t, match.RespondToInvitation("3", true))
+	assert.Equal(t, version, match.PendingVersion())
+}
+
+func TestMatch_DeregisteringPromotesFirstBenched(t *testing.T) {
+	match := createFullMatch(t)
+	// the bench is ordered by the time the players registered
+	match.findRegistration("3").timeStamp = match.findRegistration("4").TimeStamp().Add(time.Second)
+
+	assert.NoError(t, match.RespondToInvitation("1", false))
+
+	assert.Equal(t, RegistrationStatus(Registered), registrationStatus(match, "4"))
+	assert.Equal(t, RegistrationStatus(Benched), registrationStatus(match, "3"))
+
+	events := match.Events()
+	assert.Equal(t, matchpb.PlayerPromoted{MatchID: match.ID(), GroupID: "test-group", UserID: "4"},
+		events[len(events)-1].Payload())
+}
+
+func TestMatch_RemoveRegistrationPromotesFirstBenched(t *testing.T) {
+	match := createFullMatch(t)
+
+	assert.NoError(t, match.RemoveRegistration("2"))
+	assert.Equal(t, RegistrationStatus(Registered), registrationStatus(match, "3"))
+
+	// benched players leaving free no slot
+	assert.NoError(t, match.RespondToInvitation("4", false))
+	assert.Equal(t, []string{"1", "3"}, match.registeredUserIDs())
+
+	restored := NewEmptyMatch(match.ID())
+	for _, event := range match.Events() {
+		assert.NoError(t, restored.ApplyEvent(event))
+	}
+
+	assert.Equal(t, match.Registrations(), restored.Registrations())
+}
+
+func createDueMatch(t *testing.T, priority Priority) *Match {
+	t.Helper()
+
+	location, _ := NewLocation("test-location")
+	playerCount, _ := NewPlayerCount(1, 2)
+	selection := createSelection(t, priority, 2*time.Hour)
+
+	match, err := CreateNewMatch(time.Now().Add(time.Hour), location, playerCount, selection, "test-group")
+	assert.NoError(t, err)
+
+	for _, playerID := range []string{"1", "2", "3"} {
+		assert.NoError(t, match.RespondToInvitation(playerID, true))
+	}
+
+	return match
+}
+
+func TestMatch_SelectPlayers(t *testing.T) {
+	match := createDueMatch(t, RoundRobin)
+	history := NewPlayerHistory([]*Match{
+		createPlayedMatch(t, NewRegistration("3", Benched, time.Now())),
+	})
+
+	assert.NoError(t, match.SelectPlayers(history))
+
+	assert.Equal(t, RegistrationStatus(Registered), registrationStatus(match, "1"))
+	assert.Equal(t, RegistrationStatus(Benched), registrationStatus(match, "2"))
+	assert.Equal(t, RegistrationStatus(Registered), registrationStatus(match, "3"))
+	assert.Equal(t, []string{"2"}, match.Bench())
+
+	events := match.Events()
+	assert.Equal(t, matchpb.PlayersSelected{
+		MatchID:  match.ID(),
+		GroupID:  "test-group",
+		Promoted: []string{"3"},
+		Benched:  []string{"2"},
+		Bench:    []string{"2"},
+	}, events[len(events)-1].Payload())
+
+	assert.ErrorIs(t, match.SelectPlayers(history), ErrAlreadySelected)
+
+	restored := NewEmptyMatch(match.ID())
+	for _, event := range match.Events() {
+		assert.NoError(t, restored.ApplyEvent(event))
+	}
+
+	assert.Equal(t, match.ToSnapshot(), restored.ToSnapshot())
+}
+
+func TestMatch_SelectPlayersLeavesAddedPlayersTheirSlot(t *testing.T) {
+	match := createDueMatch(t, FirstComeFirstServe)
+	assert.NoError(t, match.AddRegistration("3"))
+
+	assert.NoError(t, match.SelectPlayers(NewPlayerHistory(nil)))
+
+	assert.Equal(t, []string{"1", "3"}, match.registeredUserIDs())
+	assert.Equal(t, []string{"2"}, match.Bench())
+}
+
+func TestMatch_PromotesInOrderOfBench(t *testing.T) {
+	match := createDueMatch(t, FirstComeFirstServe)
+	assert.NoError(t, match.RespondToInvitation("4", true))
+	// the earliest registration would come first without the bench
+	match.findRegistration("4").timeStamp = match.findRegistration("3").TimeStamp().Add(-time.Second)
+	match.bench = []string{"3", "4"}
+	match.selected = true
+
+	assert.NoError(t, match.RemoveRegistration("1"))
+
+	assert.Equal(t, RegistrationStatus(Registered), registrationStatus(match, "3"))
+	assert.Equal(t, RegistrationStatus(Benched), registrationStatus(match, "4"))
+}
+
+func TestMatch_SelectPlayersNotBeforeCutoff(t *testing.T) {
+	match := createDueMatch(t, FirstComeFirstServe)
+	assert.NoError(t, match.Reschedule(time.Now().Add(3*time.Hour)))
+
+	assert.ErrorIs(t, match.SelectPlayers(NewPlayerHistory(nil)), ErrSelectionNotDue)
+}
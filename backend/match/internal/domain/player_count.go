@@ -1,28 +1,30 @@
 package domain
 
-import "errors"
+import "github.com/FSpruhs/kick-app/backend/internal/ddd"
 
 type PlayerCount struct {
 	min int
 	max int
 }
 
-var ErrPlayerCountInvalid = errors.New("player count is invalid")
+var (
+	ErrInvalidMinPlayers = ddd.ValidationError("match.invalid_min_players", "min players must be at least one")
+	ErrInvalidMaxPlayers = ddd.ValidationError("match.invalid_max_players", "max players must not be below min players")
+)
 
 func NewPlayerCount(minPlayers, maxPlayers int) (*PlayerCount, error) {
-	playerCount := PlayerCount{
-		min: minPlayers,
-		max: maxPlayers,
-	}
-	if !playerCount.isPlayerCountValid() {
-		return nil, ErrPlayerCountInvalid
+	if minPlayers < 1 {
+		return nil, ErrInvalidMinPlayers
 	}
 
-	return &playerCount, nil
-}
+	if maxPlayers < minPlayers {
+		return nil, ErrInvalidMaxPlayers
+	}
 
-func (pc PlayerCount) isPlayerCountValid() bool {
-	return pc.min > 0 && pc.min <= pc.max
+	return &PlayerCount{
+		min: minPlayers,
+		max: maxPlayers,
+	}, nil
 }
 
 func (pc PlayerCount) Min() int {
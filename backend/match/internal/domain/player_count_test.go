@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPlayerCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		minPlayers int
+		maxPlayers int
+		wantErr    error
+	}{
+		{"valid", 10, 12, nil},
+		{"min equals max", 10, 10, nil},
+		{"no min players", 0, 12, ErrInvalidMinPlayers},
+		{"max below min", 10, 8, ErrInvalidMaxPlayers},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playerCount, err := NewPlayerCount(tt.minPlayers, tt.maxPlayers)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, playerCount)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.minPlayers, playerCount.Min())
+			assert.Equal(t, tt.maxPlayers, playerCount.Max())
+		})
+	}
+}
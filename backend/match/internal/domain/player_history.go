@@ -0,0 +1,38 @@
+package domain
+
+// PlayerHistory is what the previous matches of a group tell about its
+// players.
+type PlayerHistory struct {
+	attended map[string]int
+	satOut   map[string]bool
+}
+
+// NewPlayerHistory reads the history from the matches played before, the
+// latest first.
+func NewPlayerHistory(previous []*Match) *PlayerHistory {
+	history := &PlayerHistory{attended: make(map[string]int), satOut: make(map[string]bool)}
+
+	for i, match := range previous {
+		for _, r := range match.registrations {
+			if r.takesPart() {
+				history.attended[r.userID]++
+			}
+
+			if i == 0 && r.status == Benched {
+				history.satOut[r.userID] = true
+			}
+		}
+	}
+
+	return history
+}
+
+// Attended is the number of previous matches the player took part in.
+func (h *PlayerHistory) Attended(userID string) int {
+	return h.attended[userID]
+}
+
+// SatOut tells whether the player waited on the bench of the latest match.
+func (h *PlayerHistory) SatOut(userID string) bool {
+	return h.satOut[userID]
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+var ErrUnknownPriority = ddd.ValidationError("match.unknown_priority", "unknown priority")
+
+// Priority names the PriorityStrategy a match selects its players by.
+type Priority string
+
+const (
+	FirstComeFirstServe Priority = "firstComeFirstServe"
+	RoundRobin          Priority = "roundRobin"
+	AttendanceBased     Priority = "attendanceBased"
+)
+
+// PriorityStrategy decides which of the players competing for the slots of a
+// match get them.
+type PriorityStrategy interface {
+	// Rank orders the candidates. The first ones get the slots, the others
+	// wait on the bench in this order.
+	Rank(candidates []*Registration, history *PlayerHistory) []*Registration
+}
+
+var strategies = map[Priority]PriorityStrategy{
+	FirstComeFirstServe: firstComeFirstServe{},
+	RoundRobin:          roundRobin{},
+	AttendanceBased:     attendanceBased{},
+}
+
+// ToPriority returns FirstComeFirstServe for matches which never chose a
+// priority.
+func ToPriority(priority string) (Priority, error) {
+	if priority == "" {
+		return FirstComeFirstServe, nil
+	}
+
+	if _, ok := strategies[Priority(priority)]; !ok {
+		return "", ErrUnknownPriority
+	}
+
+	return Priority(priority), nil
+}
+
+func (p Priority) Strategy() PriorityStrategy {
+	if strategy, ok := strategies[p]; ok {
+		return strategy
+	}
+
+	return firstComeFirstServe{}
+}
+
+// firstComeFirstServe prefers the players who registered first.
+type firstComeFirstServe struct{}
+
+func (firstComeFirstServe) Rank(candidates []*Registration, _ *PlayerHistory) []*Registration {
+	return rankBy(candidates, func(*Registration, *Registration) int { return 0 })
+}
+
+// roundRobin prefers the players who sat out the previous match on the bench.
+type roundRobin struct{}
+
+func (roundRobin) Rank(candidates []*Registration, history *PlayerHistory) []*Registration {
+	return rankBy(candidates, func(a, b *Registration) int {
+		return compareTrueFirst(history.SatOut(a.userID), history.SatOut(b.userID))
+	})
+}
+
+// attendanceBased prefers the regulars who played the most previous matches.
+type attendanceBased struct{}
+
+func (attendanceBased) Rank(candidates []*Registration, history *PlayerHistory) []*Registration {
+	return rankBy(candidates, func(a, b *Registration) int {
+		return cmp.Compare(history.Attended(b.userID), history.Attended(a.userID))
+	})
+}
+
+// rankBy sorts a copy of the candidates by compare and the players who
+// registered first on ties.
+func rankBy(candidates []*Registration, compare func(a, b *Registration) int) []*Registration {
+	ranked := slices.Clone(candidates)
+
+	slices.SortStableFunc(ranked, func(a, b *Registration) int {
+		if c := compare(a, b); c != 0 {
+			return c
+		}
+
+		return a.timeStamp.Compare(b.timeStamp)
+	})
+
+	return ranked
+}
+
+func compareTrueFirst(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return -1
+	default:
+		return 1
+	}
+}
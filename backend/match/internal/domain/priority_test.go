@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPriority(t *testing.T) {
+	priority, err := ToPriority("")
+	assert.NoError(t, err)
+	assert.Equal(t, FirstComeFirstServe, priority)
+
+	priority, err = ToPriority("roundRobin")
+	assert.NoError(t, err)
+	assert.Equal(t, RoundRobin, priority)
+
+	_, err = ToPriority("unknown")
+	assert.ErrorIs(t, err, ErrUnknownPriority)
+}
+
+func createPlayedMatch(t *testing.T, registrations ...*Registration) *Match {
+	t.Helper()
+
+	location, _ := NewLocation("test-location")
+	playerCount, _ := NewPlayerCount(1, 10)
+	selection := createSelection(t, FirstComeFirstServe, 0)
+	begin := time.Now().Add(-time.Hour)
+
+	return NewMatch(
+		"played",
+		"test-group",
+		begin,
+		location,
+		playerCount,
+		selection,
+		true,
+		nil,
+		Finished,
+		nil,
+		registrations,
+	)
+}
+
+func userIDs(registrations []*Registration) []string {
+	ids := make([]string, len(registrations))
+	for i, r := range registrations {
+		ids[i] = r.UserID()
+	}
+
+	return ids
+}
+
+func TestPriority_Strategy(t *testing.T) {
+	now := time.Now()
+	candidates := []*Registration{
+		NewRegistration("c", Benched, now.Add(2*time.Second)),
+		NewRegistration("a", Registered, now),
+		NewRegistration("b", Registered, now.Add(time.Second)),
+	}
+
+	// c sat out the latest match and b played the most
+	history := NewPlayerHistory([]*Match{
+		createPlayedMatch(t, NewRegistration("a", Registered, now), NewRegistration("c", Benched, now)),
+		createPlayedMatch(t, NewRegistration("b", Registered, now), NewRegistration("c", Registered, now)),
+		createPlayedMatch(t, NewRegistration("b", Added, now), NewRegistration("a", Deregistered, now)),
+	})
+
+	tests := []struct {
+		priority Priority
+		want     []string
+	}{
+		{FirstComeFirstServe, []string{"a", "b", "c"}},
+		{RoundRobin, []string{"c", "a", "b"}},
+		{AttendanceBased, []string{"b", "a", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.priority), func(t *testing.T) {
+			assert.Equal(t, tt.want, userIDs(tt.priority.Strategy().Rank(candidates, history)))
+		})
+	}
+}
@@ -27,3 +27,8 @@ func (r Registration) Status() RegistrationStatus {
 func (r Registration) TimeStamp() time.Time {
 	return r.timeStamp
 }
+
+// takesPart tells whether the registration holds a slot of the match.
+func (r Registration) takesPart() bool {
+	return r.status == Registered || r.status == Added
+}
@@ -7,10 +7,12 @@ const (
 	Deregistered
 	Removed
 	Added
+	// Benched players wait for a free slot of a full match.
+	Benched
 )
 
 func (rs RegistrationStatus) String() string {
-	return [...]string{"Registered", "Deregistered", "Removed", "Added"}[rs]
+	return [...]string{"Registered", "Deregistered", "Removed", "Added", "Benched"}[rs]
 }
 
 func RegistrationStatusFromString(s string) RegistrationStatus {
@@ -23,6 +25,8 @@ func RegistrationStatusFromString(s string) RegistrationStatus {
 		return Removed
 	case "Added":
 		return Added
+	case "Benched":
+		return Benched
 	default:
 		return -1
 	}
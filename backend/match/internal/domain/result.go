@@ -0,0 +1,27 @@
+package domain
+
+import "github.com/FSpruhs/kick-app/backend/internal/ddd"
+
+var ErrInvalidResult = ddd.ValidationError("match.invalid_result", "goals must not be negative")
+
+// Result is the number of goals each team scored.
+type Result struct {
+	teamA int
+	teamB int
+}
+
+func NewResult(teamAGoals, teamBGoals int) (*Result, error) {
+	if teamAGoals < 0 || teamBGoals < 0 {
+		return nil, ErrInvalidResult
+	}
+
+	return &Result{teamA: teamAGoals, teamB: teamBGoals}, nil
+}
+
+func (r Result) TeamAGoals() int {
+	return r.teamA
+}
+
+func (r Result) TeamBGoals() int {
+	return r.teamB
+}
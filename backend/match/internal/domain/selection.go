@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+// DefaultCutoff is how long before the begin of a match its players are
+// selected, unless the match chooses otherwise.
+const DefaultCutoff = 24 * time.Hour
+
+var ErrInvalidCutoff = ddd.ValidationError("match.invalid_cutoff", "cutoff must not be negative")
+
+// Selection is how a match selects the players for its slots. Until the
+// cutoff before the begin the players get the slots in the order they
+// register. At the cutoff the priority decides who keeps a slot.
+type Selection struct {
+	priority Priority
+	cutoff   time.Duration
+}
+
+func NewSelection(priority Priority, cutoff time.Duration) (*Selection, error) {
+	if cutoff < 0 {
+		return nil, ErrInvalidCutoff
+	}
+
+	return &Selection{priority: priority, cutoff: cutoff}, nil
+}
+
+func (s Selection) Priority() Priority {
+	return s.priority
+}
+
+func (s Selection) Cutoff() time.Duration {
+	return s.cutoff
+}
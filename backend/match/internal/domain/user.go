@@ -0,0 +1,18 @@
+package domain
+
+type User struct {
+	id       string
+	nickName string
+}
+
+func NewUser(id, nickName string) *User {
+	return &User{id: id, nickName: nickName}
+}
+
+func (u *User) ID() string {
+	return u.id
+}
+
+func (u *User) NickName() string {
+	return u.nickName
+}
@@ -0,0 +1,7 @@
+package domain
+
+import "context"
+
+type UserRepository interface {
+	GetUserAll(ctx context.Context, userIDs []string) ([]*User, error)
+}
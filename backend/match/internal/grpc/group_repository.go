@@ -20,9 +20,9 @@ func NewGroupRepository(conn *grpc.ClientConn) *GroupRepository {
 	return &GroupRepository{client: grouppb.NewGroupServiceClient(conn)}
 }
 
-func (r *GroupRepository) IsPlayerActive(userID, groupID string) (bool, error) {
+func (r *GroupRepository) IsPlayerActive(ctx context.Context, userID, groupID string) (bool, error) {
 	resp, err := r.client.IsActivePlayer(
-		context.Background(),
+		ctx,
 		&grouppb.IsActivePlayerRequest{UserId: userID, GroupId: groupID},
 	)
 	if err != nil {
@@ -32,14 +32,42 @@ func (r *GroupRepository) IsPlayerActive(userID, groupID string) (bool, error) {
 	return resp.GetIsActive(), nil
 }
 
-func (r *GroupRepository) HasPlayerAdminRole(userID, groupID string) (bool, error) {
-	resp, err := r.client.HasPlayerAdminRole(
-		context.Background(),
-		&grouppb.HasPlayerAdminRoleRequest{UserId: userID, GroupId: groupID},
+func (r *GroupRepository) GetPlayer(ctx context.Context, userID, groupID string) (*domain.GroupPlayer, error) {
+	resp, err := r.client.GetPlayerAccess(
+		ctx,
+		&grouppb.GetPlayerAccessRequest{UserId: userID, GroupId: groupID},
 	)
 	if err != nil {
-		return false, fmt.Errorf("has player admin role %s %s: %w", userID, groupID, err)
+		return nil, fmt.Errorf("get player access %s %s: %w", userID, groupID, err)
 	}
 
-	return resp.GetHasAdminRole(), nil
+	return &domain.GroupPlayer{
+		Active:      resp.GetIsActive(),
+		Role:        resp.GetRole(),
+		Permissions: resp.GetPermissions(),
+	}, nil
+}
+
+func (r *GroupRepository) GetActiveGroupIDs(ctx context.Context, userID string) ([]string, error) {
+	resp, err := r.client.GetActiveGroupsByUserID(
+		ctx,
+		&grouppb.GetActiveGroupsByUserIDRequest{UserId: userID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get active groups of user %s: %w", userID, err)
+	}
+
+	return resp.GetGroupIds(), nil
+}
+
+func (r *GroupRepository) GetMatchPriority(ctx context.Context, groupID string) (string, error) {
+	resp, err := r.client.GetMatchPriority(
+		ctx,
+		&grouppb.GetMatchPriorityRequest{GroupId: groupID},
+	)
+	if err != nil {
+		return "", fmt.Errorf("get match priority of group %s: %w", groupID, err)
+	}
+
+	return resp.GetPriority(), nil
 }
@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/user/userpb"
+)
+
+type UserRepository struct {
+	client userpb.UserServiceClient
+}
+
+var _ domain.UserRepository = (*UserRepository)(nil)
+
+func NewUserRepository(conn *grpc.ClientConn) *UserRepository {
+	return &UserRepository{client: userpb.NewUserServiceClient(conn)}
+}
+
+func (r *UserRepository) GetUserAll(ctx context.Context, userIDs []string) ([]*domain.User, error) {
+	resp, err := r.client.GetUserAll(ctx, &userpb.GetUserAllRequest{UserIds: userIDs})
+	if err != nil {
+		return nil, fmt.Errorf("get users %v: %w", userIDs, err)
+	}
+
+	users := make([]*domain.User, len(resp.GetUsers()))
+	for i, u := range resp.GetUsers() {
+		users[i] = domain.NewUser(u.GetUserId(), u.GetNickName())
+	}
+
+	return users, nil
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type storedMatch struct {
+	snapshot domain.MatchSnapshot
+	version  int
+}
+
+// MatchRepository keeps the matches as snapshots in memory. Every match found
+// is restored from its own copy, so changes are only seen by others once the
+// match was saved.
+type MatchRepository struct {
+	mu      sync.RWMutex
+	matches map[string]storedMatch
+}
+
+var _ domain.MatchRepository = (*MatchRepository)(nil)
+
+func NewMatchRepository() *MatchRepository {
+	return &MatchRepository{matches: make(map[string]storedMatch)}
+}
+
+func (r *MatchRepository) Save(_ context.Context, match *domain.Match) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stored, ok := r.matches[match.ID()]; ok && stored.version != match.Version() {
+		return fmt.Errorf("saving match %s: %w", match.ID(), ddd.ConcurrentModificationError{
+			AggregateName: match.AggregateName(),
+			AggregateID:   match.ID(),
+			Version:       match.Version(),
+		})
+	}
+
+	snapshot, _ := match.ToSnapshot().(domain.MatchSnapshot)
+
+	r.matches[match.ID()] = storedMatch{
+		snapshot: cloneSnapshot(snapshot),
+		version:  match.PendingVersion(),
+	}
+
+	return nil
+}
+
+func (r *MatchRepository) FindByID(_ context.Context, id string) (*domain.Match, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.matches[id]
+	if !ok {
+		return nil, fmt.Errorf("finding match %s: %w", id, domain.ErrMatchNotFound)
+	}
+
+	return restore(id, stored)
+}
+
+func (r *MatchRepository) FindAllByGroupIDs(
+	_ context.Context,
+	groupIDs []string,
+	page pagination.Request,
+) (pagination.Page[*domain.Match], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matches := make([]*domain.Match, 0)
+
+	for id, stored := range r.matches {
+		if !slices.Contains(groupIDs, stored.snapshot.GroupID) {
+			continue
+		}
+
+		match, err := restore(id, stored)
+		if err != nil {
+			return pagination.Page[*domain.Match]{}, err
+		}
+
+		matches = append(matches, match)
+	}
+
+	return pagination.Slice(matches, page, (*domain.Match).ID, func(match *domain.Match, field string) any {
+		if field == "status" {
+			return string(match.Status())
+		}
+
+		return match.Begin()
+	}), nil
+}
+
+func (r *MatchRepository) FindDueForSelection(_ context.Context, now time.Time) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	due := make([]*domain.Match, 0)
+
+	for id, stored := range r.matches {
+		match, err := restore(id, stored)
+		if err != nil {
+			return nil, err
+		}
+
+		if match.Status() == domain.Planned && !match.Selected() && !match.SelectAt().After(now) {
+			due = append(due, match)
+		}
+	}
+
+	slices.SortFunc(due, func(a, b *domain.Match) int { return a.SelectAt().Compare(b.SelectAt()) })
+
+	ids := make([]string, len(due))
+	for i, match := range due {
+		ids[i] = match.ID()
+	}
+
+	return ids, nil
+}
+
+func restore(id string, stored storedMatch) (*domain.Match, error) {
+	match := domain.NewEmptyMatch(id)
+	if err := match.ApplySnapshot(cloneSnapshot(stored.snapshot)); err != nil {
+		return nil, fmt.Errorf("restoring match %s: %w", id, err)
+	}
+
+	match.SetVersion(stored.version)
+
+	return match, nil
+}
+
+// cloneSnapshot copies the registrations and the bench of snapshot, which
+// are shared with the match it was taken from.
+func cloneSnapshot(snapshot domain.MatchSnapshot) domain.MatchSnapshot {
+	snapshot.Registrations = slices.Clone(snapshot.Registrations)
+	snapshot.Bench = slices.Clone(snapshot.Bench)
+
+	return snapshot
+}
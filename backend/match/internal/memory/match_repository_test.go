@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/match/internal/repositorytest"
+)
+
+func TestMatchRepository(t *testing.T) {
+	repositorytest.MatchRepository(t, func(*testing.T) domain.MatchRepository {
+		return NewMatchRepository()
+	})
+}
@@ -0,0 +1,22 @@
+package mongodb
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+)
+
+// Indexes serve the matches of groups in the order of their begin and the
+// planned matches due for selection.
+func Indexes(matchesCollection string) []indexes.Index {
+	return []indexes.Index{
+		{
+			Collection: matchesCollection,
+			Keys:       bson.D{{Key: "groupId", Value: 1}, {Key: "begin", Value: 1}, {Key: "_id", Value: 1}},
+		},
+		{
+			Collection: matchesCollection,
+			Keys:       bson.D{{Key: "status", Value: 1}, {Key: "selected", Value: 1}, {Key: "selectAt", Value: 1}},
+		},
+	}
+}
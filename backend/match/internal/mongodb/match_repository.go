@@ -2,12 +2,20 @@ package mongodb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/eventstore"
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb"
+	"github.com/FSpruhs/kick-app/backend/internal/outbox"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
 	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
 )
 
@@ -16,11 +24,23 @@ const timeout = 10 * time.Second
 type MatchDocument struct {
 	ID            string                 `bson:"_id,omitempty"`
 	GroupID       string                 `bson:"groupId,omitempty"`
-	Begin         int64                  `bson:"begin,omitempty"`
+	Begin         time.Time              `bson:"begin,omitempty"`
 	Location      string                 `bson:"location,omitempty"`
 	PlayerMax     int                    `bson:"playerMax,omitempty"`
 	PlayerMin     int                    `bson:"playerMin,omitempty"`
+	Priority      string                 `bson:"priority,omitempty"`
+	SelectAt      time.Time              `bson:"selectAt,omitempty"`
+	Selected      bool                   `bson:"selected,omitempty"`
+	Bench         []string               `bson:"bench,omitempty"`
+	Status        string                 `bson:"status,omitempty"`
+	Result        *ResultDocument        `bson:"result,omitempty"`
 	Registrations []RegistrationDocument `bson:"registrations,omitempty"`
+	Version       int                    `bson:"version"`
+}
+
+type ResultDocument struct {
+	TeamAGoals int `bson:"teamAGoals"`
+	TeamBGoals int `bson:"teamBGoals"`
 }
 
 type RegistrationDocument struct {
@@ -29,46 +49,144 @@ type RegistrationDocument struct {
 	TimeStamp int64  `bson:"timeStamp,omitempty"`
 }
 
+// MatchRepository restores matches from their events. The match documents
+// are kept as read model. They store when the players are selected instead of
+// the cutoff, so the matches due for selection can be found.
 type MatchRepository struct {
 	collection *mongo.Collection
+	store      *eventstore.Store
+	outbox     *outbox.Store
 }
 
 var _ domain.MatchRepository = (*MatchRepository)(nil)
 
-func NewMatchRepository(db *mongo.Database, collectionName string) *MatchRepository {
-	return &MatchRepository{collection: db.Collection(collectionName)}
+func NewMatchRepository(
+	db *mongo.Database,
+	collectionName string,
+	store *eventstore.Store,
+	events *outbox.Store,
+) *MatchRepository {
+	return &MatchRepository{collection: db.Collection(collectionName), store: store, outbox: events}
 }
 
-func (g MatchRepository) Save(match *domain.Match) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (g MatchRepository) Save(ctx context.Context, match *domain.Match) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "match.MatchRepository.Save")
+	defer span.End()
+
 	matchDoc := toDocument(match)
 
-	_, err := g.collection.ReplaceOne(ctx, bson.M{"_id": match.ID()}, matchDoc)
-	if err != nil {
+	if err := mongodb.WithTransaction(ctx, g.collection.Database().Client(), func(txCtx mongo.SessionContext) error {
+		if err := g.store.Save(txCtx, match); err != nil {
+			return err
+		}
+
+		// a match with a newer version is found by its id only, so the upsert
+		// fails with a duplicate key
+		opts := options.Replace().SetUpsert(true)
+		if _, err := g.collection.ReplaceOne(txCtx, mongodb.VersionFilter(match.ID(), match.Version()), matchDoc, opts); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return ddd.ConcurrentModificationError{
+					AggregateName: match.AggregateName(),
+					AggregateID:   match.ID(),
+					Version:       match.Version(),
+				}
+			}
+
+			return fmt.Errorf("replacing match document: %w", err)
+		}
+
+		return g.outbox.Save(txCtx, match.Events()...)
+	}); err != nil {
 		return fmt.Errorf("saving match %s: %w", match.ID(), err)
 	}
 
 	return nil
 }
 
-func (g MatchRepository) FindByID(id string) (*domain.Match, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (g MatchRepository) FindByID(ctx context.Context, id string) (*domain.Match, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	matchDoc := MatchDocument{}
-	err := g.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&matchDoc)
+	ctx, span := tracing.StartSpan(ctx, "match.MatchRepository.FindByID")
+	defer span.End()
+
+	match := domain.NewEmptyMatch(id)
+
+	// matches stored before their events were recorded got a snapshot by
+	// migration 2026101810
+	err := g.store.Load(ctx, match)
+	if errors.Is(err, eventstore.ErrAggregateNotFound) {
+		return nil, fmt.Errorf("finding match %s: %w", id, domain.ErrMatchNotFound)
+	}
+
 	if err != nil {
-		return nil, fmt.Errorf("finding match %s: %w", id, err)
+		return nil, fmt.Errorf("loading match %s: %w", id, err)
 	}
 
-	result, err := toDomain(&matchDoc)
+	return match, nil
+}
+
+func (g MatchRepository) FindAllByGroupIDs(
+	ctx context.Context,
+	groupIDs []string,
+	page pagination.Request,
+) (pagination.Page[*domain.Match], error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "match.MatchRepository.FindAllByGroupIDs")
+	defer span.End()
+
+	filter := bson.M{"groupId": bson.M{"$in": groupIDs}}
+
+	docs, err := mongodb.FindPage[MatchDocument](ctx, g.collection, filter, page, nil)
 	if err != nil {
-		return nil, fmt.Errorf("converting match %s: %w", id, err)
+		return pagination.Page[*domain.Match]{}, fmt.Errorf("finding matches of groups %v: %w", groupIDs, err)
+	}
+
+	matches := make([]*domain.Match, len(docs.Items))
+	for i, doc := range docs.Items {
+		if matches[i], err = toDomain(doc); err != nil {
+			return pagination.Page[*domain.Match]{}, fmt.Errorf("converting match %s: %w", doc.ID, err)
+		}
 	}
 
-	return result, nil
+	return pagination.WithItems(docs, matches), nil
+}
+
+func (g MatchRepository) FindDueForSelection(ctx context.Context, now time.Time) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "match.MatchRepository.FindDueForSelection")
+	defer span.End()
+
+	filter := bson.M{
+		"status":   string(domain.Planned),
+		"selected": bson.M{"$ne": true},
+		"selectAt": bson.M{"$lte": now},
+	}
+	opts := options.Find().SetProjection(bson.M{"_id": 1}).SetSort(bson.D{{Key: "selectAt", Value: 1}})
+
+	cursor, err := g.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding matches due for selection: %w", err)
+	}
+
+	var docs []MatchDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("decoding matches due for selection: %w", err)
+	}
+
+	ids := make([]string, len(docs))
+	for i, doc := range docs {
+		ids[i] = doc.ID
+	}
+
+	return ids, nil
 }
 
 func toDocument(match *domain.Match) MatchDocument {
@@ -81,14 +199,26 @@ func toDocument(match *domain.Match) MatchDocument {
 		})
 	}
 
+	var result *ResultDocument
+	if match.Result() != nil {
+		result = &ResultDocument{TeamAGoals: match.Result().TeamAGoals(), TeamBGoals: match.Result().TeamBGoals()}
+	}
+
 	return MatchDocument{
 		ID:            match.ID(),
 		GroupID:       match.GroupID(),
-		Begin:         match.Begin().Unix(),
+		Begin:         match.Begin(),
 		Location:      match.Location().Name(),
-		PlayerMax:     match.PlayerCount().Min(),
+		PlayerMax:     match.PlayerCount().Max(),
 		PlayerMin:     match.PlayerCount().Min(),
+		Priority:      string(match.Selection().Priority()),
+		SelectAt:      match.SelectAt(),
+		Selected:      match.Selected(),
+		Bench:         match.Bench(),
+		Status:        string(match.Status()),
+		Result:        result,
 		Registrations: registrations,
+		Version:       match.PendingVersion(),
 	}
 }
 
@@ -112,12 +242,42 @@ func toDomain(matchDoc *MatchDocument) (*domain.Match, error) {
 		return nil, fmt.Errorf("invalid player count %d-%d: %w", matchDoc.PlayerMin, matchDoc.PlayerMax, err)
 	}
 
-	return domain.NewMatch(
+	priority, err := domain.ToPriority(matchDoc.Priority)
+	if err != nil {
+		return nil, fmt.Errorf("invalid priority %s: %w", matchDoc.Priority, err)
+	}
+
+	selection, err := domain.NewSelection(priority, matchDoc.Begin.Sub(matchDoc.SelectAt))
+	if err != nil {
+		return nil, fmt.Errorf("invalid selection at %s: %w", matchDoc.SelectAt, err)
+	}
+
+	status, err := domain.MatchStatusFromString(matchDoc.Status)
+	if err != nil {
+		return nil, fmt.Errorf("invalid status %s: %w", matchDoc.Status, err)
+	}
+
+	var result *domain.Result
+	if matchDoc.Result != nil {
+		if result, err = domain.NewResult(matchDoc.Result.TeamAGoals, matchDoc.Result.TeamBGoals); err != nil {
+			return nil, fmt.Errorf("invalid result: %w", err)
+		}
+	}
+
+	match := domain.NewMatch(
 		matchDoc.ID,
 		matchDoc.GroupID,
-		time.Unix(matchDoc.Begin, 0),
+		matchDoc.Begin,
 		location,
 		playerCount,
+		selection,
+		matchDoc.Selected,
+		matchDoc.Bench,
+		status,
+		result,
 		registrations,
-	), nil
+	)
+	match.SetVersion(matchDoc.Version)
+
+	return match, nil
 }
@@ -0,0 +1,123 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/FSpruhs/kick-app/backend/internal/eventstore"
+	"github.com/FSpruhs/kick-app/backend/internal/migrations"
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+	"github.com/FSpruhs/kick-app/backend/internal/outbox"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/match/internal/repositorytest"
+	"github.com/FSpruhs/kick-app/backend/match/matchpb"
+)
+
+func TestMatchRepository(t *testing.T) {
+	repositorytest.MatchRepository(t, func(t *testing.T) domain.MatchRepository {
+		t.Helper()
+
+		db := mongotest.NewDatabase(t)
+
+		reg := registry.New()
+		require.NoError(t, matchpb.Registrations(reg))
+		require.NoError(t, reg.Register(domain.MatchSnapshotName, domain.MatchSnapshot{}))
+
+		store := eventstore.NewStore(db, "match.events", "match.snapshots", reg)
+		require.NoError(t, store.EnsureIndexes(context.Background()))
+
+		return NewMatchRepository(db, "match.matches", store, outbox.NewStore(db, "match.outbox", reg))
+	})
+}
+
+func TestToDocument(t *testing.T) {
+	location, err := domain.NewLocation("Stadium")
+	require.NoError(t, err)
+
+	playerCount, err := domain.NewPlayerCount(4, 10)
+	require.NoError(t, err)
+
+	result, err := domain.NewResult(3, 2)
+	require.NoError(t, err)
+
+	selection, err := domain.NewSelection(domain.AttendanceBased, time.Hour)
+	require.NoError(t, err)
+
+	begin := time.Unix(1_900_000_000, 0)
+	registrations := []*domain.Registration{
+		domain.NewRegistration("user-1", domain.Registered, begin),
+		domain.NewRegistration("user-2", domain.Benched, begin),
+	}
+	match := domain.NewMatch(
+		"match-1",
+		"group-1",
+		begin,
+		location,
+		playerCount,
+		selection,
+		true,
+		[]string{"user-2"},
+		domain.ResultEntered,
+		result,
+		registrations,
+	)
+
+	doc := toDocument(match)
+
+	assert.Equal(t, 4, doc.PlayerMin)
+	assert.Equal(t, 10, doc.PlayerMax)
+	assert.Equal(t, "resultEntered", doc.Status)
+	assert.Equal(t, &ResultDocument{TeamAGoals: 3, TeamBGoals: 2}, doc.Result)
+	assert.Equal(t, "attendanceBased", doc.Priority)
+	assert.Equal(t, begin.Add(-time.Hour), doc.SelectAt)
+
+	restored, err := toDomain(&doc)
+	require.NoError(t, err)
+	assert.Equal(t, match.ToSnapshot(), restored.ToSnapshot())
+}
+
+func TestMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := mongotest.NewDatabase(t)
+	matches := db.Collection("match.matches")
+
+	_, err := matches.InsertOne(ctx, bson.M{
+		"_id":       "match-1",
+		"begin":     int64(1_900_000_000),
+		"location":  "Field",
+		"playerMin": 1,
+		"playerMax": 2,
+	})
+	require.NoError(t, err)
+
+	migrator, err := migrations.New(db, Migrations("match.matches", "match.events", "match.snapshots"))
+	require.NoError(t, err)
+
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+
+	var doc MatchDocument
+	require.NoError(t, matches.FindOne(ctx, bson.M{"_id": "match-1"}).Decode(&doc))
+	assert.True(t, time.Unix(1_900_000_000, 0).Equal(doc.Begin))
+	assert.Equal(t, string(domain.Planned), doc.Status)
+	assert.True(t, doc.Begin.Equal(doc.SelectAt))
+
+	snapshots, err := db.Collection("match.snapshots").CountDocuments(ctx, bson.M{"aggregateId": "match-1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), snapshots)
+
+	_, err = migrator.Down(ctx, 4)
+	require.NoError(t, err)
+
+	var raw bson.M
+	require.NoError(t, matches.FindOne(ctx, bson.M{"_id": "match-1"}).Decode(&raw))
+	assert.Equal(t, int64(1_900_000_000), raw["begin"])
+	assert.NotContains(t, raw, "status")
+	assert.NotContains(t, raw, "selectAt")
+}
@@ -0,0 +1,166 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/FSpruhs/kick-app/backend/internal/eventstore"
+	"github.com/FSpruhs/kick-app/backend/internal/migrations"
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+const millisecondsPerSecond = 1000
+
+// Migrations of the match documents stored in collectionName. The begin of a
+// match was stored as unix seconds before, which cannot be compared with the
+// dates matches are filtered by. Matches stored before they had a status are
+// planned. Matches stored before they had a cutoff select their players at
+// the begin. Matches stored before their events were recorded get a snapshot
+// in the event store.
+func Migrations(collectionName, eventsCollection, snapshotsCollection string) []migrations.Migration {
+	return []migrations.Migration{
+		{
+			Version:     2026101805,
+			Description: fmt.Sprintf("store begin of %s as date", collectionName),
+			Up: updateBegin(collectionName, "number", bson.M{
+				"$toDate": bson.M{"$multiply": bson.A{"$begin", millisecondsPerSecond}},
+			}),
+			Down: updateBegin(collectionName, "date", bson.M{
+				"$toLong": bson.M{"$divide": bson.A{bson.M{"$toLong": "$begin"}, millisecondsPerSecond}},
+			}),
+		},
+		{
+			Version:     2026101806,
+			Description: fmt.Sprintf("store status of %s", collectionName),
+			Up:          setStatus(collectionName),
+			Down:        unsetStatus(collectionName),
+		},
+		{
+			Version:     2026101807,
+			Description: fmt.Sprintf("store selection of %s", collectionName),
+			Up:          setSelectAt(collectionName),
+			Down:        unsetSelection(collectionName),
+		},
+		{
+			Version:     2026101810,
+			Description: fmt.Sprintf("snapshot %s in %s", collectionName, snapshotsCollection),
+			Up:          snapshotMatches(collectionName, eventsCollection, snapshotsCollection),
+			// the snapshots agree with the documents, so they are kept
+			Down: func(context.Context, *mongo.Database) error { return nil },
+		},
+	}
+}
+
+// updateBegin only touches documents whose begin is still of the old type,
+// so running it again changes nothing.
+func updateBegin(collectionName, oldType string, begin bson.M) migrations.Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		filter := bson.M{"begin": bson.M{"$type": oldType}}
+		update := mongo.Pipeline{{{Key: "$set", Value: bson.M{"begin": begin}}}}
+
+		if _, err := db.Collection(collectionName).UpdateMany(ctx, filter, update); err != nil {
+			return fmt.Errorf("updating begin of %s: %w", collectionName, err)
+		}
+
+		return nil
+	}
+}
+
+func setStatus(collectionName string) migrations.Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		filter := bson.M{"status": bson.M{"$exists": false}}
+		update := bson.M{"$set": bson.M{"status": string(domain.Planned)}}
+
+		if _, err := db.Collection(collectionName).UpdateMany(ctx, filter, update); err != nil {
+			return fmt.Errorf("setting status of %s: %w", collectionName, err)
+		}
+
+		return nil
+	}
+}
+
+// unsetStatus also drops the results, which only exist for matches with a
+// status.
+func unsetStatus(collectionName string) migrations.Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		update := bson.M{"$unset": bson.M{"status": "", "result": ""}}
+
+		if _, err := db.Collection(collectionName).UpdateMany(ctx, bson.M{}, update); err != nil {
+			return fmt.Errorf("unsetting status of %s: %w", collectionName, err)
+		}
+
+		return nil
+	}
+}
+
+func setSelectAt(collectionName string) migrations.Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		filter := bson.M{"selectAt": bson.M{"$exists": false}}
+		update := mongo.Pipeline{{{Key: "$set", Value: bson.M{"selectAt": "$begin"}}}}
+
+		if _, err := db.Collection(collectionName).UpdateMany(ctx, filter, update); err != nil {
+			return fmt.Errorf("setting select at of %s: %w", collectionName, err)
+		}
+
+		return nil
+	}
+}
+
+func unsetSelection(collectionName string) migrations.Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		update := bson.M{"$unset": bson.M{"priority": "", "selectAt": "", "selected": "", "bench": ""}}
+
+		if _, err := db.Collection(collectionName).UpdateMany(ctx, bson.M{}, update); err != nil {
+			return fmt.Errorf("unsetting selection of %s: %w", collectionName, err)
+		}
+
+		return nil
+	}
+}
+
+// snapshotMatches takes the current state of every match without snapshot as
+// the snapshot its later events build on. It runs after the other migrations
+// of the matches, so every match has its status and selection.
+func snapshotMatches(collectionName, eventsCollection, snapshotsCollection string) migrations.Step {
+	return func(ctx context.Context, db *mongo.Database) error {
+		reg := registry.New()
+		if err := reg.Register(domain.MatchSnapshotName, domain.MatchSnapshot{}); err != nil {
+			return fmt.Errorf("registering match snapshot: %w", err)
+		}
+
+		store := eventstore.NewStore(db, eventsCollection, snapshotsCollection, reg)
+
+		cursor, err := db.Collection(collectionName).Find(ctx, bson.M{})
+		if err != nil {
+			return fmt.Errorf("finding matches of %s: %w", collectionName, err)
+		}
+
+		defer func() { _ = cursor.Close(ctx) }()
+
+		for cursor.Next(ctx) {
+			var matchDoc MatchDocument
+			if err := cursor.Decode(&matchDoc); err != nil {
+				return fmt.Errorf("decoding match of %s: %w", collectionName, err)
+			}
+
+			match, err := toDomain(&matchDoc)
+			if err != nil {
+				return fmt.Errorf("mapping match %s: %w", matchDoc.ID, err)
+			}
+
+			if err := store.SaveInitialSnapshot(ctx, match); err != nil {
+				return err
+			}
+		}
+
+		if err := cursor.Err(); err != nil {
+			return fmt.Errorf("iterating over matches of %s: %w", collectionName, err)
+		}
+
+		return nil
+	}
+}
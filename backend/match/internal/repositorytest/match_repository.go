@@ -0,0 +1,290 @@
+package repositorytest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+// MatchRepository is the contract every domain.MatchRepository fulfills.
+// newRepository must return an empty repository on every call.
+func MatchRepository(t *testing.T, newRepository func(t *testing.T) domain.MatchRepository) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("finds saved match", func(t *testing.T) {
+		repository := newRepository(t)
+		match := saveMatch(t, repository)
+
+		found := findMatch(t, repository, match.ID())
+
+		assertSameMatch(t, match, found)
+		assert.Equal(t, match.PendingVersion(), found.Version())
+	})
+
+	t.Run("does not find unknown match", func(t *testing.T) {
+		_, err := newRepository(t).FindByID(ctx, "unknown")
+
+		assert.ErrorIs(t, err, domain.ErrMatchNotFound)
+	})
+
+	t.Run("saves changes", func(t *testing.T) {
+		repository := newRepository(t)
+		match := findMatch(t, repository, saveMatch(t, repository).ID())
+
+		require.NoError(t, match.RespondToInvitation("user-1", true))
+		require.NoError(t, match.RespondToInvitation("user-2", false))
+		require.NoError(t, repository.Save(ctx, match))
+
+		found := findMatch(t, repository, match.ID())
+		assertSameMatch(t, match, found)
+		assert.Equal(t, match.PendingVersion(), found.Version())
+	})
+
+	t.Run("keeps unsaved changes apart", func(t *testing.T) {
+		repository := newRepository(t)
+		match := findMatch(t, repository, saveMatch(t, repository).ID())
+
+		require.NoError(t, match.RespondToInvitation("user-1", true))
+
+		assert.Empty(t, findMatch(t, repository, match.ID()).Registrations())
+	})
+
+	t.Run("rejects save of outdated match", func(t *testing.T) {
+		repository := newRepository(t)
+		id := saveMatch(t, repository).ID()
+		match, outdated := findMatch(t, repository, id), findMatch(t, repository, id)
+
+		require.NoError(t, match.RespondToInvitation("user-1", true))
+		require.NoError(t, repository.Save(ctx, match))
+		require.NoError(t, outdated.RespondToInvitation("user-2", true))
+
+		err := repository.Save(ctx, outdated)
+
+		require.ErrorIs(t, err, ddd.ErrConcurrentModification)
+		registrations := findMatch(t, repository, id).Registrations()
+		require.Len(t, registrations, 1)
+		assert.Equal(t, "user-1", registrations[0].UserID())
+	})
+
+	t.Run("saves only one of concurrent changes", func(t *testing.T) {
+		repository := newRepository(t)
+		id := saveMatch(t, repository).ID()
+
+		users := []string{"user-1", "user-2", "user-3", "user-4", "user-5"}
+
+		var (
+			wg    sync.WaitGroup
+			mu    sync.Mutex
+			saved int
+		)
+
+		matches := make([]*domain.Match, len(users))
+		for i, user := range users {
+			matches[i] = findMatch(t, repository, id)
+			require.NoError(t, matches[i].RespondToInvitation(user, true))
+		}
+
+		for _, match := range matches {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				if err := repository.Save(ctx, match); err == nil {
+					mu.Lock()
+					saved++
+					mu.Unlock()
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, 1, saved)
+		assert.Len(t, findMatch(t, repository, id).Registrations(), 1)
+	})
+
+	t.Run("finds matches of groups by begin", func(t *testing.T) {
+		repository := newRepository(t)
+		later := saveMatchOf(t, repository, "group-1", 48*time.Hour)
+		earlier := saveMatchOf(t, repository, "group-2", 24*time.Hour)
+		saveMatchOf(t, repository, "group-3", 24*time.Hour)
+
+		request := pagination.Request{Limit: 1, Sort: domain.MatchListSchema.DefaultSort}
+
+		first, err := repository.FindAllByGroupIDs(ctx, []string{"group-1", "group-2"}, request)
+		require.NoError(t, err)
+		require.Len(t, first.Items, 1)
+		assertSameMatch(t, earlier, first.Items[0])
+		require.NotNil(t, first.Next)
+
+		request.Cursor = first.Next
+		second, err := repository.FindAllByGroupIDs(ctx, []string{"group-1", "group-2"}, request)
+		require.NoError(t, err)
+		require.Len(t, second.Items, 1)
+		assertSameMatch(t, later, second.Items[0])
+		assert.Nil(t, second.Next)
+	})
+
+	t.Run("filters matches by status and begin", func(t *testing.T) {
+		repository := newRepository(t)
+		finished := saveMatchOf(t, repository, "group-1", -24*time.Hour)
+		planned := saveMatchOf(t, repository, "group-1", 24*time.Hour)
+		cancelled := saveMatchOf(t, repository, "group-1", 48*time.Hour)
+		later := saveMatchOf(t, repository, "group-1", 72*time.Hour)
+
+		require.NoError(t, finished.Finish())
+		require.NoError(t, repository.Save(ctx, finished))
+		require.NoError(t, cancelled.Cancel())
+		require.NoError(t, repository.Save(ctx, cancelled))
+
+		find := func(filters ...pagination.Filter) []string {
+			t.Helper()
+
+			page, err := repository.FindAllByGroupIDs(ctx, []string{"group-1"}, pagination.Request{
+				Limit:   pagination.DefaultLimit,
+				Sort:    domain.MatchListSchema.DefaultSort,
+				Filters: filters,
+			})
+			require.NoError(t, err)
+
+			ids := make([]string, len(page.Items))
+			for i, match := range page.Items {
+				ids[i] = match.ID()
+			}
+
+			return ids
+		}
+
+		status := func(operator pagination.Operator, status domain.MatchStatus) pagination.Filter {
+			return pagination.Filter{Field: "status", Operator: operator, Value: string(status)}
+		}
+
+		assert.Equal(t, []string{finished.ID()}, find(status(pagination.Equal, domain.Finished)))
+		assert.Equal(t, []string{planned.ID(), later.ID()}, find(status(pagination.Equal, domain.Planned)))
+		assert.Equal(t, []string{finished.ID(), planned.ID(), later.ID()}, find(
+			status(pagination.NotEqual, domain.Cancelled),
+		))
+		assert.Equal(t, []string{planned.ID()}, find(
+			status(pagination.Equal, domain.Planned),
+			pagination.Filter{Field: "begin", Operator: pagination.Less, Value: later.Begin()},
+		))
+		assert.Empty(t, find(status(pagination.Equal, "unknown")))
+	})
+
+	t.Run("finds matches due for selection", func(t *testing.T) {
+		repository := newRepository(t)
+		now := time.Now()
+		later := saveMatchOf(t, repository, "group-1", -time.Hour)
+		earlier := saveMatchOf(t, repository, "group-1", -2*time.Hour)
+		selected := saveMatchOf(t, repository, "group-1", -3*time.Hour)
+		cancelled := saveMatchOf(t, repository, "group-1", -4*time.Hour)
+		saveMatchOf(t, repository, "group-1", time.Hour)
+
+		require.NoError(t, selected.SelectPlayers(domain.NewPlayerHistory(nil)))
+		require.NoError(t, repository.Save(ctx, selected))
+		require.NoError(t, cancelled.Cancel())
+		require.NoError(t, repository.Save(ctx, cancelled))
+
+		due, err := repository.FindDueForSelection(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{earlier.ID(), later.ID()}, due)
+		assert.True(t, findMatch(t, repository, selected.ID()).Selected())
+	})
+}
+
+func saveMatch(t *testing.T, repository domain.MatchRepository) *domain.Match {
+	t.Helper()
+
+	location, err := domain.NewLocation("Stadium")
+	require.NoError(t, err)
+
+	playerCount, err := domain.NewPlayerCount(4, 10)
+	require.NoError(t, err)
+
+	// matches are stored to the millisecond
+	begin := time.Now().Add(24 * time.Hour).Truncate(time.Millisecond)
+
+	selection, err := domain.NewSelection(domain.RoundRobin, domain.DefaultCutoff)
+	require.NoError(t, err)
+
+	match, err := domain.CreateNewMatch(begin, location, playerCount, selection, "group-1")
+	require.NoError(t, err)
+	require.NoError(t, repository.Save(context.Background(), match))
+
+	return match
+}
+
+// saveMatchOf saves a match of the group beginning in the given time from
+// now, which may have passed already.
+func saveMatchOf(t *testing.T, repository domain.MatchRepository, groupID string, in time.Duration) *domain.Match {
+	t.Helper()
+
+	location, err := domain.NewLocation("Stadium")
+	require.NoError(t, err)
+
+	playerCount, err := domain.NewPlayerCount(4, 10)
+	require.NoError(t, err)
+
+	selection, err := domain.NewSelection(domain.FirstComeFirstServe, 0)
+	require.NoError(t, err)
+
+	begin := time.Now().Add(in).Truncate(time.Millisecond)
+	match := domain.NewMatch(
+		uuid.New().String(),
+		groupID,
+		begin,
+		location,
+		playerCount,
+		selection,
+		false,
+		nil,
+		domain.Planned,
+		nil,
+		nil,
+	)
+	require.NoError(t, repository.Save(context.Background(), match))
+
+	return match
+}
+
+func findMatch(t *testing.T, repository domain.MatchRepository, id string) *domain.Match {
+	t.Helper()
+
+	match, err := repository.FindByID(context.Background(), id)
+	require.NoError(t, err)
+
+	return match
+}
+
+func assertSameMatch(t *testing.T, expected, actual *domain.Match) {
+	t.Helper()
+
+	assert.Equal(t, expected.ID(), actual.ID())
+	assert.Equal(t, expected.GroupID(), actual.GroupID())
+	assert.WithinDuration(t, expected.Begin(), actual.Begin(), 0)
+	assert.Equal(t, expected.Location().Name(), actual.Location().Name())
+	assert.Equal(t, expected.PlayerCount().Min(), actual.PlayerCount().Min())
+	assert.Equal(t, expected.PlayerCount().Max(), actual.PlayerCount().Max())
+	assert.Equal(t, expected.Selection(), actual.Selection())
+	assert.Equal(t, expected.Selected(), actual.Selected())
+	require.Len(t, actual.Registrations(), len(expected.Registrations()))
+
+	for i, registration := range expected.Registrations() {
+		assert.Equal(t, registration.UserID(), actual.Registrations()[i].UserID())
+		assert.Equal(t, registration.Status(), actual.Registrations()[i].Status())
+		assert.WithinDuration(t, registration.TimeStamp(), actual.Registrations()[i].TimeStamp(), time.Millisecond)
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
 )
@@ -19,26 +19,24 @@ import (
 // @Produce      json
 // @Success      201  {object}  Response
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /match/registration [put].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if err := validator.New().Struct(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		addingUserID, ok := ginconfig.ActingUserID(context, message.AddingUserID)
+		if !ok {
 			return
 		}
 
-		if err := app.AddRegistration(toCommand(&message)); err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+		if err := app.AddRegistration(context.Request.Context(), toCommand(addingUserID, &message)); err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
@@ -47,10 +45,10 @@ func Handle(app application.App) gin.HandlerFunc {
 	}
 }
 
-func toCommand(message *Message) *commands.AddRegistration {
+func toCommand(addingUserID string, message *Message) *commands.AddRegistration {
 	return &commands.AddRegistration{
 		UserID:       message.UserID,
 		MatchID:      message.MatchID,
-		AddingUserID: message.AddingUserID,
+		AddingUserID: addingUserID,
 	}
 }
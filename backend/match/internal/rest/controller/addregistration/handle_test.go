@@ -0,0 +1,78 @@
+package addregistration
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+)
+
+type mockApp struct {
+	application.App
+	mock.Mock
+}
+
+func (m *mockApp) AddRegistration(ctx context.Context, cmd *commands.AddRegistration) error {
+	return m.Called(ctx, cmd).Error(0)
+}
+
+func TestHandle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		payload string
+		want    int
+	}{
+		{
+			name:    "adding user from token",
+			payload: `{"userId": "user-2", "matchId": "match-1"}`,
+			want:    http.StatusCreated,
+		},
+		{
+			name:    "adding user is someone else",
+			payload: `{"userId": "user-2", "matchId": "match-1", "addingUserId": "user-2"}`,
+			want:    http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &mockApp{}
+			app.On("AddRegistration", mock.Anything, &commands.AddRegistration{
+				UserID:       "user-2",
+				MatchID:      "match-1",
+				AddingUserID: "user-1",
+			}).Return(nil)
+
+			router := gin.New()
+			router.Use(ginconfig.ErrorHandler(slog.Default()))
+			router.PUT("/match/registration", func(c *gin.Context) {
+				c.Set(ginconfig.UserIDKey, "user-1")
+			}, Handle(app))
+
+			req := httptest.NewRequest(http.MethodPut, "/match/registration", bytes.NewBufferString(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+
+			if tt.want == http.StatusCreated {
+				app.AssertExpectations(t)
+			} else {
+				app.AssertNotCalled(t, "AddRegistration", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
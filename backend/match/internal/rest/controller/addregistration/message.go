@@ -3,5 +3,5 @@ package addregistration
 type Message struct {
 	UserID       string `json:"userId"     validate:"required"`
 	MatchID      string `json:"matchId"    validate:"required"`
-	AddingUserID string `json:"addingUserId" validate:"required"`
+	AddingUserID string `json:"addingUserId"`
 }
@@ -0,0 +1,43 @@
+package cancelmatch
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+)
+
+// Handle
+// CancelMatch godoc
+// @Summary      cancels a match
+// @Description  cancels a planned match and notifies the registered players
+// @Tags         match
+// @Accept       json
+// @Produce      json
+// @Success      200
+// @Failure      403
+// @Failure      404
+// @Failure      409
+// @Failure      500
+// @Router       /match/{matchId}/cancel [put].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		userID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		command := &commands.CancelMatch{UserID: userID, MatchID: context.Param("matchId")}
+
+		if err := app.CancelMatch(context.Request.Context(), command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, nil)
+	}
+}
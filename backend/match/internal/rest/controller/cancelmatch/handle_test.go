@@ -0,0 +1,85 @@
+package cancelmatch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type mockApp struct {
+	application.App
+	mock.Mock
+}
+
+func (m *mockApp) CancelMatch(ctx context.Context, cmd *commands.CancelMatch) error {
+	return m.Called(ctx, cmd).Error(0)
+}
+
+func newRouter(app application.App) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
+	router.PUT("/match/:matchId/cancel", func(c *gin.Context) {
+		c.Set(ginconfig.UserIDKey, "user-1")
+	}, Handle(app))
+
+	return router
+}
+
+func TestHandle(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		want        int
+		contentType string
+	}{
+		{
+			name:        "planned match",
+			want:        http.StatusOK,
+			contentType: "application/json; charset=utf-8",
+		},
+		{
+			name:        "match already played",
+			err:         domain.ErrInvalidTransition,
+			want:        http.StatusConflict,
+			contentType: "application/problem+json",
+		},
+		{
+			name:        "not an admin",
+			err:         fmt.Errorf("authorizing %s: %w", policy.ManageMatch, policy.ErrInsufficientRole),
+			want:        http.StatusForbidden,
+			contentType: "application/problem+json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &mockApp{}
+			app.On("CancelMatch", mock.Anything, &commands.CancelMatch{
+				UserID:  "user-1",
+				MatchID: "match-1",
+			}).Return(tt.err)
+
+			req := httptest.NewRequest(http.MethodPut, "/match/match-1/cancel", nil)
+			rec := httptest.NewRecorder()
+			newRouter(app).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+			assert.Equal(t, tt.contentType, rec.Header().Get("Content-Type"))
+			app.AssertExpectations(t)
+		})
+	}
+}
@@ -1,18 +1,26 @@
 package creatematch
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
 	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
 )
 
+var fields = ginconfig.FieldMapping{
+	domain.ErrLocationInvalid:   "location",
+	domain.ErrInvalidMinPlayers: "minPlayers",
+	domain.ErrInvalidMaxPlayers: "maxPlayers",
+	domain.ErrUnknownPriority:   "priority",
+}
+
 // Handle
 // CreateMatch godoc
 // @Summary      creates new match
@@ -22,34 +30,32 @@ import (
 // @Produce      json
 // @Success      201  {object}  Response
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /match [post].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if err := validator.New().Struct(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		userID, ok := ginconfig.ActingUserID(context, message.UserID)
+		if !ok {
 			return
 		}
 
-		command, err := toCommand(&message)
+		command, err := toCommand(userID, &message)
 		if err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
+			ginconfig.AbortWithProblem(context, fields.Map(err))
 
 			return
 		}
 
-		result, err := app.CreateMatch(command)
+		result, err := app.CreateMatch(context.Request.Context(), command)
 		if err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
@@ -58,28 +64,37 @@ func Handle(app application.App) gin.HandlerFunc {
 	}
 }
 
-func toCommand(message *Message) (*commands.CreateMatch, error) {
+func toCommand(userID string, message *Message) (*commands.CreateMatch, error) {
 	dateTime, err := time.Parse(time.RFC3339, message.Begin)
 	if err != nil {
 		return nil, fmt.Errorf("parse date time: %w", err)
 	}
 
-	location, err := domain.NewLocation(message.Location)
-	if err != nil {
-		return nil, fmt.Errorf("create location: %w", err)
+	location, locationErr := domain.NewLocation(message.Location)
+	playerCount, playerCountErr := domain.NewPlayerCount(message.MinPlayers, message.MaxPlayers)
+
+	var priorityErr error
+	if message.Priority != "" {
+		_, priorityErr = domain.ToPriority(message.Priority)
 	}
 
-	playerCount, err := domain.NewPlayerCount(message.MinPlayers, message.MaxPlayers)
-	if err != nil {
-		return nil, fmt.Errorf("create player count: %w", err)
+	if err := errors.Join(locationErr, playerCountErr, priorityErr); err != nil {
+		return nil, err
+	}
+
+	cutoff := domain.DefaultCutoff
+	if message.CutoffMinutes != nil {
+		cutoff = time.Duration(*message.CutoffMinutes) * time.Minute
 	}
 
 	return &commands.CreateMatch{
-		UserID:      message.UserID,
+		UserID:      userID,
 		GroupID:     message.GroupID,
 		Begin:       dateTime,
 		Location:    location,
 		PlayerCount: playerCount,
+		Priority:    message.Priority,
+		Cutoff:      cutoff,
 	}, nil
 }
 
@@ -1,10 +1,14 @@
 package creatematch
 
+// Message selects the players by the priority of the group and a day before
+// the begin unless Priority or CutoffMinutes are given.
 type Message struct {
-	UserID     string `json:"userId"     validate:"required"`
-	GroupID    string `json:"groupId"    validate:"required"`
-	Begin      string `json:"begin"      validate:"required"`
-	Location   string `json:"location"   validate:"required"`
-	MaxPlayers int    `json:"maxPlayers" validate:"required"`
-	MinPlayers int    `json:"minPlayers" validate:"required"`
+	UserID        string `json:"userId"`
+	GroupID       string `json:"groupId"       validate:"required"`
+	Begin         string `json:"begin"         validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Location      string `json:"location"      validate:"required"`
+	MaxPlayers    int    `json:"maxPlayers"    validate:"required"`
+	MinPlayers    int    `json:"minPlayers"    validate:"required"`
+	Priority      string `json:"priority"`
+	CutoffMinutes *int   `json:"cutoffMinutes" validate:"omitempty,min=0"`
 }
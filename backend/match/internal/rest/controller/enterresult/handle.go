@@ -0,0 +1,59 @@
+package enterresult
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+// Handle
+// EnterResult godoc
+// @Summary      enters the result of a match
+// @Description  enters or corrects the result of a finished match and notifies the registered players
+// @Tags         match
+// @Accept       json
+// @Produce      json
+// @Param        message  body  Message  true  "goals of both teams"
+// @Success      200
+// @Failure      400
+// @Failure      403
+// @Failure      404
+// @Failure      409
+// @Failure      500
+// @Router       /match/{matchId}/result [put].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var message Message
+
+		if !ginconfig.BindJSON(context, &message) {
+			return
+		}
+
+		userID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		result, err := domain.NewResult(*message.TeamAGoals, *message.TeamBGoals)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		command := &commands.EnterResult{UserID: userID, MatchID: context.Param("matchId"), Result: result}
+
+		if err := app.EnterResult(context.Request.Context(), command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, nil)
+	}
+}
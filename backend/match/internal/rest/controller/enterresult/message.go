@@ -0,0 +1,6 @@
+package enterresult
+
+type Message struct {
+	TeamAGoals *int `json:"teamAGoals" validate:"required,min=0"`
+	TeamBGoals *int `json:"teamBGoals" validate:"required,min=0"`
+}
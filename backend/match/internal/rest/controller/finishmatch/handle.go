@@ -0,0 +1,43 @@
+package finishmatch
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+)
+
+// Handle
+// FinishMatch godoc
+// @Summary      finishes a match
+// @Description  marks a match as played once it began and notifies the registered players
+// @Tags         match
+// @Accept       json
+// @Produce      json
+// @Success      200
+// @Failure      403
+// @Failure      404
+// @Failure      409
+// @Failure      500
+// @Router       /match/{matchId}/finish [put].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		userID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		command := &commands.FinishMatch{UserID: userID, MatchID: context.Param("matchId")}
+
+		if err := app.FinishMatch(context.Request.Context(), command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, nil)
+	}
+}
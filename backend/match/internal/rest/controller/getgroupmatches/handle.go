@@ -0,0 +1,75 @@
+package getgroupmatches
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/queries"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+// Handle
+// GetGroupMatches godoc
+// @Summary      get matches of a group
+// @Description  get matches of a group, earliest first by default
+// @Tags         match
+// @Accept       json
+// @Produce      json
+// @Param        limit   query  int       false  "page size, 1 to 100"
+// @Param        sort    query  string    false  "begin or -begin"
+// @Param        filter  query  []string  false  "field:operator:value, e.g. status:eq:planned"
+// @Param        cursor  query  string    false  "cursor of the next or prev link"
+// @Success      200  {object}  pagination.Response[Response]
+// @Failure      400
+// @Failure      403
+// @Failure      500
+// @Router       /match/group/{groupId} [get].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		userID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		page, ok := pagination.FromQuery(context, domain.MatchListSchema)
+		if !ok {
+			return
+		}
+
+		query := &queries.GetMatchesByGroup{
+			GroupID: context.Param("groupId"),
+			UserID:  userID,
+			Page:    page,
+		}
+
+		matches, err := app.GetMatchesByGroup(context.Request.Context(), query)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, pagination.NewResponse(context, matches, toResponse(matches.Items)))
+	}
+}
+
+func toResponse(matches []*domain.Match) []*Response {
+	response := make([]*Response, len(matches))
+	for i, match := range matches {
+		response[i] = &Response{
+			ID:         match.ID(),
+			GroupID:    match.GroupID(),
+			Begin:      match.Begin(),
+			Location:   match.Location().Name(),
+			MinPlayers: match.PlayerCount().Min(),
+			MaxPlayers: match.PlayerCount().Max(),
+			Status:     string(match.Status()),
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,75 @@
+package getmatch
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/queries"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+// Handle
+// GetMatch godoc
+// @Summary      get match details by match id
+// @Description  get match details with the nicknames of the registered users
+// @Tags         match
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  Response
+// @Failure      403
+// @Failure      404
+// @Failure      500
+// @Router       /match/{matchId} [get].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		userID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		query := &queries.GetMatch{MatchID: context.Param("matchId"), UserID: userID}
+
+		match, err := app.GetMatch(context.Request.Context(), query)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, toResponse(match))
+	}
+}
+
+func toResponse(match *domain.MatchDetails) *Response {
+	registrations := make([]*Registration, len(match.Registrations()))
+	for i, registration := range match.Registrations() {
+		registrations[i] = &Registration{
+			UserID:    registration.UserID(),
+			NickName:  match.NickName(registration.UserID()),
+			Status:    registration.Status().String(),
+			TimeStamp: registration.TimeStamp(),
+		}
+	}
+
+	var result *Result
+	if match.Result() != nil {
+		result = &Result{TeamAGoals: match.Result().TeamAGoals(), TeamBGoals: match.Result().TeamBGoals()}
+	}
+
+	return &Response{
+		ID:            match.ID(),
+		GroupID:       match.GroupID(),
+		Begin:         match.Begin(),
+		Location:      match.Location().Name(),
+		MinPlayers:    match.PlayerCount().Min(),
+		MaxPlayers:    match.PlayerCount().Max(),
+		Priority:      string(match.Selection().Priority()),
+		SelectAt:      match.SelectAt(),
+		Status:        string(match.Status()),
+		Result:        result,
+		Registrations: registrations,
+	}
+}
@@ -0,0 +1,29 @@
+package getmatch
+
+import "time"
+
+type Response struct {
+	ID            string          `json:"id"`
+	GroupID       string          `json:"groupId"`
+	Begin         time.Time       `json:"begin"`
+	Location      string          `json:"location"`
+	MinPlayers    int             `json:"minPlayers"`
+	MaxPlayers    int             `json:"maxPlayers"`
+	Priority      string          `json:"priority"`
+	SelectAt      time.Time       `json:"selectAt"`
+	Status        string          `json:"status"`
+	Result        *Result         `json:"result,omitempty"`
+	Registrations []*Registration `json:"registrations"`
+}
+
+type Result struct {
+	TeamAGoals int `json:"teamAGoals"`
+	TeamBGoals int `json:"teamBGoals"`
+}
+
+type Registration struct {
+	UserID    string    `json:"userId"`
+	NickName  string    `json:"nickName"`
+	Status    string    `json:"status"`
+	TimeStamp time.Time `json:"timeStamp"`
+}
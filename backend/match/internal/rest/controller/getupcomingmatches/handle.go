@@ -0,0 +1,71 @@
+package getupcomingmatches
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/queries"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+// Handle
+// GetUpcomingMatches godoc
+// @Summary      get upcoming matches of a user
+// @Description  get upcoming matches of all groups the user is an active player of, earliest first by default
+// @Tags         match
+// @Accept       json
+// @Produce      json
+// @Param        limit   query  int       false  "page size, 1 to 100"
+// @Param        sort    query  string    false  "begin or -begin"
+// @Param        filter  query  []string  false  "field:operator:value, e.g. begin:lt:2026-01-01T00:00:00Z"
+// @Param        cursor  query  string    false  "cursor of the next or prev link"
+// @Success      200  {object}  pagination.Response[Response]
+// @Failure      400
+// @Failure      403
+// @Failure      500
+// @Router       /match/user/{userId} [get].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		userID, ok := ginconfig.ActingUserID(context, context.Param("userId"))
+		if !ok {
+			return
+		}
+
+		page, ok := pagination.FromQuery(context, domain.MatchListSchema)
+		if !ok {
+			return
+		}
+
+		query := &queries.GetUpcomingMatches{UserID: userID, Page: page}
+
+		matches, err := app.GetUpcomingMatches(context.Request.Context(), query)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, pagination.NewResponse(context, matches, toResponse(matches.Items)))
+	}
+}
+
+func toResponse(matches []*domain.Match) []*Response {
+	response := make([]*Response, len(matches))
+	for i, match := range matches {
+		response[i] = &Response{
+			ID:         match.ID(),
+			GroupID:    match.GroupID(),
+			Begin:      match.Begin(),
+			Location:   match.Location().Name(),
+			MinPlayers: match.PlayerCount().Min(),
+			MaxPlayers: match.PlayerCount().Max(),
+			Status:     string(match.Status()),
+		}
+	}
+
+	return response
+}
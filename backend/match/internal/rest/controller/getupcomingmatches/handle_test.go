@@ -0,0 +1,74 @@
+package getupcomingmatches
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/queries"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type mockApp struct {
+	application.App
+	mock.Mock
+}
+
+func (m *mockApp) GetUpcomingMatches(
+	ctx context.Context,
+	cmd *queries.GetUpcomingMatches,
+) (pagination.Page[*domain.Match], error) {
+	args := m.Called(ctx, cmd)
+
+	return args.Get(0).(pagination.Page[*domain.Match]), args.Error(1)
+}
+
+func TestHandle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		target string
+		want   int
+	}{
+		{name: "own matches", target: "/match/user/user-1", want: http.StatusOK},
+		{name: "matches of someone else", target: "/match/user/user-2", want: http.StatusForbidden},
+		{name: "unknown filter", target: "/match/user/user-1?filter=location:eq:Stadium", want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &mockApp{}
+			app.On("GetUpcomingMatches", mock.Anything, &queries.GetUpcomingMatches{
+				UserID: "user-1",
+				Page:   pagination.Request{Limit: pagination.DefaultLimit, Sort: domain.MatchListSchema.DefaultSort},
+			}).Return(pagination.Page[*domain.Match]{}, nil)
+
+			router := gin.New()
+			router.Use(ginconfig.ErrorHandler(slog.Default()))
+			router.GET("/match/user/:userId", func(c *gin.Context) {
+				c.Set(ginconfig.UserIDKey, "user-1")
+			}, Handle(app))
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			assert.Equal(t, tt.want, rec.Code)
+
+			if tt.want == http.StatusOK {
+				app.AssertExpectations(t)
+			} else {
+				app.AssertNotCalled(t, "GetUpcomingMatches", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
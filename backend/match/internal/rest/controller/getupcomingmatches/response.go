@@ -0,0 +1,13 @@
+package getupcomingmatches
+
+import "time"
+
+type Response struct {
+	ID         string    `json:"id"`
+	GroupID    string    `json:"groupId"`
+	Begin      time.Time `json:"begin"`
+	Location   string    `json:"location"`
+	MinPlayers int       `json:"minPlayers"`
+	MaxPlayers int       `json:"maxPlayers"`
+	Status     string    `json:"status"`
+}
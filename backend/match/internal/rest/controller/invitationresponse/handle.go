@@ -4,8 +4,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
 )
@@ -19,26 +19,24 @@ import (
 // @Produce      json
 // @Success      201  {object}  Response
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /match/registration [post].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if err := validator.New().Struct(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		respondingPlayerID, ok := ginconfig.ActingUserID(context, message.RespondingPlayerID)
+		if !ok {
 			return
 		}
 
-		if err := app.RespondToInvitation(toCommand(&message)); err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+		if err := app.RespondToInvitation(context.Request.Context(), toCommand(respondingPlayerID, &message)); err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
@@ -47,11 +45,11 @@ func Handle(app application.App) gin.HandlerFunc {
 	}
 }
 
-func toCommand(message *Message) *commands.RespondToInvitation {
+func toCommand(respondingPlayerID string, message *Message) *commands.RespondToInvitation {
 	return &commands.RespondToInvitation{
 		MatchID:            message.MatchID,
 		Accept:             message.Accept,
-		RespondingPlayerID: message.RespondingPlayerID,
+		RespondingPlayerID: respondingPlayerID,
 		PlayerID:           message.PlayerID,
 	}
 }
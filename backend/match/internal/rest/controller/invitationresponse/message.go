@@ -3,6 +3,6 @@ package invitationresponse
 type Message struct {
 	MatchID            string `json:"match_id" validate:"required"`
 	Accept             bool   `json:"accept" validate:"required"`
-	RespondingPlayerID string `json:"respondingPlayerId" validate:"required"`
+	RespondingPlayerID string `json:"respondingPlayerId"`
 	PlayerID           string `json:"playerId" validate:"required"`
 }
@@ -0,0 +1,63 @@
+package relocatematch
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+var fields = ginconfig.FieldMapping{
+	domain.ErrLocationInvalid: "location",
+}
+
+// Handle
+// RelocateMatch godoc
+// @Summary      relocates a match
+// @Description  moves a planned match to a new location and notifies the registered players
+// @Tags         match
+// @Accept       json
+// @Produce      json
+// @Param        message  body  Message  true  "new location of the match"
+// @Success      200
+// @Failure      400
+// @Failure      403
+// @Failure      404
+// @Failure      409
+// @Failure      500
+// @Router       /match/{matchId}/location [put].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var message Message
+
+		if !ginconfig.BindJSON(context, &message) {
+			return
+		}
+
+		userID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		location, err := domain.NewLocation(message.Location)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, fields.Map(err))
+
+			return
+		}
+
+		command := &commands.RelocateMatch{UserID: userID, MatchID: context.Param("matchId"), Location: location}
+
+		if err := app.RelocateMatch(context.Request.Context(), command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
+
+			return
+		}
+
+		context.JSON(http.StatusOK, nil)
+	}
+}
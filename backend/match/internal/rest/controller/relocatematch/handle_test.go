@@ -0,0 +1,93 @@
+package relocatematch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+type mockApp struct {
+	application.App
+	mock.Mock
+}
+
+func (m *mockApp) RelocateMatch(ctx context.Context, cmd *commands.RelocateMatch) error {
+	return m.Called(ctx, cmd).Error(0)
+}
+
+func newRouter(app application.App) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
+	router.PUT("/match/:matchId/location", func(c *gin.Context) {
+		c.Set(ginconfig.UserIDKey, "user-1")
+	}, Handle(app))
+
+	return router
+}
+
+func TestHandle(t *testing.T) {
+	location, err := domain.NewLocation("Stadium")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		err         error
+		want        int
+		contentType string
+	}{
+		{
+			name:        "planned match",
+			want:        http.StatusOK,
+			contentType: "application/json; charset=utf-8",
+		},
+		{
+			name:        "match already played",
+			err:         domain.ErrInvalidTransition,
+			want:        http.StatusConflict,
+			contentType: "application/problem+json",
+		},
+		{
+			name:        "not an admin",
+			err:         fmt.Errorf("authorizing %s: %w", policy.ManageMatch, policy.ErrInsufficientRole),
+			want:        http.StatusForbidden,
+			contentType: "application/problem+json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &mockApp{}
+			app.On("RelocateMatch", mock.Anything, &commands.RelocateMatch{
+				UserID:   "user-1",
+				MatchID:  "match-1",
+				Location: location,
+			}).Return(tt.err)
+
+			payload := bytes.NewBufferString(`{"location": "Stadium"}`)
+			req := httptest.NewRequest(http.MethodPut, "/match/match-1/location", payload)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			newRouter(app).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+			assert.Equal(t, tt.contentType, rec.Header().Get("Content-Type"))
+			app.AssertExpectations(t)
+		})
+	}
+}
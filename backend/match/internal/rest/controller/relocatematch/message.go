@@ -0,0 +1,5 @@
+package relocatematch
+
+type Message struct {
+	Location string `json:"location" validate:"required"`
+}
@@ -3,10 +3,11 @@ package removeregistration
 import (
 	"net/http"
 
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
-	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 )
 
 // Handle
@@ -18,26 +19,24 @@ import (
 // @Produce      json
 // @Success      200  {object}  Response
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /match/registration [delete].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if err := validator.New().Struct(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		removingUserID, ok := ginconfig.ActingUserID(context, message.DeletingUserID)
+		if !ok {
 			return
 		}
 
-		if err := app.RemoveRegistration(toCommand(&message)); err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+		if err := app.RemoveRegistration(context.Request.Context(), toCommand(removingUserID, &message)); err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
@@ -46,10 +45,10 @@ func Handle(app application.App) gin.HandlerFunc {
 	}
 }
 
-func toCommand(message *Message) *commands.RemoveRegistration {
+func toCommand(removingUserID string, message *Message) *commands.RemoveRegistration {
 	return &commands.RemoveRegistration{
 		UserID:         message.UserID,
 		MatchID:        message.MatchID,
-		RemovingUserID: message.DeletingUserID,
+		RemovingUserID: removingUserID,
 	}
 }
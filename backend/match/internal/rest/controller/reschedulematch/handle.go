@@ -0,0 +1,65 @@
+package reschedulematch
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/application/commands"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
+)
+
+var fields = ginconfig.FieldMapping{
+	domain.ErrBeginInPast: "begin",
+}
+
+// Handle
+// RescheduleMatch godoc
+// @Summary      reschedules a match
+// @Description  moves a planned match to a new begin and notifies the registered players
+// @Tags         match
+// @Accept       json
+// @Produce      json
+// @Param        message  body  Message  true  "new begin of the match"
+// @Success      200
+// @Failure      400
+// @Failure      403
+// @Failure      404
+// @Failure      409
+// @Failure      500
+// @Router       /match/{matchId}/begin [put].
+func Handle(app application.App) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var message Message
+
+		if !ginconfig.BindJSON(context, &message) {
+			return
+		}
+
+		userID, ok := ginconfig.ActingUserID(context)
+		if !ok {
+			return
+		}
+
+		begin, err := time.Parse(time.RFC3339, message.Begin)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, fmt.Errorf("parse date time: %w", err))
+
+			return
+		}
+
+		command := &commands.RescheduleMatch{UserID: userID, MatchID: context.Param("matchId"), Begin: begin}
+
+		if err := app.RescheduleMatch(context.Request.Context(), command); err != nil {
+			ginconfig.AbortWithProblem(context, fields.Map(err))
+
+			return
+		}
+
+		context.JSON(http.StatusOK, nil)
+	}
+}
@@ -0,0 +1,5 @@
+package reschedulematch
+
+type Message struct {
+	Begin string `json:"begin" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
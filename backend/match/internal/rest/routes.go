@@ -1,24 +1,46 @@
 package rest
 
 import (
-	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
-	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/removeregistration"
 	"github.com/gin-gonic/gin"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/idempotency"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application"
 	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/addregistration"
+	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/cancelmatch"
 	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/creatematch"
+	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/enterresult"
+	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/finishmatch"
+	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/getgroupmatches"
+	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/getmatch"
+	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/getupcomingmatches"
 	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/invitationresponse"
+	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/relocatematch"
+	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/removeregistration"
+	"github.com/FSpruhs/kick-app/backend/match/internal/rest/controller/reschedulematch"
 )
 
-func MatchRoutes(router *gin.Engine, app application.App) {
+func MatchRoutes(
+	router *gin.Engine,
+	app application.App,
+	verifier *ginconfig.TokenVerifier,
+	keys *idempotency.Keys,
+) {
 	api := router.Group("/api/v1")
-	api.Use(ginconfig.JWTValidator())
+	api.Use(ginconfig.JWTValidator(verifier))
 	api.Use(ginconfig.UserIDExtractor())
 	{
-		api.POST("/match", creatematch.Handle(app))
-		api.POST("/match/registration", invitationresponse.Handle(app))
-		api.PUT("/match/registration", addregistration.Handle(app))
-		api.DELETE("/match/registration", removeregistration.Handle(app))
+		api.POST("/match", keys.GinMiddleware(), creatematch.Handle(app))
+		api.POST("/match/registration", keys.GinMiddleware(), invitationresponse.Handle(app))
+		api.PUT("/match/registration", keys.GinMiddleware(), addregistration.Handle(app))
+		api.DELETE("/match/registration", keys.GinMiddleware(), removeregistration.Handle(app))
+		api.GET("/match/:matchId", getmatch.Handle(app))
+		api.PUT("/match/:matchId/cancel", keys.GinMiddleware(), cancelmatch.Handle(app))
+		api.PUT("/match/:matchId/begin", keys.GinMiddleware(), reschedulematch.Handle(app))
+		api.PUT("/match/:matchId/location", keys.GinMiddleware(), relocatematch.Handle(app))
+		api.PUT("/match/:matchId/finish", keys.GinMiddleware(), finishmatch.Handle(app))
+		api.PUT("/match/:matchId/result", keys.GinMiddleware(), enterresult.Handle(app))
+		api.GET("/match/group/:groupId", getgroupmatches.Handle(app))
+		api.GET("/match/user/:userId", getupcomingmatches.Handle(app))
 	}
 }
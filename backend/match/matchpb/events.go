@@ -1,8 +1,89 @@
 package matchpb
 
-const MatchCreatedEvent = "match.MatchCreated"
+import "time"
 
+const (
+	MatchCreatedEvent        = "match.MatchCreated"
+	RegistrationChangedEvent = "match.RegistrationChanged"
+	PlayerPromotedEvent      = "match.PlayerPromoted"
+	PlayersSelectedEvent     = "match.PlayersSelected"
+	MatchCancelledEvent      = "match.MatchCancelled"
+	MatchRescheduledEvent    = "match.MatchRescheduled"
+	MatchRelocatedEvent      = "match.MatchRelocated"
+	MatchFinishedEvent       = "match.MatchFinished"
+	MatchResultEnteredEvent  = "match.MatchResultEntered"
+)
+
+// MatchCreated names the priority the players are selected by at the cutoff
+// before the begin. Matches created before had neither.
 type MatchCreated struct {
+	MatchID   string
+	GroupID   string
+	Begin     time.Time
+	Location  string
+	PlayerMin int
+	PlayerMax int
+	Priority  string
+	Cutoff    time.Duration
+}
+
+type RegistrationChanged struct {
+	MatchID   string
+	UserID    string
+	Status    string
+	TimeStamp time.Time
+}
+
+// PlayerPromoted moves a player from the bench into a slot which became free.
+type PlayerPromoted struct {
+	MatchID string
+	GroupID string
+	UserID  string
+}
+
+// PlayersSelected is the outcome of the priority at the cutoff. Bench is the
+// order the benched players are promoted in.
+type PlayersSelected struct {
+	MatchID  string
+	GroupID  string
+	Promoted []string
+	Benched  []string
+	Bench    []string
+}
+
+// The events of the lifecycle of a match name the users registered for it
+// when it happened, so they can be notified.
+
+type MatchCancelled struct {
 	MatchID string
 	GroupID string
+	UserIDs []string
+}
+
+type MatchRescheduled struct {
+	MatchID string
+	GroupID string
+	Begin   time.Time
+	UserIDs []string
+}
+
+type MatchRelocated struct {
+	MatchID  string
+	GroupID  string
+	Location string
+	UserIDs  []string
+}
+
+type MatchFinished struct {
+	MatchID string
+	GroupID string
+	UserIDs []string
+}
+
+type MatchResultEntered struct {
+	MatchID    string
+	GroupID    string
+	TeamAGoals int
+	TeamBGoals int
+	UserIDs    []string
 }
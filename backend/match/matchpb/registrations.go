@@ -0,0 +1,29 @@
+package matchpb
+
+import (
+	"fmt"
+
+	"github.com/FSpruhs/kick-app/backend/internal/registry"
+)
+
+func Registrations(reg registry.Registry) error {
+	events := map[string]any{
+		MatchCreatedEvent:        MatchCreated{},
+		RegistrationChangedEvent: RegistrationChanged{},
+		PlayerPromotedEvent:      PlayerPromoted{},
+		PlayersSelectedEvent:     PlayersSelected{},
+		MatchCancelledEvent:      MatchCancelled{},
+		MatchRescheduledEvent:    MatchRescheduled{},
+		MatchRelocatedEvent:      MatchRelocated{},
+		MatchFinishedEvent:       MatchFinished{},
+		MatchResultEnteredEvent:  MatchResultEntered{},
+	}
+
+	for name, payload := range events {
+		if err := reg.Register(name, payload); err != nil {
+			return fmt.Errorf("register match event: %w", err)
+		}
+	}
+
+	return nil
+}
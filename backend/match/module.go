@@ -2,29 +2,119 @@ package match
 
 import (
 	"fmt"
+	"slices"
 
+	"github.com/FSpruhs/kick-app/backend/internal/config"
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/eventstore"
+	"github.com/FSpruhs/kick-app/backend/internal/health"
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+	"github.com/FSpruhs/kick-app/backend/internal/migrations"
 	"github.com/FSpruhs/kick-app/backend/internal/monolith"
+	"github.com/FSpruhs/kick-app/backend/internal/outbox"
+	"github.com/FSpruhs/kick-app/backend/internal/requestid"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
 	"github.com/FSpruhs/kick-app/backend/match/internal/application"
+	"github.com/FSpruhs/kick-app/backend/match/internal/domain"
 	"github.com/FSpruhs/kick-app/backend/match/internal/grpc"
+	"github.com/FSpruhs/kick-app/backend/match/internal/memory"
 	"github.com/FSpruhs/kick-app/backend/match/internal/mongodb"
 	"github.com/FSpruhs/kick-app/backend/match/internal/rest"
+	"github.com/FSpruhs/kick-app/backend/match/matchpb"
+)
+
+const (
+	matchesCollection   = "match.matches"
+	eventsCollection    = "match.events"
+	snapshotsCollection = "match.snapshots"
+	outboxCollection    = "match.outbox"
 )
 
 type Module struct{}
 
+var (
+	_ migrations.Source = (*Module)(nil)
+	_ indexes.Source    = (*Module)(nil)
+)
+
+func (m *Module) Migrations() []migrations.Migration {
+	return mongodb.Migrations(matchesCollection, eventsCollection, snapshotsCollection)
+}
+
+func (m *Module) Indexes() []indexes.Index {
+	return slices.Concat(
+		mongodb.Indexes(matchesCollection),
+		eventstore.Indexes(eventsCollection, snapshotsCollection),
+		outbox.Indexes(outboxCollection),
+	)
+}
+
 func (m *Module) Startup(mono monolith.Monolith) error {
-	matches := mongodb.NewMatchRepository(mono.DB(), "match.matches")
+	if err := matchpb.Registrations(mono.Registry()); err != nil {
+		return fmt.Errorf("register match events: %w", err)
+	}
+
+	if err := mono.Registry().Register(domain.MatchSnapshotName, domain.MatchSnapshot{}); err != nil {
+		return fmt.Errorf("register match snapshot: %w", err)
+	}
+
+	matches, publisher := newRepository(mono)
 
-	conn, err := grpc.NewClient(mono.Config().RPC.Address())
+	conn, err := grpc.NewClient(
+		mono.Config().RPC.Address(),
+		mono.Metrics().DialOption(),
+		tracing.DialOption(),
+		requestid.DialOption(),
+	)
 	if err != nil {
 		return fmt.Errorf("connect to rpc server: %w", err)
 	}
 
+	// matches are authorized against the group module behind this connection
+	mono.Health().AddCheck("match.groups", health.Connection(conn))
+
 	groups := grpc.NewGroupRepository(conn)
+	users := grpc.NewUserRepository(conn)
 
-	app := application.New(matches, groups, mono.EventDispatcher())
+	app := application.New(matches, groups, users, publisher)
 
-	rest.MatchRoutes(mono.Router(), app)
+	selector := application.NewPlayerSelector(
+		matches,
+		app,
+		application.SelectorLogger(mono.Logger().With("module", "match")),
+	)
+	mono.Waiter().Add(selector.Start)
+
+	rest.MatchRoutes(mono.Router(), app, mono.TokenVerifier(), mono.Idempotency())
 
 	return nil
 }
+
+// newRepository returns the matches and the publisher of their events. The
+// events of matches kept in memory are published right away, the ones of
+// matches kept in MongoDB are relayed from the outbox.
+func newRepository(mono monolith.Monolith) (domain.MatchRepository, ddd.EventPublisher[ddd.AggregateEvent]) {
+	if mono.Config().Storage == config.StorageMemory {
+		return memory.NewMatchRepository(), mono.EventDispatcher()
+	}
+
+	store := eventstore.NewStore(
+		mono.DB(),
+		eventsCollection,
+		snapshotsCollection,
+		mono.Registry(),
+		eventstore.SnapshotEvery(mono.Config().Events.SnapshotEvery),
+	)
+
+	events := outbox.NewStore(mono.DB(), outboxCollection, mono.Registry())
+	relay := outbox.NewRelay(
+		events,
+		mono.EventDispatcher(),
+		outbox.DeadLetters(mono.DeadLetters()),
+		outbox.ObserveLag("match", mono.Metrics()),
+		outbox.Logger(mono.Logger().With("module", "match")),
+	)
+	mono.Waiter().Add(relay.Start)
+
+	return mongodb.NewMatchRepository(mono.DB(), matchesCollection, store, events), relay
+}
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"context"
+
 	"github.com/FSpruhs/kick-app/backend/player/internal/application/commands"
 	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
 )
@@ -11,9 +13,9 @@ type App interface {
 }
 
 type Commands interface {
-	ConfirmPlayer(cmd *commands.ConfirmPlayer) error
-	ConfirmGroupLeavingUser(cmd *commands.ConfirmGroupLeavingUser) error
-	UpdateRole(cmd *commands.UpdateRole) error
+	ConfirmPlayer(ctx context.Context, cmd *commands.ConfirmPlayer) error
+	ConfirmGroupLeavingUser(ctx context.Context, cmd *commands.ConfirmGroupLeavingUser) error
+	UpdateRole(ctx context.Context, cmd *commands.UpdateRole) error
 }
 
 type Queries interface{}
@@ -1,13 +1,14 @@
 package commands
 
 import (
-	"errors"
+	"context"
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
 )
 
-var ErrMasterCanNotLeaveGroup = errors.New("master cannot leave group")
+var ErrMasterCanNotLeaveGroup = ddd.ConflictError("player.master_can_not_leave", "master cannot leave group")
 
 type ConfirmGroupLeavingUser struct {
 	UserID  string
@@ -22,8 +23,8 @@ func NewConfirmGroupLeavingUserHandler(players domain.PlayerRepository) ConfirmG
 	return ConfirmGroupLeavingUserHandler{players}
 }
 
-func (h ConfirmGroupLeavingUserHandler) ConfirmGroupLeavingUser(cmd *ConfirmGroupLeavingUser) error {
-	player, err := h.PlayerRepository.FindByUserIDAndGroupID(cmd.UserID, cmd.GroupID)
+func (h ConfirmGroupLeavingUserHandler) ConfirmGroupLeavingUser(ctx context.Context, cmd *ConfirmGroupLeavingUser) error {
+	player, err := h.PlayerRepository.FindByUserIDAndGroupID(ctx, cmd.UserID, cmd.GroupID)
 	if err != nil {
 		return fmt.Errorf("confirm group leaving user: %w", err)
 	}
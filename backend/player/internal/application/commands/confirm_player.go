@@ -1,15 +1,17 @@
 package commands
 
 import (
-	"errors"
+	"context"
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
 	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
 )
 
 var (
-	ErrPlayerNotInGroup  = errors.New("player is not in the group")
-	ErrInviteLevelTooLow = errors.New("invite level is too low")
+	ErrPlayerNotInGroup  = ddd.NotFoundError("player.not_in_group", "player is not in the group")
+	ErrInviteLevelTooLow = policy.ErrBelowInviteLevel
 )
 
 type ConfirmPlayer struct {
@@ -26,8 +28,8 @@ func NewConfirmPlayerHandler(players domain.PlayerRepository) ConfirmPlayerHandl
 	return ConfirmPlayerHandler{players}
 }
 
-func (h ConfirmPlayerHandler) ConfirmPlayer(cmd *ConfirmPlayer) error {
-	player, err := h.PlayerRepository.FindByID(cmd.PlayerID)
+func (h ConfirmPlayerHandler) ConfirmPlayer(ctx context.Context, cmd *ConfirmPlayer) error {
+	player, err := h.PlayerRepository.FindByID(ctx, cmd.PlayerID)
 	if err != nil {
 		return fmt.Errorf("confirm player: %w", err)
 	}
@@ -36,9 +38,15 @@ func (h ConfirmPlayerHandler) ConfirmPlayer(cmd *ConfirmPlayer) error {
 		return ErrPlayerNotInGroup
 	}
 
-	if player.Role < domain.PlayerRole(cmd.InviteLevel) {
-		return ErrInviteLevelTooLow
-	}
-
-	return nil
+	role := policy.Role(player.Role)
+
+	return policy.Authorize(ctx, policy.Request{
+		Actor: policy.Subject{
+			UserID:      player.UserID,
+			Role:        role,
+			Permissions: policy.DefaultPermissions(role, policy.Role(cmd.InviteLevel)),
+		},
+		Action:   policy.InviteUser,
+		Resource: policy.Resource{Kind: policy.GroupResource, ID: cmd.GroupID},
+	})
 }
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
@@ -22,23 +23,23 @@ func NewUpdateRoleHandler(
 	return UpdateRoleHandler{players}
 }
 
-func (h UpdateRoleHandler) UpdateRole(cmd *UpdateRole) error {
-	playerToUpdate, err := h.PlayerRepository.FindByID(cmd.PlayerToUpdateID)
+func (h UpdateRoleHandler) UpdateRole(ctx context.Context, cmd *UpdateRole) error {
+	playerToUpdate, err := h.PlayerRepository.FindByID(ctx, cmd.PlayerToUpdateID)
 	if err != nil {
 		return fmt.Errorf("searching player with id %s: %w", cmd.PlayerToUpdateID, err)
 	}
 
-	updatingPlayer, err := h.PlayerRepository.FindByID(cmd.UpdatingPlayerID)
+	updatingPlayer, err := h.PlayerRepository.FindByID(ctx, cmd.UpdatingPlayerID)
 	if err != nil {
 		return fmt.Errorf("searching player with id %s: %w", cmd.PlayerToUpdateID, err)
 	}
 
-	if err := playerToUpdate.UpdateRole(updatingPlayer, cmd.NewRole); err != nil {
+	if err := playerToUpdate.UpdateRole(ctx, updatingPlayer, cmd.NewRole); err != nil {
 		return fmt.Errorf("updating role from player %s: %w", playerToUpdate.ID(), err)
 	}
 
 	playersToSave := []*domain.Player{playerToUpdate, updatingPlayer}
-	if err := h.PlayerRepository.SaveAll(playersToSave); err != nil {
+	if err := h.PlayerRepository.SaveAll(ctx, playersToSave); err != nil {
 		return fmt.Errorf("saving player %s: %w", playerToUpdate.ID(), err)
 	}
 
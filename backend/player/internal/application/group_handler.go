@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -18,18 +19,18 @@ func NewGroupHandler(players domain.PlayerRepository) *GroupHandler[ddd.Aggregat
 	return &GroupHandler[ddd.AggregateEvent]{players: players}
 }
 
-func (h GroupHandler[T]) HandleEvent(event ddd.AggregateEvent) error {
+func (h GroupHandler[T]) HandleEvent(ctx context.Context, event ddd.AggregateEvent) error {
 	switch event.EventName() {
 	case grouppb.GroupCreatedEvent:
-		return h.onGroupCreatedEvent(event)
+		return h.onGroupCreatedEvent(ctx, event)
 	case grouppb.UserAcceptedInvitationEvent:
-		return h.onUserAcceptedInvitationEvent(event)
+		return h.onUserAcceptedInvitationEvent(ctx, event)
 	}
 
 	return nil
 }
 
-func (h GroupHandler[T]) onGroupCreatedEvent(event ddd.Event) error {
+func (h GroupHandler[T]) onGroupCreatedEvent(ctx context.Context, event ddd.Event) error {
 	orderCreated, ok := event.Payload().(grouppb.GroupCreated)
 	if !ok {
 		return ddd.ErrInvalidEventPayload
@@ -42,7 +43,7 @@ func (h GroupHandler[T]) onGroupCreatedEvent(event ddd.Event) error {
 		Role:      domain.Master,
 	}
 
-	_, err := h.players.Create(&newPlayer)
+	_, err := h.players.Create(ctx, &newPlayer)
 	if err != nil {
 		return fmt.Errorf("handling group created event: %w", err)
 	}
@@ -50,7 +51,7 @@ func (h GroupHandler[T]) onGroupCreatedEvent(event ddd.Event) error {
 	return nil
 }
 
-func (h GroupHandler[T]) onUserAcceptedInvitationEvent(event ddd.Event) error {
+func (h GroupHandler[T]) onUserAcceptedInvitationEvent(ctx context.Context, event ddd.Event) error {
 	userAcceptedInvitation, ok := event.Payload().(grouppb.UserAcceptedInvitation)
 	if !ok {
 		return ddd.ErrInvalidEventPayload
@@ -63,7 +64,7 @@ func (h GroupHandler[T]) onUserAcceptedInvitationEvent(event ddd.Event) error {
 		Role:      domain.Member,
 	}
 
-	_, err := h.players.Create(&newPlayer)
+	_, err := h.players.Create(ctx, &newPlayer)
 	if err != nil {
 		return fmt.Errorf("handling on user accepted invitation event: %w", err)
 	}
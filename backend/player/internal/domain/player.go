@@ -1,21 +1,23 @@
 package domain
 
 import (
-	"errors"
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/policy"
 )
 
 const PlayerAggregate = "player.PlayerAggregate"
 
 var (
-	ErrDifferentGroups            = errors.New("players are not in the same group")
-	ErrInsufficientPermissions    = errors.New("updating player has to be at least an admin")
-	ErrSelfUpdate                 = errors.New("player cannot update their own role")
-	ErrMasterDowngrade            = errors.New("only master can downgrade to member")
-	ErrMasterUpdate               = errors.New("only master can update to master")
-	ErrMasterDowngradeByNonMaster = errors.New("only master can downgrade a master")
+	ErrPlayerNotFound             = ddd.NotFoundError("player.not_found", "player not found")
+	ErrDifferentGroups            = ddd.ForbiddenError("player.different_groups", "players are not in the same group")
+	ErrInsufficientPermissions    = policy.ErrInsufficientRole
+	ErrSelfUpdate                 = policy.ErrSelfRoleChange
+	ErrMasterDowngrade            = policy.ErrOnlyMasterCanDowngradeToMember
+	ErrMasterUpdate               = policy.ErrOnlyMasterCanPromoteToMaster
+	ErrMasterDowngradeByNonMaster = policy.ErrOnlyMasterCanChangeMaster
 )
 
 type Player struct {
@@ -25,8 +27,8 @@ type Player struct {
 	Role    PlayerRole
 }
 
-func (p *Player) UpdateRole(updatingPlayer *Player, newRole PlayerRole) error {
-	if err := validateUpdateRolePermission(updatingPlayer, p, newRole); err != nil {
+func (p *Player) UpdateRole(ctx context.Context, updatingPlayer *Player, newRole PlayerRole) error {
+	if err := validateUpdateRolePermission(ctx, updatingPlayer, p, newRole); err != nil {
 		return fmt.Errorf("validating update role permission: %w", err)
 	}
 
@@ -36,40 +38,28 @@ func (p *Player) UpdateRole(updatingPlayer *Player, newRole PlayerRole) error {
 		return nil
 	}
 
-	if err := updatingPlayer.UpdateRole(p, Admin); err != nil {
+	if err := updatingPlayer.UpdateRole(ctx, p, Admin); err != nil {
 		return fmt.Errorf("updating role of updating player: %w", err)
 	}
 
 	return nil
 }
 
-func validateUpdateRolePermission(updatingPlayer, targetPlayer *Player, newRole PlayerRole) error {
+func validateUpdateRolePermission(ctx context.Context, updatingPlayer, targetPlayer *Player, newRole PlayerRole) error {
 	if targetPlayer.GroupID != updatingPlayer.GroupID {
 		return ErrDifferentGroups
 	}
 
-	if updatingPlayer.Role == Member {
-		return ErrInsufficientPermissions
-	}
-
-	if updatingPlayer.ID() == targetPlayer.ID() {
-		return ErrSelfUpdate
-	}
-
-	switch newRole {
-	case Member:
-		if updatingPlayer.Role != Master {
-			return ErrMasterDowngrade
-		}
-	case Master:
-		if updatingPlayer.Role != Master {
-			return ErrMasterUpdate
-		}
-	case Admin:
-		if targetPlayer.Role == Master && updatingPlayer.Role != Master {
-			return ErrMasterDowngradeByNonMaster
-		}
-	}
+	return policy.Authorize(ctx, policy.Request{
+		Actor:    updatingPlayer.subject(),
+		Action:   policy.ChangeRole,
+		Resource: policy.Resource{Kind: policy.GroupResource, ID: targetPlayer.GroupID},
+		Target:   targetPlayer.subject(),
+		NewRole:  policy.Role(newRole),
+	})
+}
 
-	return nil
+// subject identifies the player by its ID, which is unique over all groups.
+func (p *Player) subject() policy.Subject {
+	return policy.Subject{UserID: p.ID(), Role: policy.Role(p.Role)}
 }
@@ -1,9 +1,11 @@
 package domain
 
+import "context"
+
 type PlayerRepository interface {
-	Create(player *Player) (*Player, error)
-	FindByID(id string) (*Player, error)
-	FindByUserIDAndGroupID(userID, groupID string) (*Player, error)
-	Save(player *Player) error
-	SaveAll(players []*Player) error
+	Create(ctx context.Context, player *Player) (*Player, error)
+	FindByID(ctx context.Context, id string) (*Player, error)
+	FindByUserIDAndGroupID(ctx context.Context, userID, groupID string) (*Player, error)
+	Save(ctx context.Context, player *Player) error
+	SaveAll(ctx context.Context, players []*Player) error
 }
@@ -2,8 +2,12 @@ package domain
 
 import (
 	"strings"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 )
 
+var ErrInvalidRole = ddd.ValidationError("player.invalid_role", "invalid player role")
+
 type InvalidPlayerRoleError struct {
 	role string
 }
@@ -12,6 +16,10 @@ func (e InvalidPlayerRoleError) Error() string {
 	return "invalid player role: " + e.role
 }
 
+func (e InvalidPlayerRoleError) Unwrap() error {
+	return ErrInvalidRole
+}
+
 type PlayerRole int
 
 const (
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -121,7 +122,7 @@ func TestPlayer_UpdateRole(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.targetPlayer.UpdateRole(tt.updatingPlayer, tt.newRole)
+			err := tt.targetPlayer.UpdateRole(context.Background(), tt.updatingPlayer, tt.newRole)
 			require.ErrorIs(t, err, tt.expectedError, "Expected error '%v', got '%v'", tt.expectedError, err)
 
 			if err == nil {
@@ -25,10 +25,10 @@ func RegisterServer(app application.App, registrar grpc.ServiceRegistrar) error
 }
 
 func (s server) ConfirmPlayer(
-	_ context.Context,
+	ctx context.Context,
 	request *playerspb.ConfirmPlayerRequest,
 ) (*playerspb.ConfirmPlayerResponse, error) {
-	if err := s.app.ConfirmPlayer(&commands.ConfirmPlayer{
+	if err := s.app.ConfirmPlayer(ctx, &commands.ConfirmPlayer{
 		PlayerID:    request.GetPlayerId(),
 		GroupID:     request.GetGroupId(),
 		InviteLevel: int(request.GetInviteLevel()),
@@ -40,7 +40,7 @@ func (s server) ConfirmPlayer(
 }
 
 func (s server) ConfirmGroupLeavingUser(
-	_ context.Context,
+	ctx context.Context,
 	request *playerspb.ConfirmGroupLeavingUserRequest,
 ) (*playerspb.ConfirmGroupLeavingUserResponse, error) {
 	command := commands.ConfirmGroupLeavingUser{
@@ -48,7 +48,7 @@ func (s server) ConfirmGroupLeavingUser(
 		GroupID: request.GetGroupId(),
 	}
 
-	if err := s.app.ConfirmGroupLeavingUser(&command); err != nil {
+	if err := s.app.ConfirmGroupLeavingUser(ctx, &command); err != nil {
 		return nil, fmt.Errorf("confirm user leaving group: %w", err)
 	}
 
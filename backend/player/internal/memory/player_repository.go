@@ -0,0 +1,109 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
+)
+
+type storedPlayer struct {
+	groupID string
+	userID  string
+	role    domain.PlayerRole
+}
+
+// PlayerRepository keeps the players in memory. Every player found is a new
+// copy, so changes are only seen by others once the player was saved.
+type PlayerRepository struct {
+	mu      sync.RWMutex
+	players map[string]storedPlayer
+}
+
+var _ domain.PlayerRepository = (*PlayerRepository)(nil)
+
+func NewPlayerRepository() *PlayerRepository {
+	return &PlayerRepository{players: make(map[string]storedPlayer)}
+}
+
+func (r *PlayerRepository) Create(_ context.Context, newPlayer *domain.Player) (*domain.Player, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.players[newPlayer.ID()]; ok {
+		return nil, fmt.Errorf("creating player in db: player %s already exists", newPlayer.ID())
+	}
+
+	r.store(newPlayer)
+
+	return newPlayer, nil
+}
+
+func (r *PlayerRepository) FindByID(_ context.Context, id string) (*domain.Player, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.players[id]
+	if !ok {
+		return nil, fmt.Errorf("finding player %s: %w", id, domain.ErrPlayerNotFound)
+	}
+
+	return toPlayer(id, stored), nil
+}
+
+func (r *PlayerRepository) FindByUserIDAndGroupID(_ context.Context, userID, groupID string) (*domain.Player, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for id, stored := range r.players {
+		if stored.userID == userID && stored.groupID == groupID {
+			return toPlayer(id, stored), nil
+		}
+	}
+
+	return nil, fmt.Errorf("finding player of user %s in group %s: %w", userID, groupID, domain.ErrPlayerNotFound)
+}
+
+func (r *PlayerRepository) Save(_ context.Context, player *domain.Player) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.replace(player)
+
+	return nil
+}
+
+// SaveAll saves the players at once, so no one sees only a part of them.
+func (r *PlayerRepository) SaveAll(_ context.Context, players []*domain.Player) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, player := range players {
+		r.replace(player)
+	}
+
+	return nil
+}
+
+// replace stores player if it exists. Like a replace in the database, saving
+// an unknown player does not create it.
+func (r *PlayerRepository) replace(player *domain.Player) {
+	if _, ok := r.players[player.ID()]; ok {
+		r.store(player)
+	}
+}
+
+func (r *PlayerRepository) store(player *domain.Player) {
+	r.players[player.ID()] = storedPlayer{groupID: player.GroupID, userID: player.UserID, role: player.Role}
+}
+
+func toPlayer(id string, stored storedPlayer) *domain.Player {
+	return &domain.Player{
+		Aggregate: ddd.NewAggregate(id, domain.PlayerAggregate),
+		GroupID:   stored.groupID,
+		UserID:    stored.userID,
+		Role:      stored.role,
+	}
+}
@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/player/internal/repositorytest"
+)
+
+func TestPlayerRepository(t *testing.T) {
+	repositorytest.PlayerRepository(t, func(*testing.T) domain.PlayerRepository {
+		return NewPlayerRepository()
+	})
+}
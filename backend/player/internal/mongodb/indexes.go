@@ -0,0 +1,14 @@
+package mongodb
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+)
+
+// Indexes serve finding the player of a user in a group.
+func Indexes(collectionName string) []indexes.Index {
+	return []indexes.Index{
+		{Collection: collectionName, Keys: bson.D{{Key: "userId", Value: 1}, {Key: "groupId", Value: 1}}},
+	}
+}
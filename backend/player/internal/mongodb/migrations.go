@@ -0,0 +1,14 @@
+package mongodb
+
+import "github.com/FSpruhs/kick-app/backend/internal/migrations"
+
+// Migrations of the player documents stored in collectionName. The fields of
+// the documents were named after their lowercased field names before.
+func Migrations(collectionName string) []migrations.Migration {
+	return []migrations.Migration{
+		migrations.RenameFields(2026101804, collectionName, map[string]string{
+			"groupid": "groupId",
+			"userid":  "userId",
+		}),
+	}
+}
@@ -2,6 +2,7 @@ package mongodb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
 	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
 )
 
@@ -18,9 +20,9 @@ var _ domain.PlayerRepository = (*PlayerRepository)(nil)
 
 type PlayerDocument struct {
 	ID      string `bson:"_id,omitempty"`
-	GroupID string `json:"groupId,omitempty"`
-	UserID  string `json:"userId,omitempty"`
-	Role    int    `json:"role,omitempty"`
+	GroupID string `bson:"groupId"`
+	UserID  string `bson:"userId"`
+	Role    int    `bson:"role"`
 }
 
 type PlayerRepository struct {
@@ -31,12 +33,19 @@ func NewPlayerRepository(database *mongo.Database, collectionName string) Player
 	return PlayerRepository{collection: database.Collection(collectionName)}
 }
 
-func (p PlayerRepository) FindByUserIDAndGroupID(userID, groupID string) (*domain.Player, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (p PlayerRepository) FindByUserIDAndGroupID(ctx context.Context, userID, groupID string) (*domain.Player, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "player.PlayerRepository.FindByUserIDAndGroupID")
+	defer span.End()
+
 	var playerDoc PlayerDocument
 	if err := p.collection.FindOne(ctx, bson.M{"userId": userID, "groupId": groupID}).Decode(&playerDoc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("finding player of user %s in group %s: %w", userID, groupID, domain.ErrPlayerNotFound)
+		}
+
 		return nil, fmt.Errorf("finding player by user id and group id: %w", err)
 	}
 
@@ -45,12 +54,19 @@ func (p PlayerRepository) FindByUserIDAndGroupID(userID, groupID string) (*domai
 	return player, nil
 }
 
-func (p PlayerRepository) FindByID(id string) (*domain.Player, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (p PlayerRepository) FindByID(ctx context.Context, id string) (*domain.Player, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "player.PlayerRepository.FindByID")
+	defer span.End()
+
 	var playerDoc PlayerDocument
 	if err := p.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&playerDoc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("finding player %s: %w", id, domain.ErrPlayerNotFound)
+		}
+
 		return nil, fmt.Errorf("finding player by id: %w", err)
 	}
 
@@ -59,10 +75,13 @@ func (p PlayerRepository) FindByID(id string) (*domain.Player, error) {
 	return player, nil
 }
 
-func (p PlayerRepository) Create(newPlayer *domain.Player) (*domain.Player, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (p PlayerRepository) Create(ctx context.Context, newPlayer *domain.Player) (*domain.Player, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "player.PlayerRepository.Create")
+	defer span.End()
+
 	playerDoc := toDocument(newPlayer)
 
 	_, err := p.collection.InsertOne(ctx, playerDoc)
@@ -73,10 +92,13 @@ func (p PlayerRepository) Create(newPlayer *domain.Player) (*domain.Player, erro
 	return newPlayer, nil
 }
 
-func (p PlayerRepository) Save(player *domain.Player) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (p PlayerRepository) Save(ctx context.Context, player *domain.Player) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "player.PlayerRepository.Save")
+	defer span.End()
+
 	playerDoc := toDocument(player)
 
 	_, err := p.collection.ReplaceOne(ctx, bson.M{"_id": player.ID()}, playerDoc)
@@ -87,10 +109,13 @@ func (p PlayerRepository) Save(player *domain.Player) error {
 	return nil
 }
 
-func (p PlayerRepository) SaveAll(players []*domain.Player) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (p PlayerRepository) SaveAll(ctx context.Context, players []*domain.Player) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "player.PlayerRepository.SaveAll")
+	defer span.End()
+
 	session, err := p.collection.Database().Client().StartSession()
 	if err != nil {
 		return fmt.Errorf("starting session: %w", err)
@@ -0,0 +1,17 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/player/internal/repositorytest"
+)
+
+func TestPlayerRepository(t *testing.T) {
+	repositorytest.PlayerRepository(t, func(t *testing.T) domain.PlayerRepository {
+		t.Helper()
+
+		return NewPlayerRepository(mongotest.NewDatabase(t), "player.players")
+	})
+}
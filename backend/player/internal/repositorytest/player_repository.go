@@ -0,0 +1,136 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
+)
+
+// PlayerRepository is the contract every domain.PlayerRepository fulfills.
+// newRepository must return an empty repository on every call.
+func PlayerRepository(t *testing.T, newRepository func(t *testing.T) domain.PlayerRepository) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("finds created player", func(t *testing.T) {
+		repository := newRepository(t)
+		player := createPlayer(t, repository, "user-1", "group-1", domain.Master)
+
+		byID, err := repository.FindByID(ctx, player.ID())
+		require.NoError(t, err)
+		assertSamePlayer(t, player, byID)
+
+		byUserAndGroup, err := repository.FindByUserIDAndGroupID(ctx, "user-1", "group-1")
+		require.NoError(t, err)
+		assertSamePlayer(t, player, byUserAndGroup)
+	})
+
+	t.Run("does not find unknown player", func(t *testing.T) {
+		repository := newRepository(t)
+		createPlayer(t, repository, "user-1", "group-1", domain.Member)
+
+		_, err := repository.FindByID(ctx, "unknown")
+		require.ErrorIs(t, err, domain.ErrPlayerNotFound)
+
+		_, err = repository.FindByUserIDAndGroupID(ctx, "user-1", "group-2")
+		require.ErrorIs(t, err, domain.ErrPlayerNotFound)
+	})
+
+	t.Run("does not create player twice", func(t *testing.T) {
+		repository := newRepository(t)
+		player := createPlayer(t, repository, "user-1", "group-1", domain.Member)
+
+		_, err := repository.Create(ctx, player)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("saves changes", func(t *testing.T) {
+		repository := newRepository(t)
+		player := findPlayer(t, repository, createPlayer(t, repository, "user-1", "group-1", domain.Member).ID())
+
+		player.Role = domain.Admin
+		require.NoError(t, repository.Save(ctx, player))
+
+		assertSamePlayer(t, player, findPlayer(t, repository, player.ID()))
+	})
+
+	t.Run("keeps unsaved changes apart", func(t *testing.T) {
+		repository := newRepository(t)
+		player := findPlayer(t, repository, createPlayer(t, repository, "user-1", "group-1", domain.Member).ID())
+
+		player.Role = domain.Admin
+
+		assert.Equal(t, domain.PlayerRole(domain.Member), findPlayer(t, repository, player.ID()).Role)
+	})
+
+	t.Run("does not save unknown player", func(t *testing.T) {
+		repository := newRepository(t)
+		player := newPlayer("user-1", "group-1", domain.Member)
+
+		require.NoError(t, repository.Save(ctx, player))
+
+		_, err := repository.FindByID(ctx, player.ID())
+		assert.ErrorIs(t, err, domain.ErrPlayerNotFound)
+	})
+
+	t.Run("saves all players", func(t *testing.T) {
+		repository := newRepository(t)
+		master := findPlayer(t, repository, createPlayer(t, repository, "user-1", "group-1", domain.Master).ID())
+		member := findPlayer(t, repository, createPlayer(t, repository, "user-2", "group-1", domain.Member).ID())
+
+		require.NoError(t, member.UpdateRole(ctx, master, domain.Master))
+		require.NoError(t, repository.SaveAll(ctx, []*domain.Player{member, master}))
+
+		assert.Equal(t, domain.PlayerRole(domain.Master), findPlayer(t, repository, member.ID()).Role)
+		assert.Equal(t, domain.PlayerRole(domain.Admin), findPlayer(t, repository, master.ID()).Role)
+	})
+}
+
+func newPlayer(userID, groupID string, role domain.PlayerRole) *domain.Player {
+	return &domain.Player{
+		Aggregate: ddd.NewAggregate(uuid.New().String(), domain.PlayerAggregate),
+		GroupID:   groupID,
+		UserID:    userID,
+		Role:      role,
+	}
+}
+
+func createPlayer(
+	t *testing.T,
+	repository domain.PlayerRepository,
+	userID, groupID string,
+	role domain.PlayerRole,
+) *domain.Player {
+	t.Helper()
+
+	player, err := repository.Create(context.Background(), newPlayer(userID, groupID, role))
+	require.NoError(t, err)
+
+	return player
+}
+
+func findPlayer(t *testing.T, repository domain.PlayerRepository, id string) *domain.Player {
+	t.Helper()
+
+	player, err := repository.FindByID(context.Background(), id)
+	require.NoError(t, err)
+
+	return player
+}
+
+func assertSamePlayer(t *testing.T, expected, actual *domain.Player) {
+	t.Helper()
+
+	assert.Equal(t, expected.ID(), actual.ID())
+	assert.Equal(t, expected.GroupID, actual.GroupID)
+	assert.Equal(t, expected.UserID, actual.UserID)
+	assert.Equal(t, expected.Role, actual.Role)
+}
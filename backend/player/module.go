@@ -3,18 +3,38 @@ package player
 import (
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/config"
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+	"github.com/FSpruhs/kick-app/backend/internal/migrations"
 	"github.com/FSpruhs/kick-app/backend/internal/monolith"
 	"github.com/FSpruhs/kick-app/backend/player/internal/application"
+	"github.com/FSpruhs/kick-app/backend/player/internal/domain"
 	"github.com/FSpruhs/kick-app/backend/player/internal/grpc"
 	"github.com/FSpruhs/kick-app/backend/player/internal/handler"
+	"github.com/FSpruhs/kick-app/backend/player/internal/memory"
 	"github.com/FSpruhs/kick-app/backend/player/internal/mongodb"
 	"github.com/FSpruhs/kick-app/backend/player/internal/rest"
 )
 
+const playersCollection = "player.players"
+
 type Module struct{}
 
+var (
+	_ migrations.Source = (*Module)(nil)
+	_ indexes.Source    = (*Module)(nil)
+)
+
+func (m *Module) Migrations() []migrations.Migration {
+	return mongodb.Migrations(playersCollection)
+}
+
+func (m *Module) Indexes() []indexes.Index {
+	return mongodb.Indexes(playersCollection)
+}
+
 func (m *Module) Startup(mono monolith.Monolith) error {
-	players := mongodb.NewPlayerRepository(mono.DB(), "player.players")
+	players := newRepository(mono)
 
 	app := application.New(players)
 
@@ -29,3 +49,11 @@ func (m *Module) Startup(mono monolith.Monolith) error {
 
 	return nil
 }
+
+func newRepository(mono monolith.Monolith) domain.PlayerRepository {
+	if mono.Config().Storage == config.StorageMemory {
+		return memory.NewPlayerRepository()
+	}
+
+	return mongodb.NewPlayerRepository(mono.DB(), playersCollection)
+}
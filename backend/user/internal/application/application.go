@@ -1,6 +1,9 @@
 package application
 
 import (
+	"context"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application/commands"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application/queries"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
@@ -12,16 +15,16 @@ type App interface {
 }
 
 type Commands interface {
-	CreateUser(cmd *commands.CreateUser) (*domain.User, error)
-	LoginUser(cmd *commands.LoginUser) (*domain.User, error)
-	MessageRead(cmd *commands.MessageRead) error
+	CreateUser(ctx context.Context, cmd *commands.CreateUser) (*domain.User, error)
+	LoginUser(ctx context.Context, cmd *commands.LoginUser) (*domain.User, error)
+	MessageRead(ctx context.Context, cmd *commands.MessageRead) error
 }
 
 type Queries interface {
-	GetUser(cmd *queries.GetUser) (*domain.User, error)
-	GetUsersByIDs(cmd *queries.GetUsersByIDs) ([]*domain.User, error)
-	GetUserAll(cmd *queries.GetUserAll) ([]*domain.User, error)
-	GetUserMessages(cmd *queries.GetUserMessages) ([]*domain.Message, error)
+	GetUser(ctx context.Context, cmd *queries.GetUser) (*domain.User, error)
+	GetUsersByIDs(ctx context.Context, cmd *queries.GetUsersByIDs) ([]*domain.User, error)
+	GetUserAll(ctx context.Context, cmd *queries.GetUserAll) (pagination.Page[*domain.User], error)
+	GetUserMessages(ctx context.Context, cmd *queries.GetUserMessages) (pagination.Page[*domain.Message], error)
 }
 
 type Application struct {
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
@@ -21,8 +22,8 @@ func NewCreateUserHandler(users domain.UserRepository) CreateUserHandler {
 	return CreateUserHandler{users}
 }
 
-func (h CreateUserHandler) CreateUser(cmd *CreateUser) (*domain.User, error) {
-	if emailCount, err := h.UserRepository.CountByEmail(cmd.Email); err != nil {
+func (h CreateUserHandler) CreateUser(ctx context.Context, cmd *CreateUser) (*domain.User, error) {
+	if emailCount, err := h.UserRepository.CountByEmail(ctx, cmd.Email); err != nil {
 		return nil, fmt.Errorf("counting email: %w", err)
 	} else if emailCount > 0 {
 		return nil, domain.ErrEmailAlreadyExists
@@ -30,7 +31,7 @@ func (h CreateUserHandler) CreateUser(cmd *CreateUser) (*domain.User, error) {
 
 	newUser := domain.NewUser(cmd.FullName, cmd.Nickname, cmd.Password, cmd.Email)
 
-	result, err := h.UserRepository.Create(newUser)
+	result, err := h.UserRepository.Create(ctx, newUser)
 	if err != nil {
 		return nil, fmt.Errorf("creating user: %w", err)
 	}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,8 +16,8 @@ func TestCreateUserHandler_CreateUser(t *testing.T) {
 	password, _ := domain.NewPassword("Abcd123")
 
 	mockRepo := new(MockUserRepository)
-	mockRepo.On("CountByEmail", email).Return(0, nil)
-	mockRepo.On("Create", mock.AnythingOfType("*domain.User")).Return(&domain.User{ID: "123"}, nil)
+	mockRepo.On("CountByEmail", mock.Anything, email).Return(0, nil)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.User")).Return(&domain.User{ID: "123"}, nil)
 
 	handler := NewCreateUserHandler(mockRepo)
 	cmd := &CreateUser{
@@ -26,7 +27,7 @@ func TestCreateUserHandler_CreateUser(t *testing.T) {
 		Password: password,
 	}
 
-	createdUser, err := handler.CreateUser(cmd)
+	createdUser, err := handler.CreateUser(context.Background(), cmd)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, createdUser)
@@ -41,7 +42,7 @@ func TestCreateUserHandler_CreateUser_EmailAlreadyExists(t *testing.T) {
 	password, _ := domain.NewPassword("Abcd123")
 
 	mockRepo := new(MockUserRepository)
-	mockRepo.On("CountByEmail", email).Return(1, nil)
+	mockRepo.On("CountByEmail", mock.Anything, email).Return(1, nil)
 
 	handler := NewCreateUserHandler(mockRepo)
 	cmd := &CreateUser{
@@ -51,7 +52,7 @@ func TestCreateUserHandler_CreateUser_EmailAlreadyExists(t *testing.T) {
 		Password: password,
 	}
 
-	createdUser, err := handler.CreateUser(cmd)
+	createdUser, err := handler.CreateUser(context.Background(), cmd)
 
 	assert.NotNil(t, err)
 	assert.Nil(t, createdUser)
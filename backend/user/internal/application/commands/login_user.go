@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
@@ -19,8 +21,13 @@ func NewLoginUserHandler(users domain.UserRepository) LoginUserHandler {
 	return LoginUserHandler{users}
 }
 
-func (h LoginUserHandler) LoginUser(cmd *LoginUser) (*domain.User, error) {
-	user, err := h.UserRepository.FindByEmail(cmd.Email)
+func (h LoginUserHandler) LoginUser(ctx context.Context, cmd *LoginUser) (*domain.User, error) {
+	user, err := h.UserRepository.FindByEmail(ctx, cmd.Email)
+	// unknown emails fail like wrong passwords, so nobody can probe for users
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, fmt.Errorf("login user: %w", domain.ErrWrongPassword)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("login user: %w", err)
 	}
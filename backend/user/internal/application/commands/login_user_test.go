@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func TestLoginUserHandler_SuccessfulLogin(t *testing.T) {
 		Password: password,
 	}
 	mockRepo := new(MockUserRepository)
-	mockRepo.On("FindByEmail", mock.AnythingOfType("*domain.Email")).Return(mockUser, nil)
+	mockRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("*domain.Email")).Return(mockUser, nil)
 
 	handler := NewLoginUserHandler(mockRepo)
 
@@ -28,7 +29,7 @@ func TestLoginUserHandler_SuccessfulLogin(t *testing.T) {
 		Email:    mockUser.Email,
 		Password: mockUser.Password,
 	}
-	user, err := handler.LoginUser(&cmd)
+	user, err := handler.LoginUser(context.Background(), &cmd)
 
 	assert.NoError(t, err, "Expected no error during successful login, but got %v", err)
 	assert.NotNil(t, user, "Expected user object to be returned, but got nil")
@@ -39,7 +40,7 @@ func TestLoginUserHandler_SuccessfulLogin(t *testing.T) {
 
 func TestLoginUserHandler_UserNotFound(t *testing.T) {
 	mockRepo := new(MockUserRepository)
-	mockRepo.On("FindByEmail", mock.AnythingOfType("*domain.Email")).Return(nil, domain.ErrEmailInvalid)
+	mockRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("*domain.Email")).Return(nil, domain.ErrEmailInvalid)
 
 	handler := NewLoginUserHandler(mockRepo)
 
@@ -49,7 +50,7 @@ func TestLoginUserHandler_UserNotFound(t *testing.T) {
 		Email:    email,
 		Password: password,
 	}
-	user, err := handler.LoginUser(&cmd)
+	user, err := handler.LoginUser(context.Background(), &cmd)
 
 	assert.ErrorIs(t, err, domain.ErrEmailInvalid, "Expected error due to user not found")
 	assert.Nil(t, user, "Expected no user object to be returned when user is not found")
@@ -57,6 +58,25 @@ func TestLoginUserHandler_UserNotFound(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestLoginUserHandler_UnknownEmailFailsLikeWrongPassword(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("*domain.Email")).Return(nil, domain.ErrUserNotFound)
+
+	handler := NewLoginUserHandler(mockRepo)
+
+	var email, _ = domain.NewEmail("nonexisting@example.com")
+	var password, _ = domain.NewPassword("Password123")
+	cmd := LoginUser{
+		Email:    email,
+		Password: password,
+	}
+	user, err := handler.LoginUser(context.Background(), &cmd)
+
+	assert.ErrorIs(t, err, domain.ErrWrongPassword)
+	assert.NotErrorIs(t, err, domain.ErrUserNotFound)
+	assert.Nil(t, user)
+}
+
 func TestLoginUserHandler_WrongPassword(t *testing.T) {
 	var fullName, _ = domain.NewFullName("John", "Doe")
 	var password, _ = domain.NewPassword("Password123")
@@ -68,7 +88,7 @@ func TestLoginUserHandler_WrongPassword(t *testing.T) {
 		Password: password,
 	}
 	mockRepo := new(MockUserRepository)
-	mockRepo.On("FindByEmail", mock.AnythingOfType("*domain.Email")).Return(mockUser, nil)
+	mockRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("*domain.Email")).Return(mockUser, nil)
 
 	handler := NewLoginUserHandler(mockRepo)
 
@@ -77,7 +97,7 @@ func TestLoginUserHandler_WrongPassword(t *testing.T) {
 		Email:    mockUser.Email,
 		Password: wrongPassword,
 	}
-	user, err := handler.LoginUser(&cmd)
+	user, err := handler.LoginUser(context.Background(), &cmd)
 
 	assert.ErrorIs(t, err, domain.ErrWrongPassword, "Expected error due to wrong password")
 	assert.Nil(t, user, "Expected no user object to be returned when password is wrong")
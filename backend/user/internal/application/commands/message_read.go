@@ -1,13 +1,14 @@
 package commands
 
 import (
-	"errors"
+	"context"
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
 )
 
-var ErrMessageDoesNotBelongToUser = errors.New("message does not belong to user")
+var ErrMessageDoesNotBelongToUser = ddd.ForbiddenError("user.message_not_owned", "message does not belong to user")
 
 type MessageRead struct {
 	UserID    string
@@ -23,8 +24,8 @@ func NewMessageReadHandler(messages domain.MessageRepository) MessageReadHandler
 	return MessageReadHandler{messages}
 }
 
-func (h MessageReadHandler) MessageRead(cmd *MessageRead) error {
-	message, err := h.MessageRepository.FindByID(cmd.MessageID)
+func (h MessageReadHandler) MessageRead(ctx context.Context, cmd *MessageRead) error {
+	message, err := h.MessageRepository.FindByID(ctx, cmd.MessageID)
 	if err != nil {
 		return fmt.Errorf("finding message with id %s: %w", cmd.MessageID, err)
 	}
@@ -35,7 +36,7 @@ func (h MessageReadHandler) MessageRead(cmd *MessageRead) error {
 
 	message.MarkAsRead()
 
-	if err := h.MessageRepository.Save(message); err != nil {
+	if err := h.MessageRepository.Save(ctx, message); err != nil {
 		return fmt.Errorf("saving message with id %s: %w", cmd.MessageID, err)
 	}
 
@@ -1,8 +1,11 @@
 package commands
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
 )
 
@@ -12,28 +15,32 @@ type MockUserRepository struct {
 
 var _ domain.UserRepository = (*MockUserRepository)(nil)
 
-func (m *MockUserRepository) Save(user *domain.User) error {
+func (m *MockUserRepository) Save(ctx context.Context, user *domain.User) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m *MockUserRepository) FindByID(id string) (*domain.User, error) {
+func (m *MockUserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m *MockUserRepository) FindByIDs(ids []string) ([]*domain.User, error) {
+func (m *MockUserRepository) FindByIDs(ctx context.Context, ids []string) ([]*domain.User, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m *MockUserRepository) FindAll(filter *domain.Filter) ([]*domain.User, error) {
+func (m *MockUserRepository) FindAll(
+	ctx context.Context,
+	filter *domain.Filter,
+	page pagination.Request,
+) (pagination.Page[*domain.User], error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m *MockUserRepository) FindByEmail(email *domain.Email) (*domain.User, error) {
-	args := m.Called(email)
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email *domain.Email) (*domain.User, error) {
+	args := m.Called(ctx, email)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -41,8 +48,8 @@ func (m *MockUserRepository) FindByEmail(email *domain.Email) (*domain.User, err
 	return args.Get(0).(*domain.User), args.Error(1)
 }
 
-func (m *MockUserRepository) Create(user *domain.User) (*domain.User, error) {
-	args := m.Called(user)
+func (m *MockUserRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
+	args := m.Called(ctx, user)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -50,8 +57,8 @@ func (m *MockUserRepository) Create(user *domain.User) (*domain.User, error) {
 	return args.Get(0).(*domain.User), args.Error(1)
 }
 
-func (m *MockUserRepository) CountByEmail(email *domain.Email) (int, error) {
-	args := m.Called(email)
+func (m *MockUserRepository) CountByEmail(ctx context.Context, email *domain.Email) (int, error) {
+	args := m.Called(ctx, email)
 
 	return args.Int(0), args.Error(1)
 }
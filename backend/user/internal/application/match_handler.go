@@ -1,6 +1,8 @@
 package application
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/internal/ddd"
@@ -23,22 +25,32 @@ func NewMatchHandler(
 	}
 }
 
-func (h MatchHandler[T]) HandleEvent(event ddd.AggregateEvent) error {
+func (h MatchHandler[T]) HandleEvent(ctx context.Context, event ddd.AggregateEvent) error {
 	switch event.EventName() {
 	case matchpb.MatchCreatedEvent:
-		return h.onMatchCreatedEvent(event)
+		return h.onMatchCreatedEvent(ctx, event)
+	case matchpb.PlayerPromotedEvent:
+		return h.onPlayerPromotedEvent(ctx, event)
+	case matchpb.PlayersSelectedEvent:
+		return h.onPlayersSelectedEvent(ctx, event)
+	case matchpb.MatchCancelledEvent,
+		matchpb.MatchRescheduledEvent,
+		matchpb.MatchRelocatedEvent,
+		matchpb.MatchFinishedEvent,
+		matchpb.MatchResultEnteredEvent:
+		return h.onMatchChangedEvent(ctx, event)
 	}
 
 	return nil
 }
 
-func (h MatchHandler[T]) onMatchCreatedEvent(event ddd.Event) error {
+func (h MatchHandler[T]) onMatchCreatedEvent(ctx context.Context, event ddd.Event) error {
 	matchCreated, ok := event.Payload().(matchpb.MatchCreated)
 	if !ok {
 		return ddd.ErrInvalidEventPayload
 	}
 
-	users, err := h.groups.FindPlayersByGroup(matchCreated.GroupID)
+	users, err := h.groups.FindPlayersByGroup(ctx, matchCreated.GroupID)
 	if err != nil {
 		return fmt.Errorf("finding players by group: %w", err)
 	}
@@ -46,10 +58,117 @@ func (h MatchHandler[T]) onMatchCreatedEvent(event ddd.Event) error {
 	for _, user := range users {
 		message := domain.CreateInviteUserToMatchMessage(user, matchCreated.MatchID, matchCreated.GroupID)
 
-		if err := h.messages.Create(message); err != nil {
+		if err := h.create(ctx, event, message); err != nil {
 			return fmt.Errorf("creating invite user to match message: %w", err)
 		}
 	}
 
 	return nil
 }
+
+func (h MatchHandler[T]) onPlayerPromotedEvent(ctx context.Context, event ddd.Event) error {
+	playerPromoted, ok := event.Payload().(matchpb.PlayerPromoted)
+	if !ok {
+		return ddd.ErrInvalidEventPayload
+	}
+
+	message := domain.CreatePromotedFromBenchMessage(playerPromoted.UserID, playerPromoted.MatchID, playerPromoted.GroupID)
+
+	if err := h.create(ctx, event, message); err != nil {
+		return fmt.Errorf("creating promoted from bench message: %w", err)
+	}
+
+	return nil
+}
+
+// onPlayersSelectedEvent tells the players whose place changed at the cutoff.
+func (h MatchHandler[T]) onPlayersSelectedEvent(ctx context.Context, event ddd.Event) error {
+	playersSelected, ok := event.Payload().(matchpb.PlayersSelected)
+	if !ok {
+		return ddd.ErrInvalidEventPayload
+	}
+
+	changes := []struct {
+		userIDs []string
+		create  func(userID, matchID, groupID string) *domain.Message
+	}{
+		{playersSelected.Promoted, domain.CreatePromotedFromBenchMessage},
+		{playersSelected.Benched, domain.CreateMovedToBenchMessage},
+	}
+
+	for _, change := range changes {
+		for _, userID := range change.userIDs {
+			message := change.create(userID, playersSelected.MatchID, playersSelected.GroupID)
+
+			if err := h.create(ctx, event, message); err != nil {
+				return fmt.Errorf("creating players selected message: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// onMatchChangedEvent tells the players registered for the match that its
+// lifecycle changed.
+func (h MatchHandler[T]) onMatchChangedEvent(ctx context.Context, event ddd.Event) error {
+	var (
+		userIDs []string
+		create  func(userID string) *domain.Message
+	)
+
+	switch payload := event.Payload().(type) {
+	case matchpb.MatchCancelled:
+		userIDs = payload.UserIDs
+		create = func(userID string) *domain.Message {
+			return domain.CreateMatchCancelledMessage(userID, payload.MatchID, payload.GroupID)
+		}
+	case matchpb.MatchRescheduled:
+		userIDs = payload.UserIDs
+		create = func(userID string) *domain.Message {
+			return domain.CreateMatchRescheduledMessage(userID, payload.MatchID, payload.GroupID, payload.Begin)
+		}
+	case matchpb.MatchRelocated:
+		userIDs = payload.UserIDs
+		create = func(userID string) *domain.Message {
+			return domain.CreateMatchRelocatedMessage(userID, payload.MatchID, payload.GroupID, payload.Location)
+		}
+	case matchpb.MatchFinished:
+		userIDs = payload.UserIDs
+		create = func(userID string) *domain.Message {
+			return domain.CreateMatchFinishedMessage(userID, payload.MatchID, payload.GroupID)
+		}
+	case matchpb.MatchResultEntered:
+		userIDs = payload.UserIDs
+		create = func(userID string) *domain.Message {
+			return domain.CreateMatchResultEnteredMessage(
+				userID,
+				payload.MatchID,
+				payload.GroupID,
+				payload.TeamAGoals,
+				payload.TeamBGoals,
+			)
+		}
+	default:
+		return ddd.ErrInvalidEventPayload
+	}
+
+	for _, userID := range userIDs {
+		if err := h.create(ctx, event, create(userID)); err != nil {
+			return fmt.Errorf("creating %s message: %w", event.EventName(), err)
+		}
+	}
+
+	return nil
+}
+
+// create stores the message once per event and user. Messages of an event
+// delivered again already exist and are skipped.
+func (h MatchHandler[T]) create(ctx context.Context, event ddd.Event, message *domain.Message) error {
+	err := h.messages.Create(ctx, message.KeyedByEvent(event.ID()))
+	if err != nil && !errors.Is(err, domain.ErrMessageExists) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/match/matchpb"
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/user/internal/memory"
+)
+
+func TestMatchHandler_NotifiesRegisteredPlayers(t *testing.T) {
+	ctx := context.Background()
+	messages := memory.NewMessageRepository()
+	handler := NewMatchHandler(messages, nil)
+
+	match := ddd.NewAggregate("match-1", "match.MatchAggregate")
+	match.AddEvent(matchpb.MatchCancelledEvent, matchpb.MatchCancelled{
+		MatchID: "match-1",
+		GroupID: "group-1",
+		UserIDs: []string{"user-1", "user-2"},
+	})
+	match.AddEvent(matchpb.MatchResultEnteredEvent, matchpb.MatchResultEntered{
+		MatchID:    "match-1",
+		GroupID:    "group-1",
+		TeamAGoals: 3,
+		TeamBGoals: 2,
+		UserIDs:    []string{"user-1"},
+	})
+
+	for _, event := range match.Events() {
+		require.NoError(t, handler.HandleEvent(ctx, event))
+	}
+
+	find := func(userID string) []*domain.Message {
+		t.Helper()
+
+		page, err := messages.FindByUserID(ctx, userID, pagination.Request{
+			Limit: pagination.DefaultLimit,
+			Sort:  domain.MessageListSchema.DefaultSort,
+		})
+		require.NoError(t, err)
+
+		return page.Items
+	}
+
+	user1 := find("user-1")
+	require.Len(t, user1, 2)
+	assert.ElementsMatch(
+		t,
+		[]domain.MessageType{domain.MatchCancelled, domain.MatchResultEntered},
+		[]domain.MessageType{user1[0].Type, user1[1].Type},
+	)
+
+	user2 := find("user-2")
+	require.Len(t, user2, 1)
+	assert.Equal(t, domain.MessageType(domain.MatchCancelled), user2[0].Type)
+	assert.Equal(t, "match-1", user2[0].MatchID)
+}
+
+func TestMatchHandler_NotifiesPromotedPlayer(t *testing.T) {
+	ctx := context.Background()
+	messages := memory.NewMessageRepository()
+
+	match := ddd.NewAggregate("match-1", "match.MatchAggregate")
+	match.AddEvent(matchpb.PlayerPromotedEvent, matchpb.PlayerPromoted{
+		MatchID: "match-1",
+		GroupID: "group-1",
+		UserID:  "user-1",
+	})
+
+	require.NoError(t, NewMatchHandler(messages, nil).HandleEvent(ctx, match.Events()[0]))
+
+	page, err := messages.FindByUserID(ctx, "user-1", pagination.Request{
+		Limit: pagination.DefaultLimit,
+		Sort:  domain.MessageListSchema.DefaultSort,
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, domain.MessageType(domain.PromotedFromBench), page.Items[0].Type)
+	assert.Equal(t, "match-1", page.Items[0].MatchID)
+}
+
+func TestMatchHandler_NotifiesSelectedPlayers(t *testing.T) {
+	ctx := context.Background()
+	messages := memory.NewMessageRepository()
+
+	match := ddd.NewAggregate("match-1", "match.MatchAggregate")
+	match.AddEvent(matchpb.PlayersSelectedEvent, matchpb.PlayersSelected{
+		MatchID:  "match-1",
+		GroupID:  "group-1",
+		Promoted: []string{"user-1"},
+		Benched:  []string{"user-2"},
+		Bench:    []string{"user-2", "user-3"},
+	})
+
+	require.NoError(t, NewMatchHandler(messages, nil).HandleEvent(ctx, match.Events()[0]))
+
+	find := func(userID string) []*domain.Message {
+		t.Helper()
+
+		page, err := messages.FindByUserID(ctx, userID, pagination.Request{
+			Limit: pagination.DefaultLimit,
+			Sort:  domain.MessageListSchema.DefaultSort,
+		})
+		require.NoError(t, err)
+
+		return page.Items
+	}
+
+	user1 := find("user-1")
+	require.Len(t, user1, 1)
+	assert.Equal(t, domain.MessageType(domain.PromotedFromBench), user1[0].Type)
+
+	user2 := find("user-2")
+	require.Len(t, user2, 1)
+	assert.Equal(t, domain.MessageType(domain.MovedToBench), user2[0].Type)
+
+	// players staying on the bench are not told again
+	assert.Empty(t, find("user-3"))
+}
+
+func TestMatchHandler_NotifiesOncePerEvent(t *testing.T) {
+	ctx := context.Background()
+	messages := memory.NewMessageRepository()
+	handler := NewMatchHandler(messages, nil)
+
+	match := ddd.NewAggregate("match-1", "match.MatchAggregate")
+	match.AddEvent(matchpb.MatchCancelledEvent, matchpb.MatchCancelled{
+		MatchID: "match-1",
+		GroupID: "group-1",
+		UserIDs: []string{"user-1"},
+	})
+	match.AddEvent(matchpb.PlayersSelectedEvent, matchpb.PlayersSelected{
+		MatchID:  "match-1",
+		GroupID:  "group-1",
+		Promoted: []string{"user-1"},
+	})
+
+	// the events are delivered again after a failed acknowledgement
+	for range 2 {
+		for _, event := range match.Events() {
+			require.NoError(t, handler.HandleEvent(ctx, event))
+		}
+	}
+
+	page, err := messages.FindByUserID(ctx, "user-1", pagination.Request{
+		Limit: pagination.DefaultLimit,
+		Sort:  domain.MessageListSchema.DefaultSort,
+	})
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+}
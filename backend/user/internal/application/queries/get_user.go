@@ -1,6 +1,7 @@
 package queries
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
@@ -18,8 +19,8 @@ func NewGetUserHandler(users domain.UserRepository) GetUserHandler {
 	return GetUserHandler{users}
 }
 
-func (h GetUserHandler) GetUser(cmd *GetUser) (*domain.User, error) {
-	user, err := h.UserRepository.FindByID(cmd.UserID)
+func (h GetUserHandler) GetUser(ctx context.Context, cmd *GetUser) (*domain.User, error) {
+	user, err := h.UserRepository.FindByID(ctx, cmd.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("getting user %s: %w", cmd.UserID, err)
 	}
@@ -1,13 +1,16 @@
 package queries
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
 )
 
 type GetUserAll struct {
 	Filter *domain.Filter
+	Page   pagination.Request
 }
 
 type GetUserAllHandler struct {
@@ -18,10 +21,10 @@ func NewGetUserAllHandler(users domain.UserRepository) GetUserAllHandler {
 	return GetUserAllHandler{users}
 }
 
-func (h *GetUserAllHandler) GetUserAll(cmd *GetUserAll) ([]*domain.User, error) {
-	users, err := h.UserRepository.FindAll(cmd.Filter)
+func (h *GetUserAllHandler) GetUserAll(ctx context.Context, cmd *GetUserAll) (pagination.Page[*domain.User], error) {
+	users, err := h.UserRepository.FindAll(ctx, cmd.Filter, cmd.Page)
 	if err != nil {
-		return nil, fmt.Errorf("get all users: %w", err)
+		return pagination.Page[*domain.User]{}, fmt.Errorf("get all users: %w", err)
 	}
 
 	return users, nil
@@ -1,13 +1,16 @@
 package queries
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
 )
 
 type GetUserMessages struct {
 	UserID string
+	Page   pagination.Request
 }
 
 type GetUserMessagesHandler struct {
@@ -18,10 +21,13 @@ func NewGetUserMessagesHandler(messages domain.MessageRepository) GetUserMessage
 	return GetUserMessagesHandler{messages}
 }
 
-func (h GetUserMessagesHandler) GetUserMessages(cmd *GetUserMessages) ([]*domain.Message, error) {
-	messages, err := h.MessageRepository.FindByUserID(cmd.UserID)
+func (h GetUserMessagesHandler) GetUserMessages(
+	ctx context.Context,
+	cmd *GetUserMessages,
+) (pagination.Page[*domain.Message], error) {
+	messages, err := h.MessageRepository.FindByUserID(ctx, cmd.UserID, cmd.Page)
 	if err != nil {
-		return nil, fmt.Errorf("getting messages for user %s: %w", cmd.UserID, err)
+		return pagination.Page[*domain.Message]{}, fmt.Errorf("getting messages for user %s: %w", cmd.UserID, err)
 	}
 
 	return messages, nil
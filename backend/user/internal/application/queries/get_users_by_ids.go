@@ -1,6 +1,7 @@
 package queries
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
@@ -18,8 +19,8 @@ func NewGetUsersByIDsHandler(users domain.UserRepository) GetUsersByIDsHandler {
 	return GetUsersByIDsHandler{users}
 }
 
-func (h GetUsersByIDsHandler) GetUsersByIDs(cmd *GetUsersByIDs) ([]*domain.User, error) {
-	users, err := h.UserRepository.FindByIDs(cmd.UserIDs)
+func (h GetUsersByIDsHandler) GetUsersByIDs(ctx context.Context, cmd *GetUsersByIDs) ([]*domain.User, error) {
+	users, err := h.UserRepository.FindByIDs(ctx, cmd.UserIDs)
 	if err != nil {
 		return nil, fmt.Errorf("getting users: %w", err)
 	}
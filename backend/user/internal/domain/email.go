@@ -1,12 +1,13 @@
 package domain
 
 import (
-	"errors"
 	"net/mail"
 	"strings"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 )
 
-var ErrEmailInvalid = errors.New("email is invalid")
+var ErrEmailInvalid = ddd.ValidationError("user.invalid_email", "email is invalid")
 
 type Email struct {
 	value string
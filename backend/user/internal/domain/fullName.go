@@ -2,18 +2,36 @@ package domain
 
 import (
 	"errors"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 )
 
+const maxNameLength = 40
+
 type FullName struct {
 	firstName string
 	lastName  string
 }
 
-var ErrInvalidFullName = errors.New("invalid full name")
+var (
+	ErrInvalidFirstName = ddd.ValidationError("user.invalid_first_name", "first name must have 1 to 39 characters")
+	ErrInvalidLastName  = ddd.ValidationError("user.invalid_last_name", "last name must have 1 to 39 characters")
+)
 
+// NewFullName reports an invalid first and last name at once.
 func NewFullName(firstName, lastName string) (*FullName, error) {
-	if !isFullNameValid(firstName, lastName) {
-		return nil, ErrInvalidFullName
+	var errs []error
+
+	if !isNameValid(firstName) {
+		errs = append(errs, ErrInvalidFirstName)
+	}
+
+	if !isNameValid(lastName) {
+		errs = append(errs, ErrInvalidLastName)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
 	}
 
 	return &FullName{firstName: firstName, lastName: lastName}, nil
@@ -27,6 +45,6 @@ func (f FullName) LastName() string {
 	return f.lastName
 }
 
-func isFullNameValid(firstName, lastName string) bool {
-	return 40 > len(firstName) && len(firstName) > 0 && 40 > len(lastName) && len(lastName) > 0
+func isNameValid(name string) bool {
+	return maxNameLength > len(name) && len(name) > 0
 }
@@ -10,21 +10,24 @@ func TestNewFullName(t *testing.T) {
 	tests := []struct {
 		firstName string
 		lastName  string
-		expectErr bool
+		wantErrs  []error
 	}{
-		{"John", "Doe", false},
-		{"", "Doe", true},
-		{"John", "", true},
-		{"JohnJohnJohnJohnJohnJohnJohnJohnJohnJohnJohnJohn", "Doe", true},
+		{"John", "Doe", nil},
+		{"", "Doe", []error{ErrInvalidFirstName}},
+		{"John", "", []error{ErrInvalidLastName}},
+		{"JohnJohnJohnJohnJohnJohnJohnJohnJohnJohnJohnJohn", "Doe", []error{ErrInvalidFirstName}},
+		{"", "", []error{ErrInvalidFirstName, ErrInvalidLastName}},
 	}
 
 	for _, tt := range tests {
 		_, err := NewFullName(tt.firstName, tt.lastName)
-		if tt.expectErr {
-			assert.Error(t, err, "NewFullName(%s, %s): expected error, but got none", tt.firstName, tt.lastName)
-		} else {
+		if tt.wantErrs == nil {
 			assert.NoError(t, err, "NewFullName(%s, %s): expected no error, but got %v", tt.firstName, tt.lastName, err)
 		}
+
+		for _, wantErr := range tt.wantErrs {
+			assert.ErrorIs(t, err, wantErr, "NewFullName(%s, %s)", tt.firstName, tt.lastName)
+		}
 	}
 }
 
@@ -1,5 +1,7 @@
 package domain
 
+import "context"
+
 type GroupRepository interface {
-	FindPlayersByGroup(groupID string) ([]string, error)
+	FindPlayersByGroup(ctx context.Context, groupID string) ([]string, error)
 }
@@ -5,6 +5,13 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
+)
+
+var (
+	ErrMessageNotFound = ddd.NotFoundError("user.message_not_found", "message not found")
+	ErrMessageExists   = ddd.ConflictError("user.message_exists", "message already exists")
 )
 
 type Message struct {
@@ -57,6 +64,65 @@ func CreateInviteUserToMatchMessage(userID, matchID, groupID string) *Message {
 	}
 }
 
+func CreateMatchCancelledMessage(userID, matchID, groupID string) *Message {
+	return createMatchMessage(userID, matchID, groupID, "A match you registered for has been cancelled!", MatchCancelled)
+}
+
+func CreateMatchRescheduledMessage(userID, matchID, groupID string, begin time.Time) *Message {
+	content := fmt.Sprintf("A match you registered for now begins at %s!", begin.Format(time.RFC3339))
+
+	return createMatchMessage(userID, matchID, groupID, content, MatchRescheduled)
+}
+
+func CreateMatchRelocatedMessage(userID, matchID, groupID, location string) *Message {
+	content := fmt.Sprintf("A match you registered for now takes place at %s!", location)
+
+	return createMatchMessage(userID, matchID, groupID, content, MatchRelocated)
+}
+
+func CreateMatchFinishedMessage(userID, matchID, groupID string) *Message {
+	return createMatchMessage(userID, matchID, groupID, "A match you played has been finished!", MatchFinished)
+}
+
+func CreateMatchResultEnteredMessage(userID, matchID, groupID string, teamAGoals, teamBGoals int) *Message {
+	content := fmt.Sprintf("A match you played ended %d:%d!", teamAGoals, teamBGoals)
+
+	return createMatchMessage(userID, matchID, groupID, content, MatchResultEntered)
+}
+
+func CreatePromotedFromBenchMessage(userID, matchID, groupID string) *Message {
+	content := "A slot became free, you are now registered for a match!"
+
+	return createMatchMessage(userID, matchID, groupID, content, PromotedFromBench)
+}
+
+func CreateMovedToBenchMessage(userID, matchID, groupID string) *Message {
+	content := "Other players were given priority, you are now on the bench of a match!"
+
+	return createMatchMessage(userID, matchID, groupID, content, MovedToBench)
+}
+
+func createMatchMessage(userID, matchID, groupID, content string, messageType MessageType) *Message {
+	return &Message{
+		ID:         uuid.New().String(),
+		UserID:     userID,
+		GroupID:    groupID,
+		MatchID:    matchID,
+		Content:    content,
+		Type:       messageType,
+		OccurredAt: time.Now(),
+		Read:       false,
+	}
+}
+
+// KeyedByEvent derives the ID of the message from the event it tells the user
+// about, so an event delivered again maps to the same message.
+func (m *Message) KeyedByEvent(eventID string) *Message {
+	m.ID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(eventID+"/"+m.UserID)).String()
+
+	return m
+}
+
 func (m *Message) MarkAsRead() {
 	m.Read = true
 }
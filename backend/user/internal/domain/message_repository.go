@@ -1,8 +1,34 @@
 package domain
 
+import (
+	"context"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+// MessageListSchema are the fields the messages of a user can be sorted and
+// filtered by. The newest messages come first by default.
+var MessageListSchema = pagination.Schema{
+	Fields: map[string]pagination.Field{
+		"occurredAt": {
+			Type:     pagination.Time,
+			Sortable: true,
+			Operators: []pagination.Operator{
+				pagination.Less,
+				pagination.LessOrEqual,
+				pagination.Greater,
+				pagination.GreaterOrEqual,
+			},
+		},
+		"read":    {Type: pagination.Bool, Sortable: false, Operators: []pagination.Operator{pagination.Equal}},
+		"groupId": {Type: pagination.String, Sortable: false, Operators: []pagination.Operator{pagination.Equal}},
+	},
+	DefaultSort: pagination.Sort{Field: "occurredAt", Descending: true},
+}
+
 type MessageRepository interface {
-	Create(message *Message) error
-	FindByID(id string) (*Message, error)
-	Save(message *Message) error
-	FindByUserID(userID string) ([]*Message, error)
+	Create(ctx context.Context, message *Message) error
+	FindByID(ctx context.Context, id string) (*Message, error)
+	Save(ctx context.Context, message *Message) error
+	FindByUserID(ctx context.Context, userID string, page pagination.Request) (pagination.Page[*Message], error)
 }
@@ -6,6 +6,13 @@ const (
 	GroupInvitation = iota
 	RemovedFromGroup
 	MatchInvitation
+	MatchCancelled
+	MatchRescheduled
+	MatchRelocated
+	MatchFinished
+	MatchResultEntered
+	PromotedFromBench
+	MovedToBench
 )
 
 func (mt MessageType) String() string {
@@ -16,6 +23,20 @@ func (mt MessageType) String() string {
 		return "removedFromGroup"
 	case MatchInvitation:
 		return "matchInvitation"
+	case MatchCancelled:
+		return "matchCancelled"
+	case MatchRescheduled:
+		return "matchRescheduled"
+	case MatchRelocated:
+		return "matchRelocated"
+	case MatchFinished:
+		return "matchFinished"
+	case MatchResultEntered:
+		return "matchResultEntered"
+	case PromotedFromBench:
+		return "promotedFromBench"
+	case MovedToBench:
+		return "movedToBench"
 	default:
 		return "unknown"
 	}
@@ -1,8 +1,9 @@
 package domain
 
 import (
-	"errors"
 	"regexp"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 )
 
 type Password struct {
@@ -10,7 +11,7 @@ type Password struct {
 	hash  string
 }
 
-var ErrInvalidPassword = errors.New("invalid password")
+var ErrInvalidPassword = ddd.ValidationError("user.invalid_password", "invalid password")
 
 func NewPassword(clear string) (*Password, error) {
 	if !isPasswordValid(clear) {
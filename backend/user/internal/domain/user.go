@@ -2,16 +2,18 @@ package domain
 
 import (
 	"crypto/sha256"
-	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ddd"
 )
 
 var (
-	ErrEmailAlreadyExists = errors.New("email already exists")
-	ErrWrongPassword      = errors.New("wrong password")
-	ErrInvalidEmail       = errors.New("invalid email")
+	ErrEmailAlreadyExists = ddd.ConflictError("user.email_already_exists", "email already exists")
+	ErrWrongPassword      = ddd.UnauthorizedError("user.wrong_password", "wrong password")
+	ErrUserNotFound       = ddd.NotFoundError("user.not_found", "user not found")
+	ErrInvalidEmail       = ddd.ValidationError("user.invalid_email", "invalid email")
 )
 
 type User struct {
@@ -1,13 +1,37 @@
 package domain
 
+import (
+	"context"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+)
+
+var textField = pagination.Field{
+	Type:      pagination.String,
+	Sortable:  true,
+	Operators: []pagination.Operator{pagination.Equal, pagination.Prefix},
+}
+
+// UserListSchema are the fields the list of users can be sorted and
+// filtered by.
+var UserListSchema = pagination.Schema{
+	Fields: map[string]pagination.Field{
+		"nickName":  textField,
+		"firstName": textField,
+		"lastName":  textField,
+		"email":     textField,
+	},
+	DefaultSort: pagination.Sort{Field: "nickName", Descending: false},
+}
+
 type UserRepository interface {
-	Create(user *User) (*User, error)
-	Save(user *User) error
-	CountByEmail(email *Email) (int, error)
-	FindByEmail(email *Email) (*User, error)
-	FindByID(id string) (*User, error)
-	FindByIDs(ids []string) ([]*User, error)
-	FindAll(filter *Filter) ([]*User, error)
+	Create(ctx context.Context, user *User) (*User, error)
+	Save(ctx context.Context, user *User) error
+	CountByEmail(ctx context.Context, email *Email) (int, error)
+	FindByEmail(ctx context.Context, email *Email) (*User, error)
+	FindByID(ctx context.Context, id string) (*User, error)
+	FindByIDs(ctx context.Context, ids []string) ([]*User, error)
+	FindAll(ctx context.Context, filter *Filter, page pagination.Request) (pagination.Page[*User], error)
 }
 
 type Filter struct {
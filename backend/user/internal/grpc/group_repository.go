@@ -20,9 +20,9 @@ func NewGroupRepository(conn *grpc.ClientConn) *GroupRepository {
 	return &GroupRepository{client: grouppb.NewGroupServiceClient(conn)}
 }
 
-func (r *GroupRepository) FindPlayersByGroup(groupID string) ([]string, error) {
+func (r *GroupRepository) FindPlayersByGroup(ctx context.Context, groupID string) ([]string, error) {
 	resp, err := r.client.GetActivePlayersByGroupID(
-		context.Background(),
+		ctx,
 		&grouppb.GetActivePlayersByGroupIDRequest{GroupId: groupID},
 	)
 	if err != nil {
@@ -24,10 +24,10 @@ func RegisterServer(app application.App, registrar grpc.ServiceRegistrar) error
 	return nil
 }
 
-func (s server) GetUser(_ context.Context, request *userpb.GetUserRequest) (*userpb.GetUserResponse, error) {
+func (s server) GetUser(ctx context.Context, request *userpb.GetUserRequest) (*userpb.GetUserResponse, error) {
 	query := &queries.GetUser{UserID: request.GetUserId()}
 
-	user, err := s.app.GetUser(query)
+	user, err := s.app.GetUser(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("get user: %w", err)
 	}
@@ -35,10 +35,10 @@ func (s server) GetUser(_ context.Context, request *userpb.GetUserRequest) (*use
 	return &userpb.GetUserResponse{UserId: user.ID, NickName: user.NickName}, nil
 }
 
-func (s server) GetUserAll(_ context.Context, request *userpb.GetUserAllRequest) (*userpb.GetUserAllResponse, error) {
+func (s server) GetUserAll(ctx context.Context, request *userpb.GetUserAllRequest) (*userpb.GetUserAllResponse, error) {
 	query := &queries.GetUsersByIDs{UserIDs: request.GetUserIds()}
 
-	user, err := s.app.GetUsersByIDs(query)
+	user, err := s.app.GetUsersByIDs(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("get user all: %w", err)
 	}
@@ -10,4 +10,11 @@ func RegisterMatchHandler(
 	domainSubscriber ddd.EventSubscriber[ddd.AggregateEvent],
 ) {
 	domainSubscriber.Subscribe(matchpb.MatchCreatedEvent, matchHandler)
+	domainSubscriber.Subscribe(matchpb.PlayerPromotedEvent, matchHandler)
+	domainSubscriber.Subscribe(matchpb.PlayersSelectedEvent, matchHandler)
+	domainSubscriber.Subscribe(matchpb.MatchCancelledEvent, matchHandler)
+	domainSubscriber.Subscribe(matchpb.MatchRescheduledEvent, matchHandler)
+	domainSubscriber.Subscribe(matchpb.MatchRelocatedEvent, matchHandler)
+	domainSubscriber.Subscribe(matchpb.MatchFinishedEvent, matchHandler)
+	domainSubscriber.Subscribe(matchpb.MatchResultEnteredEvent, matchHandler)
 }
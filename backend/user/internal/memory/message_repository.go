@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+)
+
+// MessageRepository keeps copies of the messages in memory.
+type MessageRepository struct {
+	mu       sync.RWMutex
+	messages map[string]domain.Message
+}
+
+var _ domain.MessageRepository = (*MessageRepository)(nil)
+
+func NewMessageRepository() *MessageRepository {
+	return &MessageRepository{messages: make(map[string]domain.Message)}
+}
+
+func (r *MessageRepository) Create(_ context.Context, message *domain.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.messages[message.ID]; ok {
+		return fmt.Errorf("could not insert message %s: %w", message.ID, domain.ErrMessageExists)
+	}
+
+	r.messages[message.ID] = *message
+
+	return nil
+}
+
+func (r *MessageRepository) FindByID(_ context.Context, id string) (*domain.Message, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	message, ok := r.messages[id]
+	if !ok {
+		return nil, fmt.Errorf("finding message %s: %w", id, domain.ErrMessageNotFound)
+	}
+
+	return &message, nil
+}
+
+func (r *MessageRepository) Save(_ context.Context, message *domain.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// like a replace, saving an unknown message does not create it
+	if _, ok := r.messages[message.ID]; ok {
+		r.messages[message.ID] = *message
+	}
+
+	return nil
+}
+
+func (r *MessageRepository) FindByUserID(
+	_ context.Context,
+	userID string,
+	page pagination.Request,
+) (pagination.Page[*domain.Message], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	messages := make([]*domain.Message, 0)
+
+	for _, message := range r.messages {
+		if message.UserID == userID {
+			messages = append(messages, &message)
+		}
+	}
+
+	return pagination.Slice(messages, page, func(message *domain.Message) string { return message.ID }, messageField), nil
+}
+
+// messageField returns the value of a field of domain.MessageListSchema.
+func messageField(message *domain.Message, field string) any {
+	switch field {
+	case "read":
+		return message.Read
+	case "groupId":
+		return message.GroupID
+	default:
+		return message.OccurredAt
+	}
+}
@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/user/internal/repositorytest"
+)
+
+func TestMessageRepository(t *testing.T) {
+	repositorytest.MessageRepository(t, func(*testing.T) domain.MessageRepository {
+		return NewMessageRepository()
+	})
+}
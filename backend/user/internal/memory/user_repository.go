@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+)
+
+// UserRepository keeps copies of the users in memory. Like the database, it
+// stores the hash of the password only.
+type UserRepository struct {
+	mu    sync.RWMutex
+	users map[string]*domain.User
+}
+
+var _ domain.UserRepository = (*UserRepository)(nil)
+
+func NewUserRepository() *UserRepository {
+	return &UserRepository{users: make(map[string]*domain.User)}
+}
+
+func (r *UserRepository) Create(_ context.Context, newUser *domain.User) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[newUser.ID]; ok {
+		return nil, fmt.Errorf("inserting user: user %s already exists", newUser.ID)
+	}
+
+	if r.findByEmail(newUser.Email.Value()) != nil {
+		return nil, fmt.Errorf("inserting user: %w", domain.ErrEmailAlreadyExists)
+	}
+
+	r.users[newUser.ID] = copyUser(newUser)
+
+	return newUser, nil
+}
+
+func (r *UserRepository) Save(_ context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// like a replace, saving an unknown user does not create it
+	if _, ok := r.users[user.ID]; ok {
+		r.users[user.ID] = copyUser(user)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) CountByEmail(_ context.Context, email *domain.Email) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.findByEmail(email.Value()) == nil {
+		return 0, nil
+	}
+
+	return 1, nil
+}
+
+func (r *UserRepository) FindByEmail(_ context.Context, email *domain.Email) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user := r.findByEmail(email.Value())
+	if user == nil {
+		return nil, fmt.Errorf("finding user by email: %w", domain.ErrUserNotFound)
+	}
+
+	return copyUser(user), nil
+}
+
+func (r *UserRepository) FindByID(_ context.Context, id string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return nil, fmt.Errorf("finding user %s: %w", id, domain.ErrUserNotFound)
+	}
+
+	return copyUser(user), nil
+}
+
+func (r *UserRepository) FindByIDs(_ context.Context, ids []string) ([]*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make([]*domain.User, 0, len(ids))
+
+	for _, id := range ids {
+		user, ok := r.users[id]
+		if ok && !slices.ContainsFunc(users, func(found *domain.User) bool { return found.ID == id }) {
+			users = append(users, copyUser(user))
+		}
+	}
+
+	return users, nil
+}
+
+func (r *UserRepository) FindAll(
+	_ context.Context,
+	filter *domain.Filter,
+	page pagination.Request,
+) (pagination.Page[*domain.User], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make([]*domain.User, 0, len(r.users))
+
+	for _, user := range r.users {
+		if filter.ExceptGroupID == "" || !slices.Contains(user.Groups, filter.ExceptGroupID) {
+			users = append(users, copyUser(user))
+		}
+	}
+
+	return pagination.Slice(users, page, func(user *domain.User) string { return user.ID }, userField), nil
+}
+
+func (r *UserRepository) findByEmail(email string) *domain.User {
+	for _, user := range r.users {
+		if user.Email.Value() == email {
+			return user
+		}
+	}
+
+	return nil
+}
+
+// userField returns the value of a field of domain.UserListSchema.
+func userField(user *domain.User, field string) any {
+	switch field {
+	case "firstName":
+		return user.FullName.FirstName()
+	case "lastName":
+		return user.FullName.LastName()
+	case "email":
+		return user.Email.Value()
+	default:
+		return user.NickName
+	}
+}
+
+func copyUser(user *domain.User) *domain.User {
+	return &domain.User{
+		ID:       user.ID,
+		FullName: user.FullName,
+		NickName: user.NickName,
+		Email:    user.Email,
+		Password: domain.NewHashedPassword(user.Password.Hash()),
+		Groups:   slices.Clone(user.Groups),
+	}
+}
@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/user/internal/repositorytest"
+)
+
+func TestUserRepository(t *testing.T) {
+	repositorytest.UserRepository(t, func(*testing.T) domain.UserRepository {
+		return NewUserRepository()
+	})
+}
@@ -0,0 +1,19 @@
+package mongodb
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+)
+
+// Indexes keep the email of a user unique and serve the messages of a user
+// newest first.
+func Indexes(usersCollection, messagesCollection string) []indexes.Index {
+	return []indexes.Index{
+		{Collection: usersCollection, Keys: bson.D{{Key: "email", Value: 1}}, Unique: true},
+		{
+			Collection: messagesCollection,
+			Keys:       bson.D{{Key: "userId", Value: 1}, {Key: "occurredAt", Value: -1}, {Key: "_id", Value: -1}},
+		},
+	}
+}
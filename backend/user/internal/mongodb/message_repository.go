@@ -2,12 +2,16 @@ package mongodb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
 )
 
@@ -15,13 +19,13 @@ const timeout = 10 * time.Second
 
 type MessageDocument struct {
 	ID         string             `bson:"_id,omitempty"`
-	UserID     string             `json:"userId,omitempty"`
-	GroupID    string             `json:"groupId,omitempty"`
-	MatchID    string             `json:"matchId,omitempty"`
-	Content    string             `json:"content,omitempty"`
-	Type       domain.MessageType `json:"type,omitempty"`
-	OccurredAt time.Time          `json:"occurredAt,omitempty"`
-	Read       bool               `json:"read,omitempty"`
+	UserID     string             `bson:"userId"`
+	GroupID    string             `bson:"groupId"`
+	MatchID    string             `bson:"matchId"`
+	Content    string             `bson:"content"`
+	Type       domain.MessageType `bson:"type"`
+	OccurredAt time.Time          `bson:"occurredAt"`
+	Read       bool               `bson:"read"`
 }
 
 type MessageRepository struct {
@@ -34,13 +38,20 @@ func NewMessageRepository(db *mongo.Database, collectionName string) *MessageRep
 	return &MessageRepository{collection: db.Collection(collectionName)}
 }
 
-func (m *MessageRepository) Create(message *domain.Message) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (m *MessageRepository) Create(ctx context.Context, message *domain.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "user.MessageRepository.Create")
+	defer span.End()
+
 	messageDoc := toDocument(message)
 
 	_, err := m.collection.InsertOne(ctx, messageDoc)
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("could not insert message %s: %w", message.ID, domain.ErrMessageExists)
+	}
+
 	if err != nil {
 		return fmt.Errorf("could not insert message: %w", err)
 	}
@@ -48,12 +59,19 @@ func (m *MessageRepository) Create(message *domain.Message) error {
 	return nil
 }
 
-func (m *MessageRepository) FindByID(id string) (*domain.Message, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (m *MessageRepository) FindByID(ctx context.Context, id string) (*domain.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "user.MessageRepository.FindByID")
+	defer span.End()
+
 	var messageDoc MessageDocument
 	if err := m.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&messageDoc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("finding message %s: %w", id, domain.ErrMessageNotFound)
+		}
+
 		return nil, fmt.Errorf("finding message by id: %w", err)
 	}
 
@@ -62,10 +80,13 @@ func (m *MessageRepository) FindByID(id string) (*domain.Message, error) {
 	return message, nil
 }
 
-func (m *MessageRepository) Save(message *domain.Message) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (m *MessageRepository) Save(ctx context.Context, message *domain.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "user.MessageRepository.Save")
+	defer span.End()
+
 	messageDoc := toDocument(message)
 
 	_, err := m.collection.ReplaceOne(ctx, bson.M{"_id": message.ID}, messageDoc)
@@ -76,29 +97,28 @@ func (m *MessageRepository) Save(message *domain.Message) error {
 	return nil
 }
 
-func (m *MessageRepository) FindByUserID(userID string) ([]*domain.Message, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (m *MessageRepository) FindByUserID(
+	ctx context.Context,
+	userID string,
+	page pagination.Request,
+) (pagination.Page[*domain.Message], error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	cursor, err := m.collection.Find(ctx, bson.M{"userid": userID})
-	if err != nil {
-		return nil, fmt.Errorf("finding messages by user id: %w", err)
-	}
-
-	defer func() { _ = cursor.Close(ctx) }()
+	ctx, span := tracing.StartSpan(ctx, "user.MessageRepository.FindByUserID")
+	defer span.End()
 
-	var messageDocs []*MessageDocument
-
-	if err := cursor.All(ctx, &messageDocs); err != nil {
-		return nil, fmt.Errorf("iterating over messages: %w", err)
+	messageDocs, err := mongodb.FindPage[MessageDocument](ctx, m.collection, bson.M{"userId": userID}, page, nil)
+	if err != nil {
+		return pagination.Page[*domain.Message]{}, fmt.Errorf("finding messages by user id: %w", err)
 	}
 
-	messages := make([]*domain.Message, len(messageDocs))
-	for index, messageDoc := range messageDocs {
+	messages := make([]*domain.Message, len(messageDocs.Items))
+	for index, messageDoc := range messageDocs.Items {
 		messages[index] = toMessageDomain(messageDoc)
 	}
 
-	return messages, nil
+	return pagination.WithItems(messageDocs, messages), nil
 }
 
 func toDocument(message *domain.Message) *MessageDocument {
@@ -0,0 +1,17 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/user/internal/repositorytest"
+)
+
+func TestMessageRepository(t *testing.T) {
+	repositorytest.MessageRepository(t, func(t *testing.T) domain.MessageRepository {
+		t.Helper()
+
+		return NewMessageRepository(mongotest.NewDatabase(t), "user.messages")
+	})
+}
@@ -0,0 +1,21 @@
+package mongodb
+
+import "github.com/FSpruhs/kick-app/backend/internal/migrations"
+
+// Migrations of the user and message documents. The fields of the documents
+// were named after their lowercased field names before.
+func Migrations(usersCollection, messagesCollection string) []migrations.Migration {
+	return []migrations.Migration{
+		migrations.RenameFields(2026101802, usersCollection, map[string]string{
+			"firstname": "firstName",
+			"lastname":  "lastName",
+			"nickname":  "nickName",
+		}),
+		migrations.RenameFields(2026101803, messagesCollection, map[string]string{
+			"userid":     "userId",
+			"groupid":    "groupId",
+			"matchid":    "matchId",
+			"occurredat": "occurredAt",
+		}),
+	}
+}
@@ -2,24 +2,26 @@ package mongodb
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
 )
 
 type UserDocument struct {
 	ID        string   `bson:"_id,omitempty"`
-	FirstName string   `json:"firstName,omitempty"`
-	LastName  string   `json:"lastName,omitempty"`
-	NickName  string   `json:"nickName,omitempty"`
-	Email     string   `json:"email,omitempty"`
-	Password  string   `json:"password,omitempty"`
-	Groups    []string `json:"groups,omitempty"`
+	FirstName string   `bson:"firstName"`
+	LastName  string   `bson:"lastName"`
+	NickName  string   `bson:"nickName"`
+	Email     string   `bson:"email"`
+	Password  string   `bson:"password"`
+	Groups    []string `bson:"groups"`
 }
 
 type UserRepository struct {
@@ -28,25 +30,17 @@ type UserRepository struct {
 
 var _ domain.UserRepository = (*UserRepository)(nil)
 
-func NewUserRepository(database *mongo.Database, collectionName string) (*UserRepository, error) {
-	collection := database.Collection(collectionName)
-	indexModel := mongo.IndexModel{
-		Keys:    bson.M{"email": 1},
-		Options: options.Index().SetUnique(true),
-	}
-
-	_, err := collection.Indexes().CreateOne(context.Background(), indexModel)
-	if err != nil {
-		return nil, fmt.Errorf("creating email as user index: %w", err)
-	}
-
-	return &UserRepository{collection: collection}, nil
+func NewUserRepository(database *mongo.Database, collectionName string) *UserRepository {
+	return &UserRepository{collection: database.Collection(collectionName)}
 }
 
-func (u UserRepository) Create(newUser *domain.User) (*domain.User, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (u UserRepository) Create(ctx context.Context, newUser *domain.User) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "user.UserRepository.Create")
+	defer span.End()
+
 	userDoc := toUserDocument(newUser)
 
 	_, err := u.collection.InsertOne(ctx, userDoc)
@@ -57,10 +51,13 @@ func (u UserRepository) Create(newUser *domain.User) (*domain.User, error) {
 	return newUser, nil
 }
 
-func (u UserRepository) CountByEmail(email *domain.Email) (int, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (u UserRepository) CountByEmail(ctx context.Context, email *domain.Email) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "user.UserRepository.CountByEmail")
+	defer span.End()
+
 	filter := bson.M{"email": email.Value()}
 
 	count, err := u.collection.CountDocuments(ctx, filter)
@@ -71,15 +68,22 @@ func (u UserRepository) CountByEmail(email *domain.Email) (int, error) {
 	return int(count), nil
 }
 
-func (u UserRepository) FindByEmail(email *domain.Email) (*domain.User, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (u UserRepository) FindByEmail(ctx context.Context, email *domain.Email) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "user.UserRepository.FindByEmail")
+	defer span.End()
+
 	filter := bson.M{"email": email.Value()}
 
 	var userDoc UserDocument
 
 	if err := u.collection.FindOne(ctx, filter).Decode(&userDoc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("finding user by email: %w", domain.ErrUserNotFound)
+		}
+
 		return nil, fmt.Errorf("finding user by email: %w", err)
 	}
 
@@ -91,10 +95,13 @@ func (u UserRepository) FindByEmail(email *domain.Email) (*domain.User, error) {
 	return user, nil
 }
 
-func (u UserRepository) Save(user *domain.User) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (u UserRepository) Save(ctx context.Context, user *domain.User) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "user.UserRepository.Save")
+	defer span.End()
+
 	userDoc := toUserDocument(user)
 
 	_, err := u.collection.ReplaceOne(ctx, bson.M{"_id": user.ID}, userDoc)
@@ -105,15 +112,22 @@ func (u UserRepository) Save(user *domain.User) error {
 	return nil
 }
 
-func (u UserRepository) FindByID(id string) (*domain.User, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (u UserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "user.UserRepository.FindByID")
+	defer span.End()
+
 	filter := bson.M{"_id": id}
 
 	var userDoc UserDocument
 
 	if err := u.collection.FindOne(ctx, filter).Decode(&userDoc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("finding user %s: %w", id, domain.ErrUserNotFound)
+		}
+
 		return nil, fmt.Errorf("finding user by id: %w", err)
 	}
 
@@ -125,10 +139,13 @@ func (u UserRepository) FindByID(id string) (*domain.User, error) {
 	return user, nil
 }
 
-func (u UserRepository) FindByIDs(ids []string) ([]*domain.User, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (u UserRepository) FindByIDs(ctx context.Context, ids []string) ([]*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ctx, span := tracing.StartSpan(ctx, "user.UserRepository.FindByIDs")
+	defer span.End()
+
 	filter := bson.M{"_id": bson.M{"$in": ids}}
 
 	cursor, err := u.collection.Find(ctx, filter)
@@ -151,11 +168,16 @@ func (u UserRepository) FindByIDs(ids []string) ([]*domain.User, error) {
 	return users, nil
 }
 
-func (u UserRepository) FindAll(filter *domain.Filter) ([]*domain.User, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (u UserRepository) FindAll(
+	ctx context.Context,
+	filter *domain.Filter,
+	page pagination.Request,
+) (pagination.Page[*domain.User], error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	var userDocs []UserDocument
+	ctx, span := tracing.StartSpan(ctx, "user.UserRepository.FindAll")
+	defer span.End()
 
 	bsonFilter := bson.M{}
 
@@ -163,22 +185,23 @@ func (u UserRepository) FindAll(filter *domain.Filter) ([]*domain.User, error) {
 		bsonFilter["groups"] = bson.M{"$ne": filter.ExceptGroupID}
 	}
 
-	cursor, err := u.collection.Find(ctx, bsonFilter)
+	userDocs, err := mongodb.FindPage[UserDocument](ctx, u.collection, bsonFilter, page, nil)
 	if err != nil {
-		return nil, fmt.Errorf("finding all users: %w", err)
+		return pagination.Page[*domain.User]{}, fmt.Errorf("finding all users: %w", err)
 	}
-	defer cursor.Close(ctx)
 
-	if err := cursor.All(ctx, &userDocs); err != nil {
-		return nil, fmt.Errorf("iterating over users: %w", err)
-	}
+	users := make([]*domain.User, len(userDocs.Items))
 
-	users, err := toDomains(userDocs)
-	if err != nil {
-		return nil, fmt.Errorf("converting user documents to domains: %w", err)
+	for index, userDoc := range userDocs.Items {
+		user, err := toDomain(userDoc)
+		if err != nil {
+			return pagination.Page[*domain.User]{}, fmt.Errorf("converting user document to domain: %w", err)
+		}
+
+		users[index] = user
 	}
 
-	return users, nil
+	return pagination.WithItems(userDocs, users), nil
 }
 
 func toDomain(userDoc *UserDocument) (*domain.User, error) {
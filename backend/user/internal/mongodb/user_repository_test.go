@@ -0,0 +1,27 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+	"github.com/FSpruhs/kick-app/backend/internal/mongodb/mongotest"
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+	"github.com/FSpruhs/kick-app/backend/user/internal/repositorytest"
+)
+
+func TestUserRepository(t *testing.T) {
+	repositorytest.UserRepository(t, func(t *testing.T) domain.UserRepository {
+		t.Helper()
+
+		db := mongotest.NewDatabase(t)
+
+		// the email of a user is kept unique by an index
+		_, err := indexes.Reconcile(context.Background(), db, Indexes("user.users", "user.messages"))
+		require.NoError(t, err)
+
+		return NewUserRepository(db, "user.users")
+	})
+}
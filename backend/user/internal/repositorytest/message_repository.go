@@ -0,0 +1,136 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+)
+
+// MessageRepository is the contract every domain.MessageRepository fulfills.
+// newRepository must return an empty repository on every call.
+func MessageRepository(t *testing.T, newRepository func(t *testing.T) domain.MessageRepository) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("finds created message", func(t *testing.T) {
+		repository := newRepository(t)
+		message := createMessage(t, repository, "user-1", "group-1", time.Now())
+
+		found, err := repository.FindByID(ctx, message.ID)
+
+		require.NoError(t, err)
+		assertSameMessage(t, message, found)
+	})
+
+	t.Run("does not create message twice", func(t *testing.T) {
+		repository := newRepository(t)
+		message := createMessage(t, repository, "user-1", "group-1", time.Now())
+
+		err := repository.Create(ctx, message)
+
+		assert.ErrorIs(t, err, domain.ErrMessageExists)
+	})
+
+	t.Run("does not find unknown message", func(t *testing.T) {
+		_, err := newRepository(t).FindByID(ctx, "unknown")
+
+		assert.ErrorIs(t, err, domain.ErrMessageNotFound)
+	})
+
+	t.Run("saves changes", func(t *testing.T) {
+		repository := newRepository(t)
+		message := createMessage(t, repository, "user-1", "group-1", time.Now())
+
+		message.MarkAsRead()
+		require.NoError(t, repository.Save(ctx, message))
+
+		found, err := repository.FindByID(ctx, message.ID)
+		require.NoError(t, err)
+		assert.True(t, found.Read)
+	})
+
+	t.Run("does not save unknown message", func(t *testing.T) {
+		repository := newRepository(t)
+		message := domain.CreateGroupInvitationMessage("user-1", "group-1", "Kickers")
+
+		require.NoError(t, repository.Save(ctx, message))
+
+		_, err := repository.FindByID(ctx, message.ID)
+		assert.ErrorIs(t, err, domain.ErrMessageNotFound)
+	})
+
+	t.Run("finds messages of user page by page", func(t *testing.T) {
+		repository := newRepository(t)
+		now := time.Now()
+		older := createMessage(t, repository, "user-1", "group-1", now.Add(-time.Hour))
+		newer := createMessage(t, repository, "user-1", "group-2", now)
+		createMessage(t, repository, "user-2", "group-1", now)
+
+		request := pagination.Request{Limit: 1, Sort: domain.MessageListSchema.DefaultSort}
+
+		first, err := repository.FindByUserID(ctx, "user-1", request)
+		require.NoError(t, err)
+		assert.Equal(t, []string{newer.ID}, messageIDs(first.Items))
+		require.NotNil(t, first.Next)
+
+		request.Cursor = first.Next
+		second, err := repository.FindByUserID(ctx, "user-1", request)
+		require.NoError(t, err)
+		assert.Equal(t, []string{older.ID}, messageIDs(second.Items))
+		assert.Nil(t, second.Next)
+
+		filtered, err := repository.FindByUserID(ctx, "user-1", pagination.Request{
+			Limit:   10,
+			Sort:    domain.MessageListSchema.DefaultSort,
+			Filters: []pagination.Filter{{Field: "groupId", Operator: pagination.Equal, Value: "group-1"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{older.ID}, messageIDs(filtered.Items))
+	})
+}
+
+func createMessage(
+	t *testing.T,
+	repository domain.MessageRepository,
+	userID, groupID string,
+	occurredAt time.Time,
+) *domain.Message {
+	t.Helper()
+
+	message := domain.CreateGroupInvitationMessage(userID, groupID, "Kickers")
+	// messages are stored to the millisecond
+	message.OccurredAt = occurredAt.Truncate(time.Millisecond)
+
+	require.NoError(t, repository.Create(context.Background(), message))
+
+	return message
+}
+
+func assertSameMessage(t *testing.T, expected, actual *domain.Message) {
+	t.Helper()
+
+	assert.Equal(t, expected.ID, actual.ID)
+	assert.Equal(t, expected.UserID, actual.UserID)
+	assert.Equal(t, expected.GroupID, actual.GroupID)
+	assert.Equal(t, expected.MatchID, actual.MatchID)
+	assert.Equal(t, expected.Content, actual.Content)
+	assert.Equal(t, expected.Type, actual.Type)
+	assert.WithinDuration(t, expected.OccurredAt, actual.OccurredAt, 0)
+	assert.Equal(t, expected.Read, actual.Read)
+}
+
+func messageIDs(messages []*domain.Message) []string {
+	ids := make([]string, len(messages))
+	for i, message := range messages {
+		ids[i] = message.ID
+	}
+
+	return ids
+}
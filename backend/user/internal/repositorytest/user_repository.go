@@ -0,0 +1,196 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+)
+
+// UserRepository is the contract every domain.UserRepository fulfills.
+// newRepository must return an empty repository on every call.
+func UserRepository(t *testing.T, newRepository func(t *testing.T) domain.UserRepository) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("finds created user", func(t *testing.T) {
+		repository := newRepository(t)
+		user := createUser(t, repository, "Max", "max@example.com")
+
+		byID, err := repository.FindByID(ctx, user.ID)
+		require.NoError(t, err)
+		assertSameUser(t, user, byID)
+
+		byEmail, err := repository.FindByEmail(ctx, user.Email)
+		require.NoError(t, err)
+		assertSameUser(t, user, byEmail)
+
+		count, err := repository.CountByEmail(ctx, user.Email)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("does not find unknown user", func(t *testing.T) {
+		repository := newRepository(t)
+		email := newEmail(t, "unknown@example.com")
+
+		_, err := repository.FindByID(ctx, "unknown")
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+
+		_, err = repository.FindByEmail(ctx, email)
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+
+		count, err := repository.CountByEmail(ctx, email)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("does not create second user with same email", func(t *testing.T) {
+		repository := newRepository(t)
+		createUser(t, repository, "Max", "max@example.com")
+
+		_, err := repository.Create(ctx, newUser(t, "Moritz", "max@example.com"))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("saves changes", func(t *testing.T) {
+		repository := newRepository(t)
+		user := findUser(t, repository, createUser(t, repository, "Max", "max@example.com").ID)
+
+		user.JoinGroup("group-1")
+		user.NickName = "Maxi"
+		require.NoError(t, repository.Save(ctx, user))
+
+		assertSameUser(t, user, findUser(t, repository, user.ID))
+	})
+
+	t.Run("keeps unsaved changes apart", func(t *testing.T) {
+		repository := newRepository(t)
+		user := findUser(t, repository, createUser(t, repository, "Max", "max@example.com").ID)
+
+		user.JoinGroup("group-1")
+
+		assert.Empty(t, findUser(t, repository, user.ID).Groups)
+	})
+
+	t.Run("does not save unknown user", func(t *testing.T) {
+		repository := newRepository(t)
+		user := newUser(t, "Max", "max@example.com")
+
+		require.NoError(t, repository.Save(ctx, user))
+
+		_, err := repository.FindByID(ctx, user.ID)
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+
+	t.Run("finds users by ids", func(t *testing.T) {
+		repository := newRepository(t)
+		maxUser := createUser(t, repository, "Max", "max@example.com")
+		moritz := createUser(t, repository, "Moritz", "moritz@example.com")
+		createUser(t, repository, "Lempel", "lempel@example.com")
+
+		users, err := repository.FindByIDs(ctx, []string{maxUser.ID, moritz.ID, "unknown"})
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{maxUser.ID, moritz.ID}, userIDs(users))
+	})
+
+	t.Run("finds users page by page", func(t *testing.T) {
+		repository := newRepository(t)
+		moritz := createUser(t, repository, "Moritz", "moritz@example.com")
+		maxUser := createUser(t, repository, "Max", "max@example.com")
+		member := findUser(t, repository, createUser(t, repository, "Lempel", "lempel@example.com").ID)
+
+		member.JoinGroup("group-1")
+		require.NoError(t, repository.Save(ctx, member))
+
+		filter := &domain.Filter{ExceptGroupID: "group-1"}
+		request := pagination.Request{Limit: 1, Sort: domain.UserListSchema.DefaultSort}
+
+		first, err := repository.FindAll(ctx, filter, request)
+		require.NoError(t, err)
+		assert.Equal(t, []string{maxUser.ID}, userIDs(first.Items))
+		require.NotNil(t, first.Next)
+
+		request.Cursor = first.Next
+		second, err := repository.FindAll(ctx, filter, request)
+		require.NoError(t, err)
+		assert.Equal(t, []string{moritz.ID}, userIDs(second.Items))
+		assert.Nil(t, second.Next)
+
+		prefix := pagination.Request{
+			Limit:   10,
+			Sort:    domain.UserListSchema.DefaultSort,
+			Filters: []pagination.Filter{{Field: "nickName", Operator: pagination.Prefix, Value: "Mo"}},
+		}
+
+		filtered, err := repository.FindAll(ctx, &domain.Filter{}, prefix)
+		require.NoError(t, err)
+		assert.Equal(t, []string{moritz.ID}, userIDs(filtered.Items))
+	})
+}
+
+func newEmail(t *testing.T, value string) *domain.Email {
+	t.Helper()
+
+	email, err := domain.NewEmail(value)
+	require.NoError(t, err)
+
+	return email
+}
+
+func newUser(t *testing.T, nickName, email string) *domain.User {
+	t.Helper()
+
+	fullName, err := domain.NewFullName("Max", "Mustermann")
+	require.NoError(t, err)
+
+	password, err := domain.NewPassword("Password1!")
+	require.NoError(t, err)
+
+	return domain.NewUser(fullName, nickName, password, newEmail(t, email))
+}
+
+func createUser(t *testing.T, repository domain.UserRepository, nickName, email string) *domain.User {
+	t.Helper()
+
+	user, err := repository.Create(context.Background(), newUser(t, nickName, email))
+	require.NoError(t, err)
+
+	return user
+}
+
+func findUser(t *testing.T, repository domain.UserRepository, id string) *domain.User {
+	t.Helper()
+
+	user, err := repository.FindByID(context.Background(), id)
+	require.NoError(t, err)
+
+	return user
+}
+
+func assertSameUser(t *testing.T, expected, actual *domain.User) {
+	t.Helper()
+
+	assert.Equal(t, expected.ID, actual.ID)
+	assert.Equal(t, expected.FullName, actual.FullName)
+	assert.Equal(t, expected.NickName, actual.NickName)
+	assert.Equal(t, expected.Email, actual.Email)
+	assert.Equal(t, expected.Password.Hash(), actual.Password.Hash())
+	assert.ElementsMatch(t, expected.Groups, actual.Groups)
+}
+
+func userIDs(users []*domain.User) []string {
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+
+	return ids
+}
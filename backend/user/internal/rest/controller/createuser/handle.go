@@ -1,17 +1,24 @@
 package createuser
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application/commands"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
 )
 
+var fields = ginconfig.FieldMapping{
+	domain.ErrInvalidFirstName: "firstName",
+	domain.ErrInvalidLastName:  "lastName",
+	domain.ErrEmailInvalid:     "email",
+	domain.ErrInvalidPassword:  "password",
+}
+
 // Handle
 // CreateUser godoc
 // @Summary      creates new user
@@ -27,28 +34,20 @@ func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
-			return
-		}
-
-		if err := validator.New().Struct(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
 		command, err := toCommand(&message)
 		if err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
+			ginconfig.AbortWithProblem(context, fields.Map(err))
 
 			return
 		}
 
-		user, err := app.CreateUser(command)
+		user, err := app.CreateUser(context.Request.Context(), command)
 		if err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
@@ -68,21 +67,15 @@ func toResponse(user *domain.User) *Response {
 	}
 }
 
+// toCommand joins the errors of all value objects, so every invalid field is
+// reported at once.
 func toCommand(message *Message) (*commands.CreateUser, error) {
+	fullName, fullNameErr := domain.NewFullName(message.FirstName, message.LastName)
+	email, emailErr := domain.NewEmail(message.Email)
+	password, passwordErr := domain.NewPassword(message.Password)
 
-	fullName, err := domain.NewFullName(message.FirstName, message.LastName)
-	if err != nil {
-		return nil, fmt.Errorf("creating full name: %w", err)
-	}
-
-	email, err := domain.NewEmail(message.Email)
-	if err != nil {
-		return nil, fmt.Errorf("creating email: %w", err)
-	}
-
-	password, err := domain.NewPassword(message.Password)
-	if err != nil {
-		return nil, domain.ErrInvalidPassword
+	if err := errors.Join(fullNameErr, emailErr, passwordErr); err != nil {
+		return nil, err
 	}
 
 	return &commands.CreateUser{
@@ -2,7 +2,9 @@ package createuser
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -10,6 +12,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application/commands"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application/queries"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
@@ -17,32 +21,35 @@ import (
 
 type MockApp struct{}
 
-func (m MockApp) GetUserAll(cmd *queries.GetUserAll) ([]*domain.User, error) {
+func (m MockApp) GetUserAll(ctx context.Context, cmd *queries.GetUserAll) (pagination.Page[*domain.User], error) {
 	panic("implement me")
 }
 
-func (m MockApp) MessageRead(cmd *commands.MessageRead) error {
+func (m MockApp) MessageRead(ctx context.Context, cmd *commands.MessageRead) error {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m MockApp) GetUser(cmd *queries.GetUser) (*domain.User, error) {
+func (m MockApp) GetUser(ctx context.Context, cmd *queries.GetUser) (*domain.User, error) {
 	panic("implement me")
 }
 
-func (m MockApp) GetUserMessages(cmd *queries.GetUserMessages) ([]*domain.Message, error) {
+func (m MockApp) GetUserMessages(
+	ctx context.Context,
+	cmd *queries.GetUserMessages,
+) (pagination.Page[*domain.Message], error) {
 	panic("implement me")
 }
 
-func (m MockApp) GetUsersByIDs(cmd *queries.GetUsersByIDs) ([]*domain.User, error) {
+func (m MockApp) GetUsersByIDs(ctx context.Context, cmd *queries.GetUsersByIDs) ([]*domain.User, error) {
 	panic("implement me")
 }
 
-func (m MockApp) LoginUser(cmd *commands.LoginUser) (*domain.User, error) {
+func (m MockApp) LoginUser(ctx context.Context, cmd *commands.LoginUser) (*domain.User, error) {
 	panic("just a mock, not implemented")
 }
 
-func (m MockApp) CreateUser(cmd *commands.CreateUser) (*domain.User, error) {
+func (m MockApp) CreateUser(ctx context.Context, cmd *commands.CreateUser) (*domain.User, error) {
 	return &domain.User{
 		ID:       "123",
 		FullName: cmd.FullName,
@@ -55,6 +62,7 @@ func (m MockApp) CreateUser(cmd *commands.CreateUser) (*domain.User, error) {
 func TestHandle_Success(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
 
 	mockApp := MockApp{}
 	handler := Handle(mockApp)
@@ -100,6 +108,7 @@ func TestHandle_Success(t *testing.T) {
 func TestHandle_BadRequest(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
 
 	mockApp := MockApp{}
 	handler := Handle(mockApp)
@@ -122,6 +131,7 @@ func TestHandle_BadRequest(t *testing.T) {
 func TestHandle_ValidationFailure(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
 
 	mockApp := MockApp{}
 	handler := Handle(mockApp)
@@ -146,3 +156,34 @@ func TestHandle_ValidationFailure(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, rec.Code, "Expected status 400 Bad Request, got %v", rec.Code)
 }
+
+func TestHandle_ReportsInvalidValuesByField(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
+	router.POST("/create-user", Handle(MockApp{}))
+
+	payload := `{
+		"firstName": "JohnJohnJohnJohnJohnJohnJohnJohnJohnJohnJohn",
+		"lastName": "Doe",
+		"email": "john.doe@example.com",
+		"password": "abc",
+		"nickName": "johndoe"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/create-user", bytes.NewBufferString(payload))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	var problem ginconfig.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("Failed to unmarshal JSON response: %v", err)
+	}
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, ginconfig.FieldErrors{
+		{Field: "firstName", Reason: "user.invalid_first_name", Detail: "first name must have 1 to 39 characters"},
+		{Field: "password", Reason: "user.invalid_password", Detail: "invalid password"},
+	}, problem.Errors)
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application/queries"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
@@ -17,26 +19,36 @@ import (
 // @Tags         user
 // @Accept       json
 // @Produce      json
-// @Success      200  {object}  Response
+// @Param        exceptGroupID  query  string    false  "only users which are not in the group"
+// @Param        limit          query  int       false  "page size, 1 to 100"
+// @Param        sort           query  string    false  "nickName, firstName, lastName or email, prefixed by - to sort descending"
+// @Param        filter         query  []string  false  "field:operator:value, e.g. nickName:prefix:jo"
+// @Param        cursor         query  string    false  "cursor of the next or prev link"
+// @Success      200  {object}  pagination.Response[Response]
 // @Failure      400
 // @Failure      500
 // @Router       /user [get].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
+		page, ok := pagination.FromQuery(context, domain.UserListSchema)
+		if !ok {
+			return
+		}
+
 		filter := &domain.Filter{
 			ExceptGroupID: context.Query("exceptGroupID"),
 		}
 
-		command := &queries.GetUserAll{Filter: filter}
+		command := &queries.GetUserAll{Filter: filter, Page: page}
 
-		users, err := app.GetUserAll(command)
+		users, err := app.GetUserAll(context.Request.Context(), command)
 		if err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
 
-		context.JSON(http.StatusOK, toResponse(users))
+		context.JSON(http.StatusOK, pagination.NewResponse(context, users, toResponse(users.Items)))
 	}
 }
 
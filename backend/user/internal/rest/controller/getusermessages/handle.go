@@ -5,6 +5,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application/queries"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
@@ -17,29 +19,37 @@ import (
 // @Tags         message
 // @Accept       json
 // @Produce      json
-// @Success      200  {object}  Response
+// @Param        limit   query  int       false  "page size, 1 to 100"
+// @Param        sort    query  string    false  "occurredAt or -occurredAt, newest first by default"
+// @Param        filter  query  []string  false  "field:operator:value, e.g. read:eq:false"
+// @Param        cursor  query  string    false  "cursor of the next or prev link"
+// @Success      200  {object}  pagination.Response[Response]
 // @Failure      400
+// @Failure      403
 // @Failure      500
 // @Router       /message {userId} [get].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
-		userID := context.Param("userId")
-		if userID == "" {
-			context.JSON(http.StatusBadRequest, gin.H{"error": "missing userId"})
+		userID, ok := ginconfig.ActingUserID(context, context.Param("userId"))
+		if !ok {
+			return
+		}
 
+		page, ok := pagination.FromQuery(context, domain.MessageListSchema)
+		if !ok {
 			return
 		}
 
-		command := &queries.GetUserMessages{UserID: userID}
+		command := &queries.GetUserMessages{UserID: userID, Page: page}
 
-		messages, err := app.GetUserMessages(command)
+		messages, err := app.GetUserMessages(context.Request.Context(), command)
 		if err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
 
-		context.JSON(http.StatusOK, toResponse(messages))
+		context.JSON(http.StatusOK, pagination.NewResponse(context, messages, toResponse(messages.Items)))
 	}
 }
 
@@ -0,0 +1,134 @@
+package getusermessages
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
+	"github.com/FSpruhs/kick-app/backend/internal/pagination"
+	"github.com/FSpruhs/kick-app/backend/user/internal/application"
+	"github.com/FSpruhs/kick-app/backend/user/internal/application/queries"
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
+)
+
+type mockApp struct {
+	application.App
+	mock.Mock
+}
+
+func (m *mockApp) GetUserMessages(
+	ctx context.Context,
+	cmd *queries.GetUserMessages,
+) (pagination.Page[*domain.Message], error) {
+	args := m.Called(ctx, cmd)
+
+	return args.Get(0).(pagination.Page[*domain.Message]), args.Error(1)
+}
+
+func newRouter(app application.App) *gin.Engine {
+	router := gin.New()
+	router.Use(ginconfig.ErrorHandler(slog.Default()))
+	router.GET("/message/:userId", func(c *gin.Context) {
+		c.Set(ginconfig.UserIDKey, "user-1")
+	}, Handle(app))
+
+	return router
+}
+
+func TestHandle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		userID string
+		want   int
+	}{
+		{name: "own messages", userID: "user-1", want: http.StatusOK},
+		{name: "messages of someone else", userID: "user-2", want: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &mockApp{}
+			app.On("GetUserMessages", mock.Anything, &queries.GetUserMessages{
+				UserID: "user-1",
+				Page:   pagination.Request{Limit: pagination.DefaultLimit, Sort: domain.MessageListSchema.DefaultSort},
+			}).Return(pagination.Page[*domain.Message]{}, nil)
+
+			rec := httptest.NewRecorder()
+			newRouter(app).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/message/"+tt.userID, nil))
+
+			assert.Equal(t, tt.want, rec.Code)
+
+			if tt.want == http.StatusOK {
+				app.AssertExpectations(t)
+			} else {
+				app.AssertNotCalled(t, "GetUserMessages", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestHandle_LinksNeighbouringPages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	occurredAt := time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)
+	next := &pagination.Cursor{Sort: domain.MessageListSchema.DefaultSort, Value: occurredAt, ID: "message-2"}
+
+	app := &mockApp{}
+	app.On("GetUserMessages", mock.Anything, &queries.GetUserMessages{
+		UserID: "user-1",
+		Page: pagination.Request{
+			Limit:   2,
+			Sort:    domain.MessageListSchema.DefaultSort,
+			Filters: []pagination.Filter{{Field: "read", Operator: pagination.Equal, Value: false}},
+		},
+	}).Return(pagination.Page[*domain.Message]{
+		Items: []*domain.Message{{ID: "message-1"}, {ID: "message-2"}},
+		Next:  next,
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	newRouter(app).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/message/user-1?limit=2&filter=read:eq:false", nil))
+
+	var response pagination.Response[*Response]
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, response.Items, 2)
+	assert.Empty(t, response.Prev)
+
+	link, err := url.Parse(response.Next)
+	require.NoError(t, err)
+	assert.Equal(t, "/message/user-1", link.Path)
+	assert.Equal(t, "2", link.Query().Get("limit"))
+	assert.Equal(t, "read:eq:false", link.Query().Get("filter"))
+	assert.Equal(t, next.Encode(), link.Query().Get("cursor"))
+}
+
+func TestHandle_RejectsInvalidPage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	app := &mockApp{}
+
+	rec := httptest.NewRecorder()
+	newRouter(app).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/message/user-1?limit=1000&sort=content", nil))
+
+	var problem ginconfig.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, []string{"limit", "sort"}, []string{problem.Errors[0].Field, problem.Errors[1].Field})
+	app.AssertNotCalled(t, "GetUserMessages", mock.Anything, mock.Anything)
+}
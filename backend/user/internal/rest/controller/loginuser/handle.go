@@ -5,13 +5,18 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application/commands"
 	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
 )
 
+var fields = ginconfig.FieldMapping{
+	domain.ErrEmailInvalid:    "email",
+	domain.ErrInvalidPassword: "password",
+}
+
 // Handle
 // LoginUser godoc
 // @Summary      logs in user
@@ -27,28 +32,15 @@ func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if err := validator.New().Struct(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
-			return
-		}
+		email, emailErr := domain.NewEmail(message.Email)
+		password, passwordErr := domain.NewPassword(message.Password)
 
-		email, err := domain.NewEmail(message.Email)
-		if err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
-			return
-		}
-
-		password, err := domain.NewPassword(message.Password)
-		if err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
+		if err := errors.Join(emailErr, passwordErr); err != nil {
+			ginconfig.AbortWithProblem(context, fields.Map(err))
 
 			return
 		}
@@ -58,13 +50,9 @@ func Handle(app application.App) gin.HandlerFunc {
 			Password: password,
 		}
 
-		result, err := app.LoginUser(&loginUserCommand)
-		if err != nil && errors.Is(err, domain.ErrWrongPassword) {
-			context.JSON(http.StatusUnauthorized, context.Error(err))
-
-			return
-		} else if err != nil {
-			context.JSON(http.StatusInternalServerError, context.Error(err))
+		result, err := app.LoginUser(context.Request.Context(), &loginUserCommand)
+		if err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
@@ -4,8 +4,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 
+	"github.com/FSpruhs/kick-app/backend/internal/ginconfig"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application/commands"
 )
@@ -19,31 +19,29 @@ import (
 // @Produce      json
 // @Success      200
 // @Failure      400
+// @Failure      403
 // @Router       /user/login [put].
 func Handle(app application.App) gin.HandlerFunc {
 	return func(context *gin.Context) {
 		var message Message
 
-		if err := context.BindJSON(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		if !ginconfig.BindJSON(context, &message) {
 			return
 		}
 
-		if err := validator.New().Struct(&message); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
-
+		userID, ok := ginconfig.ActingUserID(context, message.UserID)
+		if !ok {
 			return
 		}
 
 		command := commands.MessageRead{
 			MessageID: message.MessageID,
-			UserID:    message.UserID,
+			UserID:    userID,
 			Read:      message.Read,
 		}
 
-		if err := app.MessageRead(&command); err != nil {
-			context.JSON(http.StatusBadRequest, context.Error(err))
+		if err := app.MessageRead(context.Request.Context(), &command); err != nil {
+			ginconfig.AbortWithProblem(context, err)
 
 			return
 		}
@@ -1,7 +1,7 @@
 package messageread
 
 type Message struct {
-	UserID    string `json:"userId"    validate:"required"`
+	UserID    string `json:"userId"`
 	MessageID string `json:"messageId" validate:"required"`
 	Read      bool   `json:"read"      validate:"required"`
 }
@@ -12,10 +12,10 @@ import (
 	"github.com/FSpruhs/kick-app/backend/user/internal/rest/controller/messageread"
 )
 
-func UserRoutes(router *gin.Engine, app application.App) {
+func UserRoutes(router *gin.Engine, app application.App, verifier *ginconfig.TokenVerifier) {
 	router.POST("/api/v1/user", createuser.Handle(app))
 	api := router.Group("/api/v1")
-	api.Use(ginconfig.JWTValidator())
+	api.Use(ginconfig.JWTValidator(verifier))
 	api.Use(ginconfig.UserIDExtractor())
 	{
 		api.GET("/user", getuserall.Handle(app))
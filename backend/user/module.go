@@ -3,25 +3,50 @@ package user
 import (
 	"fmt"
 
+	"github.com/FSpruhs/kick-app/backend/internal/config"
+	"github.com/FSpruhs/kick-app/backend/internal/indexes"
+	"github.com/FSpruhs/kick-app/backend/internal/migrations"
 	"github.com/FSpruhs/kick-app/backend/internal/monolith"
+	"github.com/FSpruhs/kick-app/backend/internal/requestid"
+	"github.com/FSpruhs/kick-app/backend/internal/tracing"
 	"github.com/FSpruhs/kick-app/backend/user/internal/application"
+	"github.com/FSpruhs/kick-app/backend/user/internal/domain"
 	"github.com/FSpruhs/kick-app/backend/user/internal/grpc"
 	"github.com/FSpruhs/kick-app/backend/user/internal/handler"
+	"github.com/FSpruhs/kick-app/backend/user/internal/memory"
 	"github.com/FSpruhs/kick-app/backend/user/internal/mongodb"
 	"github.com/FSpruhs/kick-app/backend/user/internal/rest"
 )
 
+const (
+	usersCollection    = "user.users"
+	messagesCollection = "user.messages"
+)
+
 type Module struct{}
 
-func (m *Module) Startup(mono monolith.Monolith) error {
-	users, err := mongodb.NewUserRepository(mono.DB(), "user.users")
-	if err != nil {
-		return fmt.Errorf("creating user repository: %w", err)
-	}
+var (
+	_ migrations.Source = (*Module)(nil)
+	_ indexes.Source    = (*Module)(nil)
+)
 
-	messages := mongodb.NewMessageRepository(mono.DB(), "user.messages")
+func (m *Module) Migrations() []migrations.Migration {
+	return mongodb.Migrations(usersCollection, messagesCollection)
+}
 
-	conn, err := grpc.NewClient(mono.Config().RPC.Address())
+func (m *Module) Indexes() []indexes.Index {
+	return mongodb.Indexes(usersCollection, messagesCollection)
+}
+
+func (m *Module) Startup(mono monolith.Monolith) error {
+	users, messages := newRepositories(mono)
+
+	conn, err := grpc.NewClient(
+		mono.Config().RPC.Address(),
+		mono.Metrics().DialOption(),
+		tracing.DialOption(),
+		requestid.DialOption(),
+	)
 	if err != nil {
 		return fmt.Errorf("connect to rpc server: %w", err)
 	}
@@ -35,7 +60,7 @@ func (m *Module) Startup(mono monolith.Monolith) error {
 
 	handler.RegisterGroupHandler(groupEventHandler, mono.EventDispatcher())
 	handler.RegisterMatchHandler(matchEventHandler, mono.EventDispatcher())
-	rest.UserRoutes(mono.Router(), app)
+	rest.UserRoutes(mono.Router(), app, mono.TokenVerifier())
 
 	if err := grpc.RegisterServer(app, mono.RPC()); err != nil {
 		return fmt.Errorf("register user server: %w", err)
@@ -43,3 +68,12 @@ func (m *Module) Startup(mono monolith.Monolith) error {
 
 	return nil
 }
+
+func newRepositories(mono monolith.Monolith) (domain.UserRepository, domain.MessageRepository) {
+	if mono.Config().Storage == config.StorageMemory {
+		return memory.NewUserRepository(), memory.NewMessageRepository()
+	}
+
+	return mongodb.NewUserRepository(mono.DB(), usersCollection),
+		mongodb.NewMessageRepository(mono.DB(), messagesCollection)
+}